@@ -6,19 +6,13 @@ import (
 	qdb "github.com/rqure/qdb/src"
 )
 
-func getDatabaseAddress() string {
-	addr := os.Getenv("QDB_ADDR")
-	if addr == "" {
-		addr = "redis:6379"
+func main() {
+	dbConfig, err := qdb.RedisDatabaseConfigFromEnv()
+	if err != nil {
+		qdb.Panic("[main] Invalid database configuration: %v", err)
 	}
 
-	return addr
-}
-
-func main() {
-	db := qdb.NewRedisDatabase(qdb.RedisDatabaseConfig{
-		Address: getDatabaseAddress(),
-	})
+	db := qdb.NewRedisDatabase(dbConfig)
 
 	dbWorker := qdb.NewDatabaseWorker(db)
 	leaderElectionWorker := qdb.NewLeaderElectionWorker(db)
@@ -35,6 +29,18 @@ func main() {
 		},
 	}
 
+	// Backups are only taken when a backup directory is configured
+	if backupDir := os.Getenv("QDB_BACKUP_DIR"); backupDir != "" {
+		backupWorker := qdb.NewBackupWorker(db, qdb.BackupWorkerConfig{
+			Directory: backupDir,
+		})
+
+		leaderElectionWorker.Signals.BecameLeader.Connect(qdb.Slot(backupWorker.OnBecameLeader))
+		leaderElectionWorker.Signals.LosingLeadership.Connect(qdb.Slot(backupWorker.OnLosingLeadership))
+
+		config.Workers = append(config.Workers, backupWorker)
+	}
+
 	// Create a new application
 	app := qdb.NewApplication(config)
 
@@ -0,0 +1,307 @@
+package qdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const BackupFileExtension = ".pb.gz"
+const BackupChecksumExtension = ".sha256"
+const backupTimeLayout = "20060102T150405.000000000Z"
+
+// BackupWorkerConfig configures a BackupWorker. Zero values fall back to one backup per hour,
+// keeping the last 24 hourly and 7 daily backups.
+type BackupWorkerConfig struct {
+	Directory  string
+	Interval   time.Duration
+	KeepHourly int // Number of hours for which the newest backup is kept
+	KeepDaily  int // Number of days for which the newest backup is kept
+}
+
+type BackupWorkerSignals struct {
+	BackupCreated Signal // Emitted with the *BackupInfo of the new backup
+}
+
+// BackupInfo describes a backup file. The checksum is the SHA-256 of the compressed file.
+type BackupInfo struct {
+	Name     string
+	Time     time.Time
+	Size     int64
+	Checksum string
+}
+
+// BackupWorker periodically saves gzipped snapshots of the database to a directory, next to a
+// checksum file in the format of sha256sum. Only the leader takes backups: connect OnBecameLeader
+// and OnLosingLeadership to the signals of a LeaderElectionWorker.
+type BackupWorker struct {
+	Signals BackupWorkerSignals
+
+	db           IDatabase
+	config       BackupWorkerConfig
+	isLeader     bool
+	backupTicker *time.Ticker
+}
+
+func NewBackupWorker(db IDatabase, config BackupWorkerConfig) *BackupWorker {
+	if config.Interval <= 0 {
+		config.Interval = time.Hour
+	}
+
+	if config.KeepHourly <= 0 {
+		config.KeepHourly = 24
+	}
+
+	if config.KeepDaily <= 0 {
+		config.KeepDaily = 7
+	}
+
+	return &BackupWorker{
+		db:     db,
+		config: config,
+	}
+}
+
+func (w *BackupWorker) Init() {
+	if err := os.MkdirAll(w.config.Directory, 0755); err != nil {
+		Error("[BackupWorker::Init] Failed to create backup directory '%s': %v", w.config.Directory, err)
+	}
+
+	w.backupTicker = time.NewTicker(w.config.Interval)
+}
+
+func (w *BackupWorker) Deinit() {
+	w.backupTicker.Stop()
+}
+
+func (w *BackupWorker) DoWork() {
+	select {
+	case <-w.backupTicker.C:
+		if w.isLeader {
+			w.CreateBackup()
+		}
+	default:
+	}
+}
+
+func (w *BackupWorker) OnBecameLeader() {
+	w.isLeader = true
+}
+
+func (w *BackupWorker) OnLosingLeadership() {
+	w.isLeader = false
+}
+
+// CreateBackup saves a snapshot of the database and applies the retention policy
+func (w *BackupWorker) CreateBackup() *BackupInfo {
+	return w.createBackupAt(time.Now())
+}
+
+func (w *BackupWorker) createBackupAt(t time.Time) *BackupInfo {
+	b, err := proto.Marshal(w.db.CreateSnapshot())
+	if err != nil {
+		Error("[BackupWorker::CreateBackup] Failed to marshal snapshot: %v", err)
+		return nil
+	}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(b); err != nil {
+		Error("[BackupWorker::CreateBackup] Failed to compress snapshot: %v", err)
+		return nil
+	}
+
+	if err := zw.Close(); err != nil {
+		Error("[BackupWorker::CreateBackup] Failed to compress snapshot: %v", err)
+		return nil
+	}
+
+	info := &BackupInfo{
+		Name:     "backup-" + t.UTC().Format(backupTimeLayout) + BackupFileExtension,
+		Time:     t.UTC(),
+		Size:     int64(compressed.Len()),
+		Checksum: backupChecksum(compressed.Bytes()),
+	}
+
+	// Files are renamed into place, so a partially written backup is never listed
+	path := filepath.Join(w.config.Directory, info.Name)
+	if err := writeFileAtomic(path, compressed.Bytes()); err != nil {
+		Error("[BackupWorker::CreateBackup] Failed to write backup '%s': %v", path, err)
+		return nil
+	}
+
+	if err := writeFileAtomic(path+BackupChecksumExtension, []byte(info.Checksum+"  "+info.Name+"\n")); err != nil {
+		Error("[BackupWorker::CreateBackup] Failed to write checksum of '%s': %v", path, err)
+		os.Remove(path)
+		return nil
+	}
+
+	Info("[BackupWorker::CreateBackup] Created backup '%s' (%d bytes)", info.Name, info.Size)
+	w.applyRetention()
+	w.Signals.BackupCreated.Emit(info)
+
+	return info
+}
+
+func writeFileAtomic(path string, content []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func backupChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// ListBackups returns the backups in the directory, newest first
+func (w *BackupWorker) ListBackups() []*BackupInfo {
+	entries, err := os.ReadDir(w.config.Directory)
+	if err != nil {
+		Error("[BackupWorker::ListBackups] Failed to read backup directory '%s': %v", w.config.Directory, err)
+		return nil
+	}
+
+	backups := []*BackupInfo{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "backup-") || !strings.HasSuffix(name, BackupFileExtension) {
+			continue
+		}
+
+		t, err := time.Parse(backupTimeLayout, strings.TrimSuffix(strings.TrimPrefix(name, "backup-"), BackupFileExtension))
+		if err != nil {
+			Warn("[BackupWorker::ListBackups] Ignoring backup with an invalid name: %s", name)
+			continue
+		}
+
+		info := &BackupInfo{
+			Name: name,
+			Time: t,
+		}
+
+		if fi, err := entry.Info(); err == nil {
+			info.Size = fi.Size()
+		}
+
+		if b, err := os.ReadFile(filepath.Join(w.config.Directory, name+BackupChecksumExtension)); err == nil {
+			info.Checksum, _, _ = strings.Cut(strings.TrimSpace(string(b)), " ")
+		}
+
+		backups = append(backups, info)
+	}
+
+	slices.SortFunc(backups, func(a, b *BackupInfo) int {
+		return b.Time.Compare(a.Time)
+	})
+
+	return backups
+}
+
+// LoadBackup reads a backup after checking it against its checksum. Backups do not hold the
+// content of BinaryFile fields, so a backup referencing content missing from the blob store fails.
+func (w *BackupWorker) LoadBackup(name string) (*DatabaseSnapshot, error) {
+	if filepath.Base(name) != name {
+		return nil, fmt.Errorf("invalid backup name '%s'", name)
+	}
+
+	path := filepath.Join(w.config.Directory, name)
+	compressed, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path + BackupChecksumExtension)
+	if err != nil {
+		return nil, fmt.Errorf("missing checksum: %v", err)
+	}
+
+	if expected, _, _ := strings.Cut(strings.TrimSpace(string(b)), " "); expected != backupChecksum(compressed) {
+		return nil, fmt.Errorf("checksum mismatch for '%s'", name)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	content, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &DatabaseSnapshot{}
+	if err := proto.Unmarshal(content, snapshot); err != nil {
+		return nil, err
+	}
+
+	if missing := MissingSnapshotBlobs(w.db, snapshot); len(missing) > 0 {
+		return nil, fmt.Errorf("'%s' references %d files missing from the blob store, such as %s", name, len(missing), missing[0])
+	}
+
+	return snapshot, nil
+}
+
+// RestoreBackup replaces the content of the database with a backup
+func (w *BackupWorker) RestoreBackup(name string) bool {
+	snapshot, err := w.LoadBackup(name)
+	if err != nil {
+		Error("[BackupWorker::RestoreBackup] Failed to load backup '%s': %v", name, err)
+		return false
+	}
+
+	w.db.RestoreSnapshot(snapshot)
+	return true
+}
+
+// applyRetention keeps the newest backup of each of the last KeepHourly hours and KeepDaily days
+// that have a backup, and deletes the others
+func (w *BackupWorker) applyRetention() {
+	backups := w.ListBackups()
+	keep := map[string]bool{}
+
+	hours := map[string]bool{}
+	days := map[string]bool{}
+	for _, backup := range backups {
+		hour := backup.Time.Format("2006010215")
+		if !hours[hour] && len(hours) < w.config.KeepHourly {
+			hours[hour] = true
+			keep[backup.Name] = true
+		}
+
+		day := backup.Time.Format("20060102")
+		if !days[day] && len(days) < w.config.KeepDaily {
+			days[day] = true
+			keep[backup.Name] = true
+		}
+	}
+
+	for _, backup := range backups {
+		if keep[backup.Name] {
+			continue
+		}
+
+		path := filepath.Join(w.config.Directory, backup.Name)
+		if err := os.Remove(path); err != nil {
+			Error("[BackupWorker::applyRetention] Failed to delete backup '%s': %v", path, err)
+			continue
+		}
+		os.Remove(path + BackupChecksumExtension)
+
+		Info("[BackupWorker::applyRetention] Deleted backup '%s'", backup.Name)
+	}
+}
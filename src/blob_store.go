@@ -0,0 +1,271 @@
+package qdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const BlobChunkSize = 512 * 1024
+
+// IBlobStore holds the content of BinaryFile fields, addressed by the SHA-256 hash of the content.
+// Reference counting is handled by the database; the store only keeps the bytes.
+type IBlobStore interface {
+	Put(content io.Reader) (hash string, size int64, ok bool)
+	Get(hash string, w io.Writer) bool
+	Exists(hash string) bool
+	Delete(hash string)
+	ForEach(visit func(hash string)) bool
+}
+
+func isBlobHash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+// RedisBlobStore keeps each blob as a Redis list of chunks of at most BlobChunkSize bytes.
+// Uploads are written to a temporary key and renamed once the content hash is known.
+type RedisBlobStore struct {
+	client redis.UniversalClient
+	keygen RedisDatabaseKeyGenerator
+}
+
+func NewRedisBlobStore(client redis.UniversalClient, keygen RedisDatabaseKeyGenerator) IBlobStore {
+	return &RedisBlobStore{
+		client: client,
+		keygen: keygen,
+	}
+}
+
+func (s *RedisBlobStore) Put(content io.Reader) (string, int64, bool) {
+	uploadKey := s.keygen.GetBlobUploadKey(uuid.New().String())
+	h := sha256.New()
+	size := int64(0)
+	chunk := make([]byte, BlobChunkSize)
+
+	for {
+		n, err := io.ReadFull(content, chunk)
+		if n > 0 {
+			h.Write(chunk[:n])
+			size += int64(n)
+
+			if err := s.client.RPush(context.Background(), uploadKey, chunk[:n]).Err(); err != nil {
+				Error("[RedisBlobStore::Put] Failed to write chunk: %v", err)
+				s.client.Del(context.Background(), uploadKey)
+				return "", 0, false
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+
+		if err != nil {
+			Error("[RedisBlobStore::Put] Failed to read content: %v", err)
+			s.client.Del(context.Background(), uploadKey)
+			return "", 0, false
+		}
+	}
+
+	// An empty file is stored as a single empty chunk so that the key exists
+	if size == 0 {
+		s.client.RPush(context.Background(), uploadKey, []byte{})
+	}
+
+	hash := hex.EncodeToString(h.Sum(nil))
+	if s.Exists(hash) {
+		s.client.Del(context.Background(), uploadKey)
+		return hash, size, true
+	}
+
+	if err := s.client.Rename(context.Background(), uploadKey, s.keygen.GetBlobKey(hash)).Err(); err != nil {
+		Error("[RedisBlobStore::Put] Failed to commit blob %s: %v", hash, err)
+		s.client.Del(context.Background(), uploadKey)
+		return "", 0, false
+	}
+
+	return hash, size, true
+}
+
+// blobReadBatchSize is the number of chunks of a blob read per round trip
+const blobReadBatchSize = 16
+
+func (s *RedisBlobStore) Get(hash string, w io.Writer) bool {
+	key := s.keygen.GetBlobKey(hash)
+
+	for start := int64(0); ; start += blobReadBatchSize {
+		chunks, err := s.client.LRange(context.Background(), key, start, start+blobReadBatchSize-1).Result()
+		if err != nil {
+			Error("[RedisBlobStore::Get] Failed to read chunks %d+ of blob %s: %v", start, hash, err)
+			return false
+		}
+
+		if start == 0 && len(chunks) == 0 {
+			Error("[RedisBlobStore::Get] Failed to find blob %s", hash)
+			return false
+		}
+
+		for _, chunk := range chunks {
+			if _, err := io.WriteString(w, chunk); err != nil {
+				Error("[RedisBlobStore::Get] Failed to write chunks %d+ of blob %s: %v", start, hash, err)
+				return false
+			}
+		}
+
+		if len(chunks) < blobReadBatchSize {
+			return true
+		}
+	}
+}
+
+func (s *RedisBlobStore) Exists(hash string) bool {
+	return s.client.Exists(context.Background(), s.keygen.GetBlobKey(hash)).Val() > 0
+}
+
+func (s *RedisBlobStore) Delete(hash string) {
+	s.client.Del(context.Background(), s.keygen.GetBlobKey(hash))
+}
+
+func (s *RedisBlobStore) ForEach(visit func(hash string)) bool {
+	prefix := s.keygen.GetBlobKey("")
+	it := namespaceScanner(s.client, s.keygen).Scan(context.Background(), 0, escapeScanPattern(prefix)+"*", 1000).Iterator()
+	for it.Next(context.Background()) {
+		visit(strings.TrimPrefix(it.Val(), prefix))
+	}
+
+	if err := it.Err(); err != nil {
+		Error("[RedisBlobStore::ForEach] Failed to scan blobs: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// DirectoryBlobStore keeps each blob as a file named by its hash under <dir>/<hash[:2]>/.
+// The directory belongs to a single database, which deletes the blobs it no longer references.
+type DirectoryBlobStore struct {
+	dir string
+}
+
+func NewDirectoryBlobStore(dir string) IBlobStore {
+	return &DirectoryBlobStore{
+		dir: dir,
+	}
+}
+
+func (s *DirectoryBlobStore) path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash)
+}
+
+func (s *DirectoryBlobStore) Put(content io.Reader) (string, int64, bool) {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		Error("[DirectoryBlobStore::Put] Failed to create directory %s: %v", s.dir, err)
+		return "", 0, false
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "upload-*")
+	if err != nil {
+		Error("[DirectoryBlobStore::Put] Failed to create temporary file: %v", err)
+		return "", 0, false
+	}
+	defer os.Remove(tmp.Name())
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, h), content)
+	tmp.Close()
+	if err != nil {
+		Error("[DirectoryBlobStore::Put] Failed to write content: %v", err)
+		return "", 0, false
+	}
+
+	hash := hex.EncodeToString(h.Sum(nil))
+	if s.Exists(hash) {
+		return hash, size, true
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path(hash)), 0755); err != nil {
+		Error("[DirectoryBlobStore::Put] Failed to create directory for blob %s: %v", hash, err)
+		return "", 0, false
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(hash)); err != nil {
+		Error("[DirectoryBlobStore::Put] Failed to commit blob %s: %v", hash, err)
+		return "", 0, false
+	}
+
+	return hash, size, true
+}
+
+func (s *DirectoryBlobStore) Get(hash string, w io.Writer) bool {
+	if !isBlobHash(hash) {
+		Error("[DirectoryBlobStore::Get] Invalid blob hash: %s", hash)
+		return false
+	}
+
+	f, err := os.Open(s.path(hash))
+	if err != nil {
+		Error("[DirectoryBlobStore::Get] Failed to open blob %s: %v", hash, err)
+		return false
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(w, f); err != nil {
+		Error("[DirectoryBlobStore::Get] Failed to read blob %s: %v", hash, err)
+		return false
+	}
+
+	return true
+}
+
+func (s *DirectoryBlobStore) Exists(hash string) bool {
+	if !isBlobHash(hash) {
+		return false
+	}
+
+	_, err := os.Stat(s.path(hash))
+	return err == nil
+}
+
+func (s *DirectoryBlobStore) Delete(hash string) {
+	if !isBlobHash(hash) {
+		return
+	}
+
+	if err := os.Remove(s.path(hash)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		Error("[DirectoryBlobStore::Delete] Failed to delete blob %s: %v", hash, err)
+	}
+}
+
+func (s *DirectoryBlobStore) ForEach(visit func(hash string)) bool {
+	err := filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() && isBlobHash(d.Name()) {
+			visit(d.Name())
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		Error("[DirectoryBlobStore::ForEach] Failed to list blobs in %s: %v", s.dir, err)
+		return false
+	}
+
+	return true
+}
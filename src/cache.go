@@ -0,0 +1,226 @@
+package qdb
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// CacheInvalidationRetention is how long invalidations stay in the stream. A cache that has not read
+// the stream for half this long may have missed some, so it starts over empty.
+const CacheInvalidationRetention = time.Minute
+
+const cacheInvalidateAll = "*"
+
+type CacheStats struct {
+	Hits          uint64
+	Misses        uint64
+	Evictions     uint64 // Entries dropped to stay within the size bound
+	Invalidations uint64 // Entries dropped because they were written
+	Entries       int
+}
+
+type cacheEntry struct {
+	key   string
+	value proto.Message
+}
+
+// readCache is a bounded LRU of decoded entities, schemas and field values, keyed by their Redis key.
+// Values are cloned in and out, so callers are free to modify what they get. A nil cache never hits.
+type readCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	lru      *list.List
+	stats    CacheStats
+}
+
+func newReadCache(capacity int) *readCache {
+	if capacity <= 0 {
+		return nil
+	}
+
+	return &readCache{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+	}
+}
+
+func (c *readCache) get(key string) proto.Message {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil
+	}
+
+	c.stats.Hits++
+	c.lru.MoveToFront(element)
+	return proto.Clone(element.Value.(*cacheEntry).value)
+}
+
+func (c *readCache) put(key string, value proto.Message) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cacheEntry).value = proto.Clone(value)
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: proto.Clone(value)})
+
+	for c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.stats.Evictions++
+	}
+}
+
+func (c *readCache) remove(keys ...string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if key == cacheInvalidateAll {
+			c.stats.Invalidations += uint64(c.lru.Len())
+			c.entries = map[string]*list.Element{}
+			c.lru.Init()
+			continue
+		}
+
+		if element, ok := c.entries[key]; ok {
+			c.lru.Remove(element)
+			delete(c.entries, key)
+			c.stats.Invalidations++
+		}
+	}
+}
+
+func (c *readCache) clear() {
+	c.remove(cacheInvalidateAll)
+}
+
+func (db *RedisDatabase) CacheStats() CacheStats {
+	if db.cache == nil {
+		return CacheStats{}
+	}
+
+	db.cache.mu.Lock()
+	defer db.cache.mu.Unlock()
+
+	stats := db.cache.stats
+	stats.Entries = db.cache.lru.Len()
+	return stats
+}
+
+// invalidate drops written keys from the local cache and publishes them to the invalidation stream,
+// whether or not this database caches, so the caches of other services drop them too. Pass the
+// pipeline of the write as cmd to publish with it.
+func (db *RedisDatabase) invalidate(cmd redis.Cmdable, keys ...string) {
+	db.cache.remove(keys...)
+
+	err := cmd.XAdd(context.Background(), &redis.XAddArgs{
+		Stream: db.keygen.GetCacheInvalidationChannelKey(),
+		Values: []string{"keys", strings.Join(keys, "\n")},
+		MinID:  fmt.Sprint(time.Now().Add(-CacheInvalidationRetention).UnixMilli()),
+		Approx: true,
+	}).Err()
+	if err != nil {
+		Error("[RedisDatabase::invalidate] Failed to publish cache invalidation: %v", err)
+	}
+}
+
+// writeInvalidating queues write and the invalidation of the keys it writes in one pipeline, so that
+// publishing the invalidation does not cost another round trip
+func (db *RedisDatabase) writeInvalidating(write func(pipe redis.Pipeliner), keys ...string) error {
+	_, err := db.client.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		write(pipe)
+		db.invalidate(pipe, keys...)
+		return nil
+	})
+
+	return err
+}
+
+// resetCache empties the cache and skips the invalidations published so far
+func (db *RedisDatabase) resetCache() {
+	if db.cache == nil {
+		return
+	}
+
+	db.cache.clear()
+	db.lastInvalidationPoll = time.Now()
+
+	db.lastInvalidationId = "0"
+	r, err := db.client.XInfoStream(context.Background(), db.keygen.GetCacheInvalidationChannelKey()).Result()
+	if err == nil && r.LastGeneratedID != "" {
+		db.lastInvalidationId = r.LastGeneratedID
+	}
+}
+
+// processInvalidations drops the keys written by other services from the cache
+func (db *RedisDatabase) processInvalidations() {
+	if db.cache == nil {
+		return
+	}
+
+	if time.Since(db.lastInvalidationPoll) > CacheInvalidationRetention/2 {
+		Warn("[RedisDatabase::processInvalidations] Cache invalidations may have been missed, clearing the cache")
+		db.resetCache()
+		return
+	}
+
+	for {
+		r, err := db.client.XRead(context.Background(), &redis.XReadArgs{
+			Streams: []string{db.keygen.GetCacheInvalidationChannelKey(), db.lastInvalidationId},
+			Count:   1000,
+			Block:   -1,
+		}).Result()
+
+		if err != nil && err != redis.Nil {
+			Error("[RedisDatabase::processInvalidations] Failed to read stream %v: %v", db.keygen.GetCacheInvalidationChannelKey(), err)
+			return
+		}
+		db.lastInvalidationPoll = time.Now()
+
+		count := 0
+		for _, x := range r {
+			for _, m := range x.Messages {
+				db.lastInvalidationId = m.ID
+				count++
+
+				if keys, ok := m.Values["keys"].(string); ok {
+					db.cache.remove(strings.Split(keys, "\n")...)
+				}
+			}
+		}
+
+		if count < 1000 {
+			return
+		}
+	}
+}
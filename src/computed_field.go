@@ -0,0 +1,409 @@
+package qdb
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/stdlib"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func (c *DatabaseComputedField) IsEvaluatedOnRead() bool {
+	return c != nil && c.Evaluation != DatabaseComputedField_ON_CHANGE
+}
+
+func (c *DatabaseComputedField) IsEvaluatedOnChange() bool {
+	return c != nil && c.Evaluation == DatabaseComputedField_ON_CHANGE
+}
+
+// computedScriptModules are the standard modules computed field scripts may import. Scripts run
+// on every reader of the field, so modules reaching the file system or processes are left out.
+var computedScriptModules = []string{"math", "text", "times", "json"}
+
+// readOnlyDatabase is the database seen by computed field scripts. Their bindings only write
+// through fields, so rejecting writes keeps the scripts from changing the database.
+type readOnlyDatabase struct {
+	IDatabase
+}
+
+func (db *readOnlyDatabase) Write(requests []*DatabaseRequest) {
+	Error("[readOnlyDatabase::Write] Computed field scripts cannot write fields")
+}
+
+type computedScript struct {
+	source   string
+	compiled *tengo.Compiled
+}
+
+// compileComputedField returns the compiled script of a computed field, compiling it when its
+// schema is new or changed. The compiled script must be cloned before running it.
+func (db *RedisDatabase) compileComputedField(schema *DatabaseFieldSchema) *tengo.Compiled {
+	if cached, ok := db.computedScripts[schema.Name]; ok && cached.source == schema.Computed.Script {
+		return cached.compiled
+	}
+
+	// Jobs scheduled by a computed field script would never run
+	binding := NewTengoDatabase(&readOnlyDatabase{IDatabase: db}).ToTengoMap().(*tengo.Map)
+	delete(binding.Value, "schedule")
+
+	newScript := func(src string) *tengo.Script {
+		script := tengo.NewScript([]byte(src))
+		script.SetImports(stdlib.GetModuleMap(computedScriptModules...))
+		script.Add("qdb", binding)
+		script.Add("entity", tengo.UndefinedValue)
+		script.Add("inputs", tengo.UndefinedValue)
+		script.Add("result", tengo.UndefinedValue)
+		return script
+	}
+
+	compiled, err := newScript("result = (" + schema.Computed.Script + ")").Compile()
+	if err != nil {
+		compiled, err = newScript(schema.Computed.Script).Compile()
+	}
+
+	if err != nil {
+		Error("[RedisDatabase::compileComputedField] Failed to compile script for %s: %v", schema.Name, err)
+		return nil
+	}
+
+	db.computedScripts[schema.Name] = &computedScript{source: schema.Computed.Script, compiled: compiled}
+	return compiled
+}
+
+// evaluateComputedField runs the script of a computed field for the given entity.
+// The script sees its inputs in the `inputs` map (keyed by input path), the entity as `entity`
+// and the database as `qdb`. It can either be a single expression or a script assigning `result`.
+func (db *RedisDatabase) evaluateComputedField(schema *DatabaseFieldSchema, entityId string) *anypb.Any {
+	key := entityId + ":" + schema.Name
+	if db.evaluating[key] {
+		Error("[RedisDatabase::evaluateComputedField] Dependency cycle detected while evaluating %s", key)
+		return nil
+	}
+	db.evaluating[key] = true
+	defer delete(db.evaluating, key)
+
+	inputs := map[string]tengo.Object{}
+	for _, input := range schema.Computed.Inputs {
+		request := &DatabaseRequest{
+			Id:    entityId,
+			Field: input,
+		}
+		db.Read([]*DatabaseRequest{request})
+
+		if !request.Success {
+			inputs[input] = tengo.UndefinedValue
+			continue
+		}
+
+		inputs[input] = valueToTengo(request.Value)
+	}
+
+	compiled := db.compileComputedField(schema)
+	if compiled == nil {
+		return nil
+	}
+
+	// Nested evaluations of the same field run the same script, so each run gets its own clone
+	compiled = compiled.Clone()
+	if err := compiled.Set("entity", NewTengoEntity(NewEntity(&readOnlyDatabase{IDatabase: db}, entityId)).ToTengoMap()); err != nil {
+		Error("[RedisDatabase::evaluateComputedField] Failed to set entity for %s: %v", key, err)
+		return nil
+	}
+
+	if err := compiled.Set("inputs", &tengo.ImmutableMap{Value: inputs}); err != nil {
+		Error("[RedisDatabase::evaluateComputedField] Failed to set inputs for %s: %v", key, err)
+		return nil
+	}
+
+	if err := compiled.Run(); err != nil {
+		Error("[RedisDatabase::evaluateComputedField] Failed to execute script for %s: %v", key, err)
+		return nil
+	}
+
+	return tengoToValue(compiled.Get("result").Object(), schema.Type)
+}
+
+// updateDependencies records every field that the computed field reads, including the
+// EntityReference fields traversed by -> indirection, so that a change to any of them
+// triggers a recomputation.
+func (db *RedisDatabase) updateDependencies(schema *DatabaseFieldSchema, entityId string) {
+	db.clearDependencies(schema.Name, entityId)
+
+	dependent := entityId + ":" + schema.Name
+	for _, input := range schema.Computed.Inputs {
+		for _, dependency := range db.resolveDependencies(input, entityId) {
+			dependencyEntity, dependencyField, _ := strings.Cut(dependency, ":")
+			db.client.SAdd(context.Background(), db.keygen.GetFieldDependenciesKey(schema.Name, entityId), dependency)
+			db.client.SAdd(context.Background(), db.keygen.GetFieldDependentsKey(dependencyField, dependencyEntity), dependent)
+		}
+	}
+}
+
+func (db *RedisDatabase) clearDependencies(fieldName, entityId string) {
+	key := db.keygen.GetFieldDependenciesKey(fieldName, entityId)
+	dependent := entityId + ":" + fieldName
+
+	for _, dependency := range db.client.SMembers(context.Background(), key).Val() {
+		dependencyEntity, dependencyField, _ := strings.Cut(dependency, ":")
+		db.client.SRem(context.Background(), db.keygen.GetFieldDependentsKey(dependencyField, dependencyEntity), dependent)
+	}
+
+	db.client.Del(context.Background(), key)
+}
+
+// resolveDependencies returns the "<entityId>:<fieldName>" pairs read while resolving an input path
+func (db *RedisDatabase) resolveDependencies(path, entityId string) []string {
+	dependencies := []string{}
+	hops := strings.Split(path, "->")
+
+	for i := range hops {
+		field, entity := db.ResolveIndirection(strings.Join(hops[:i+1], "->"), entityId)
+		if field == "" || entity == "" {
+			break
+		}
+
+		// Hops by parent or child name are not fields, so there is nothing to watch
+		if i < len(hops)-1 {
+			request := &DatabaseRequest{
+				Id:    entity,
+				Field: field,
+			}
+			db.Read([]*DatabaseRequest{request})
+			if !request.Success {
+				continue
+			}
+		}
+
+		dependencies = append(dependencies, entity+":"+field)
+	}
+
+	return dependencies
+}
+
+func (db *RedisDatabase) recomputeDependents(fieldName, entityId string) {
+	for _, dependent := range db.client.SMembers(context.Background(), db.keygen.GetFieldDependentsKey(fieldName, entityId)).Val() {
+		if db.recomputing[dependent] {
+			Error("[RedisDatabase::recomputeDependents] Dependency cycle detected while recomputing %s", dependent)
+			continue
+		}
+
+		dependentEntity, dependentField, _ := strings.Cut(dependent, ":")
+
+		db.recomputing[dependent] = true
+		db.Write([]*DatabaseRequest{
+			{
+				Id:    dependentEntity,
+				Field: dependentField,
+			},
+		})
+		delete(db.recomputing, dependent)
+	}
+}
+
+// refreshComputedField re-initializes every instance of a field after its schema changed
+// to or from a computed field
+func (db *RedisDatabase) refreshComputedField(fieldName string) {
+	for _, entityType := range db.GetEntityTypes() {
+		schema := db.GetEntitySchema(entityType)
+		if schema == nil || !slices.Contains(schema.Fields, fieldName) {
+			continue
+		}
+
+		for _, entityId := range db.FindEntities(entityType) {
+			db.clearDependencies(fieldName, entityId)
+			db.Write([]*DatabaseRequest{
+				{
+					Id:    entityId,
+					Field: fieldName,
+				},
+			})
+		}
+	}
+}
+
+// findComputedFieldCycle returns the chain of field names that leads back to the
+// given schema through computed field inputs, or nil if there is no cycle
+func (db *RedisDatabase) findComputedFieldCycle(value *DatabaseFieldSchema) []string {
+	schemas := map[string]*DatabaseFieldSchema{}
+	for _, schema := range db.GetFieldSchemas() {
+		schemas[schema.Name] = schema
+	}
+	schemas[value.Name] = value
+
+	var visit func(path []string) []string
+	visit = func(path []string) []string {
+		schema := schemas[path[len(path)-1]]
+		if schema == nil || schema.Computed == nil {
+			return nil
+		}
+
+		// The earlier hops of an input are references to follow: only its last one is read
+		for _, input := range schema.Computed.Inputs {
+			hops := strings.Split(input, "->")
+			field := hops[len(hops)-1]
+			next := append(slices.Clone(path), field)
+			if field == value.Name {
+				return next
+			}
+
+			if slices.Contains(path, field) {
+				continue
+			}
+
+			if cycle := visit(next); cycle != nil {
+				return cycle
+			}
+		}
+
+		return nil
+	}
+
+	return visit([]string{value.Name})
+}
+
+func valueToTengo(value *anypb.Any) tengo.Object {
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		Error("[valueToTengo] Failed to unmarshal value: %v", err)
+		return tengo.UndefinedValue
+	}
+
+	switch v := m.(type) {
+	case *Int:
+		return &tengo.Int{Value: v.Raw}
+	case *Float:
+		return &tengo.Float{Value: v.Raw}
+	case *String:
+		return &tengo.String{Value: v.Raw}
+	case *Bool:
+		if v.Raw {
+			return tengo.TrueValue
+		}
+		return tengo.FalseValue
+	case *Timestamp:
+		return &tengo.Time{Value: v.Raw.AsTime()}
+	case *EntityReference:
+		return &tengo.String{Value: v.Raw}
+	case *BinaryFile:
+		return &tengo.String{Value: v.Raw}
+	case *Transformation:
+		return &tengo.String{Value: v.Raw}
+	case *Enum:
+		return &tengo.Int{Value: v.Raw}
+	case *IntList:
+		return intsToTengo(v.Raw)
+	case *StringList:
+		return stringsToTengo(v.Raw)
+	case *EntityReferenceList:
+		return stringsToTengo(v.Raw)
+	case *StringMap:
+		return stringMapToTengo(v.Raw)
+	}
+
+	o, err := messageToTengo(m)
+	if err != nil {
+		Error("[valueToTengo] Failed to convert value: %v", err)
+		return tengo.UndefinedValue
+	}
+
+	return o
+}
+
+func tengoToValue(o tengo.Object, typeName string) *anypb.Any {
+	if o == nil || o == tengo.UndefinedValue {
+		return nil
+	}
+
+	switch typeName {
+	case "qdb.Int":
+		if v, ok := tengo.ToInt64(o); ok {
+			return NewIntValue(v)
+		}
+	case "qdb.Float":
+		if v, ok := tengo.ToFloat64(o); ok {
+			return NewFloatValue(v)
+		}
+	case "qdb.String":
+		if v, ok := tengo.ToString(o); ok {
+			return NewStringValue(v)
+		}
+	case "qdb.Bool":
+		if v, ok := tengo.ToBool(o); ok {
+			return NewBoolValue(v)
+		}
+	case "qdb.Timestamp":
+		if v, ok := tengo.ToTime(o); ok {
+			return NewTimestampValue(v)
+		}
+	case "qdb.EntityReference":
+		if v, ok := tengo.ToString(o); ok {
+			return NewEntityReferenceValue(v)
+		}
+	case "qdb.Enum":
+		if v, ok := tengo.ToInt64(o); ok {
+			return NewEnumValue(v)
+		}
+	case "qdb.IntList":
+		if elements, ok := tengoToArray(o); ok {
+			l := []int64{}
+			for _, element := range elements {
+				if i, ok := tengo.ToInt64(element); ok {
+					l = append(l, i)
+				}
+			}
+			return NewIntListValue(l)
+		}
+	case "qdb.StringList", "qdb.EntityReferenceList":
+		if l, err := tengoToStrings("result", o); err == nil {
+			if typeName == "qdb.StringList" {
+				return NewStringListValue(l)
+			}
+			return NewEntityReferenceListValue(l)
+		}
+	case "qdb.StringMap":
+		if m, ok := tengo.ToInterface(o).(map[string]interface{}); ok {
+			v := map[string]string{}
+			for key, element := range m {
+				if s, ok := element.(string); ok {
+					v[key] = s
+				}
+			}
+			return NewStringMapValue(v)
+		}
+	default:
+		messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(typeName))
+		if err != nil {
+			Error("[tengoToValue] Failed to find message type %s: %v", typeName, err)
+			return nil
+		}
+
+		b, err := json.Marshal(tengo.ToInterface(o))
+		if err != nil {
+			Error("[tengoToValue] Failed to marshal result: %v", err)
+			return nil
+		}
+
+		m := messageType.New().Interface()
+		if err := protojson.Unmarshal(b, m); err != nil {
+			Error("[tengoToValue] Failed to unmarshal result into %s: %v", typeName, err)
+			return nil
+		}
+
+		a, err := anypb.New(m)
+		if err != nil {
+			Error("[tengoToValue] Failed to create Any: %v", err)
+			return nil
+		}
+
+		return a
+	}
+
+	Error("[tengoToValue] Cannot convert %s to %s", o.TypeName(), typeName)
+	return nil
+}
@@ -0,0 +1,229 @@
+package qdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrDatabaseDisconnected is the error of the Redis commands issued while the database is disconnected
+var ErrDatabaseDisconnected = errors.New("database is disconnected")
+
+const DefaultReconnectMinBackoff = 500 * time.Millisecond
+const DefaultReconnectMaxBackoff = 30 * time.Second
+const DefaultHealthCheckInterval = 5 * time.Second
+
+type DatabaseConnectionSignals struct {
+	Connected    Signal
+	Disconnected Signal // Emitted with the error that broke the connection
+}
+
+type probeContextKey struct{}
+
+// connectionMonitor tracks the state of the connection from the outcome of the commands, so that
+// checking it does not cost a round trip. Reconnect attempts back off exponentially.
+type connectionMonitor struct {
+	mu sync.Mutex
+
+	connected       bool
+	reported        bool  // Whether the listeners of the connection signals were last told the database is connected
+	lost            error // Error of a disconnection not reported yet
+	backoff         time.Duration
+	nextAttempt     time.Time
+	nextHealthCheck time.Time
+	poolTimeouts    uint32
+}
+
+// connectionError reports whether an error means the connection is lost, as opposed to a failed command
+func connectionError(err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) || errors.Is(err, ErrDatabaseDisconnected) {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, redis.ErrClosed) || errors.As(err, &netErr)
+}
+
+func (db *RedisDatabase) ConnectionSignals() *DatabaseConnectionSignals {
+	return &db.connectionSignals
+}
+
+// setConnected records the state of the connection. Commands call it from any goroutine, so the
+// change is only reported to the listeners by reportConnection.
+func (db *RedisDatabase) setConnected(connected bool, err error) {
+	m := &db.connection
+
+	m.mu.Lock()
+	changed := m.connected != connected
+	m.connected = connected
+
+	if connected {
+		m.backoff = 0
+		m.nextHealthCheck = time.Now().Add(db.config.HealthCheckInterval)
+	} else {
+		if changed || m.backoff == 0 {
+			m.backoff = db.config.ReconnectMinBackoff
+		} else {
+			m.backoff = min(m.backoff*2, db.config.ReconnectMaxBackoff)
+		}
+		m.nextAttempt = time.Now().Add(m.backoff)
+
+		if changed {
+			m.lost = err
+		}
+	}
+	backoff := m.backoff
+	m.mu.Unlock()
+
+	if !changed {
+		if !connected {
+			Debug("[RedisDatabase::setConnected] Reconnect attempt failed, retrying in %v: %v", backoff, err)
+		}
+		return
+	}
+
+	if connected {
+		Info("[RedisDatabase::setConnected] Connected to the database")
+	} else {
+		Warn("[RedisDatabase::setConnected] Disconnected from the database, reconnecting in %v: %v", backoff, err)
+	}
+}
+
+// reportConnection emits the connection signals for the changes recorded since the last report,
+// on the goroutine of the caller. A connection lost and back again in between is reported as both.
+func (db *RedisDatabase) reportConnection() {
+	m := &db.connection
+
+	m.mu.Lock()
+	lost, connected, reported := m.lost, m.connected, m.reported
+	m.lost = nil
+	m.reported = connected
+	m.mu.Unlock()
+
+	if lost != nil && reported {
+		db.connectionSignals.Disconnected.Emit(lost)
+		reported = false
+	}
+
+	if connected && !reported {
+		// Invalidations published while disconnected were missed
+		db.resetCache()
+		db.connectionSignals.Connected.Emit()
+	}
+}
+
+// probe sends a PING, bypassing the disconnected check of the other commands
+func (db *RedisDatabase) probe() {
+	ctx := context.WithValue(context.Background(), probeContextKey{}, true)
+	err := db.client.Ping(ctx).Err()
+	db.setConnected(err == nil, err)
+}
+
+// MaintainConnection attempts to reconnect once the backoff delay has passed and, while connected,
+// checks the connection and the health of the pool every HealthCheckInterval. It emits the
+// connection signals and resets the cache when the connection changed. DatabaseWorker calls it
+// on every iteration of the main loop. Once enough dials have failed, the pool of go-redis itself
+// only dials again once per second, so reconnecting can take up to a second longer than the backoff.
+func (db *RedisDatabase) MaintainConnection() {
+	if db.client == nil {
+		return
+	}
+	defer db.reportConnection()
+
+	m := &db.connection
+	m.mu.Lock()
+	now := time.Now()
+	due := (m.connected && !now.Before(m.nextHealthCheck)) || (!m.connected && !now.Before(m.nextAttempt))
+	m.mu.Unlock()
+
+	if !due {
+		return
+	}
+
+	db.probe()
+
+	stats := db.client.PoolStats()
+	m.mu.Lock()
+	timeouts := stats.Timeouts - m.poolTimeouts
+	m.poolTimeouts = stats.Timeouts
+	m.mu.Unlock()
+
+	if timeouts > 0 {
+		Warn("[RedisDatabase::MaintainConnection] %d commands timed out waiting for a pooled connection (%d total, %d idle)", timeouts, stats.TotalConns, stats.IdleConns)
+	}
+}
+
+// waitForConnection holds a command issued while disconnected: it fails right away, or waits
+// for the connection to come back when the config has a RetryTimeout. It only probes the
+// connection: the change is reported by the next MaintainConnection.
+func (db *RedisDatabase) waitForConnection(ctx context.Context) error {
+	if ctx.Value(probeContextKey{}) != nil {
+		return nil
+	}
+
+	deadline := time.Now().Add(db.config.RetryTimeout)
+	for {
+		db.connection.mu.Lock()
+		connected, nextAttempt := db.connection.connected, db.connection.nextAttempt
+		db.connection.mu.Unlock()
+
+		if connected {
+			return nil
+		}
+
+		if !time.Now().Before(deadline) || nextAttempt.After(deadline) {
+			return ErrDatabaseDisconnected
+		}
+
+		time.Sleep(time.Until(nextAttempt))
+		db.probe()
+	}
+}
+
+// connectionHook fails commands fast while disconnected and notices when the connection is lost
+type connectionHook struct {
+	db *RedisDatabase
+}
+
+func (h connectionHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h connectionHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.db.waitForConnection(ctx); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+
+		err := next(ctx, cmd)
+		if connectionError(err) {
+			h.db.setConnected(false, err)
+		}
+
+		return err
+	}
+}
+
+func (h connectionHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := h.db.waitForConnection(ctx); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+
+		err := next(ctx, cmds)
+		if connectionError(err) {
+			h.db.setConnected(false, err)
+		}
+
+		return err
+	}
+}
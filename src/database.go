@@ -3,6 +3,7 @@ package qdb
 import (
 	"context"
 	"encoding/base64"
+	"io"
 	"slices"
 	"strings"
 	"time"
@@ -27,22 +28,41 @@ type IDatabase interface {
 	Connect()
 	Disconnect()
 	IsConnected() bool
+	MaintainConnection()
+	ConnectionSignals() *DatabaseConnectionSignals
+	CacheStats() CacheStats
 
 	CreateSnapshot() *DatabaseSnapshot
 	RestoreSnapshot(snapshot *DatabaseSnapshot)
+	MergeSnapshot(snapshot *DatabaseSnapshot, options SnapshotMergeOptions) *DatabaseSnapshotMergeSummary
+	CreatePartialSnapshot(scope SnapshotScope) *DatabaseSnapshot
+	ImportSnapshot(snapshot *DatabaseSnapshot, parentId string) map[string]string
+	CreateSnapshotStream(w io.Writer, progress SnapshotProgressFunc) bool
+	RestoreSnapshotStream(r io.Reader, progress SnapshotProgressFunc) bool
 
 	CreateEntity(entityType, parentId, name string)
 	GetEntity(entityId string) *DatabaseEntity
+	GetEntities(entityIds []string) []*DatabaseEntity
 	SetEntity(entityId string, value *DatabaseEntity)
 	DeleteEntity(entityId string)
+	MoveEntity(entityId, parentId string) bool
+	RenameEntity(entityId, name string) bool
+	CloneEntity(entityId, parentId, name string, rewriteReferences bool) string
+	ReadEntityEvents(cursor string) ([]*DatabaseEntityEvent, string)
+	GetReferrers(entityId string) []*DatabaseReference
 
 	FindEntities(entityType string) []string
+	ResolveEntityPath(path string) string
+	GetEntityPath(entityId string) string
+	FindEntitiesPage(entityType string, cursor string, pageSize int) ([]string, string)
 	GetEntityTypes() []string
+	GetEntityTypesPage(cursor string, pageSize int) ([]string, string)
 
 	EntityExists(entityId string) bool
 	FieldExists(fieldName, entityType string) bool
 
 	GetFieldSchemas() []*DatabaseFieldSchema
+	GetFieldSchemasPage(cursor string, pageSize int) ([]*DatabaseFieldSchema, string)
 	GetFieldSchema(fieldName string) *DatabaseFieldSchema
 	SetFieldSchema(fieldName string, value *DatabaseFieldSchema)
 
@@ -51,6 +71,15 @@ type IDatabase interface {
 
 	Read(requests []*DatabaseRequest)
 	Write(requests []*DatabaseRequest)
+	ResolveIndirection(indirectField, entityId string) (string, string)
+
+	WriteBlob(content io.Reader, mimeType string) *BinaryFile
+	ReadBlob(file *BinaryFile, w io.Writer) bool
+	DiscardBlob(file *BinaryFile)
+	BlobExists(file *BinaryFile) bool
+
+	FindEntitiesByIndex(entityType, fieldName string, values []*anypb.Any) ([]string, bool)
+	FindEntitiesByRange(entityType, fieldName string, min, max float64) ([]string, bool)
 
 	TempSet(key string, value string, expiration time.Duration) bool
 	TempGet(key string) string
@@ -159,8 +188,51 @@ func (t *TengoTransformer) ProcessPending() {
 
 type RedisDatabaseConfig struct {
 	Address   string
+	Username  string // ACL user, if any
 	Password  string
 	ServiceID func() string
+
+	// BlobDirectory stores BinaryFile content on the local filesystem when set.
+	// Otherwise the content is stored in Redis as chunked lists.
+	BlobDirectory string
+
+	// Namespace prefixes every key, so several databases can share a Redis DB. It must not
+	// contain glob characters, since it is also used in Scan patterns.
+	Namespace string
+	DB        int // Redis DB index, not supported in cluster mode
+
+	// TLS is used when enabled or when a CA or client certificate is given
+	TLSEnabled  bool
+	TLSCAFile   string
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// Connects through Sentinel when a master name is given. Address is not used.
+	SentinelMasterName string
+	SentinelAddresses  []string
+	SentinelPassword   string
+
+	// Connects to a Redis Cluster when addresses are given. Address is not used. Every key
+	// gets the namespace as a hash tag, "qdb" by default, so multi-key operations stay in one slot.
+	// A namespace therefore lives on a single master: the cluster provides failover, not sharding.
+	// To spread the load, give each group of services its own namespace.
+	ClusterAddresses []string
+
+	// Reconnect attempts back off exponentially from ReconnectMinBackoff to ReconnectMaxBackoff.
+	// The connection and the pool are checked every HealthCheckInterval while connected.
+	ReconnectMinBackoff time.Duration
+	ReconnectMaxBackoff time.Duration
+	HealthCheckInterval time.Duration
+
+	// Commands issued while disconnected fail with ErrDatabaseDisconnected, or wait up to
+	// RetryTimeout for the connection to come back when it is set. The goroutine issuing the
+	// command sleeps meanwhile: on the main loop, that stalls every worker, so keep it well below
+	// LeaderLeaseTimeout.
+	RetryTimeout time.Duration
+
+	// CacheSize is the number of entities, schemas and field values kept in a local read cache.
+	// The cache is disabled when it is 0.
+	CacheSize int
 }
 
 func (r *DatabaseRequest) FromField(field *DatabaseField) *DatabaseRequest {
@@ -197,55 +269,225 @@ func (f *DatabaseField) FromRequest(request *DatabaseRequest) *DatabaseField {
 	return f
 }
 
+func (s *DatabaseFieldSchema) EnumName(value int64) (string, bool) {
+	for _, enumValue := range s.GetEnumValues() {
+		if enumValue.Value == value {
+			return enumValue.Name, true
+		}
+	}
+
+	return "", false
+}
+
+func (s *DatabaseFieldSchema) EnumValue(name string) (int64, bool) {
+	for _, enumValue := range s.GetEnumValues() {
+		if enumValue.Name == name {
+			return enumValue.Value, true
+		}
+	}
+
+	return 0, false
+}
+
+// ToEngineering applies the scale and offset of the schema to a raw value.
+// A scale of 0 is treated as unscaled.
+func (s *DatabaseFieldSchema) ToEngineering(raw float64) float64 {
+	scale := s.GetScale()
+	if scale == 0 {
+		scale = 1
+	}
+
+	return raw*scale + s.GetOffset()
+}
+
+func (s *DatabaseFieldSchema) FromEngineering(value float64) float64 {
+	scale := s.GetScale()
+	if scale == 0 {
+		scale = 1
+	}
+
+	return (value - s.GetOffset()) / scale
+}
+
+// AlarmLevel returns the most severe alarm limit exceeded by an engineering value
+func (s *DatabaseFieldSchema) AlarmLevel(value float64) DatabaseAlarmLimit_LevelEnum {
+	level := DatabaseAlarmLimit_UNSPECIFIED
+	severity := func(l DatabaseAlarmLimit_LevelEnum) int {
+		switch l {
+		case DatabaseAlarmLimit_LOW_LOW, DatabaseAlarmLimit_HIGH_HIGH:
+			return 2
+		case DatabaseAlarmLimit_LOW, DatabaseAlarmLimit_HIGH:
+			return 1
+		}
+		return 0
+	}
+
+	for _, limit := range s.GetAlarmLimits() {
+		exceeded := false
+		switch limit.Level {
+		case DatabaseAlarmLimit_LOW_LOW, DatabaseAlarmLimit_LOW:
+			exceeded = value <= limit.Value
+		case DatabaseAlarmLimit_HIGH, DatabaseAlarmLimit_HIGH_HIGH:
+			exceeded = value >= limit.Value
+		}
+
+		if exceeded && severity(limit.Level) > severity(level) {
+			level = limit.Level
+		}
+	}
+
+	return level
+}
+
 // schema:entity:<type> -> DatabaseEntitySchema
 // schema:field:<name> -> DatabaseFieldSchema
 // instance:entity:<entityId> -> DatabaseEntity
 // instance:field:<name>:<entityId> -> DatabaseField
 // instance:type:<entityType> -> []string{entityId...}
+// instance:name:<parentId>:<name> -> []string{entityId...}
 // instance:notification-config:<entityId>:<fieldName> -> []string{subscriptionId...}
 // instance:notification-config:<entityType>:<fieldName> -> []string{subscriptionId...}
-type RedisDatabaseKeyGenerator struct{}
+// instance:referrers:<entityId> -> []string{"<entityId>:<fieldName>"...}
+// instance:entity-events -> stream of DatabaseEntityEvent
+// instance:cache-invalidations -> stream of written keys
+// instance:dependencies:<fieldName>:<entityId> -> []string{"<entityId>:<fieldName>"...}
+// instance:dependents:<fieldName>:<entityId> -> []string{"<entityId>:<fieldName>"...}
+// index:hash:<fieldName>:<value> -> []string{entityId...}
+// index:sorted:<fieldName> -> []string{entityId...} scored by value
+// blob:data:<hash> -> [][]byte{chunk...}
+// blob:upload:<uploadId> -> [][]byte{chunk...}
+// blob:refs:<hash> -> int
+// RedisDatabaseKeyGenerator builds the keys of a database. Every key is prefixed with the namespace,
+// when there is one, so several databases can share a Redis DB.
+type RedisDatabaseKeyGenerator struct {
+	namespace string
+	hashTag   bool // Wraps the namespace in braces, so every key hashes to the same cluster slot
+}
+
+func NewRedisDatabaseKeyGenerator(namespace string) RedisDatabaseKeyGenerator {
+	return RedisDatabaseKeyGenerator{
+		namespace: namespace,
+	}
+}
+
+func (g *RedisDatabaseKeyGenerator) prefix(key string) string {
+	if g.hashTag {
+		return "{" + g.namespace + "}:" + key
+	}
+
+	if g.namespace == "" {
+		return key
+	}
+
+	return g.namespace + ":" + key
+}
+
+// GetNamespacedKey prefixes a key given by the caller, such as a temp key, with the namespace
+func (g *RedisDatabaseKeyGenerator) GetNamespacedKey(key string) string {
+	return g.prefix(key)
+}
 
 func (g *RedisDatabaseKeyGenerator) GetEntitySchemaKey(entityType string) string {
-	return "schema:entity:" + entityType
+	return g.prefix("schema:entity:" + entityType)
 }
 
 func (g *RedisDatabaseKeyGenerator) GetFieldSchemaKey(fieldName string) string {
-	return "schema:field:" + fieldName
+	return g.prefix("schema:field:" + fieldName)
 }
 
 func (g *RedisDatabaseKeyGenerator) GetEntityKey(entityId string) string {
-	return "instance:entity:" + entityId
+	return g.prefix("instance:entity:" + entityId)
 }
 
 func (g *RedisDatabaseKeyGenerator) GetFieldKey(fieldName, entityId string) string {
-	return "instance:field:" + fieldName + ":" + entityId
+	return g.prefix("instance:field:" + fieldName + ":" + entityId)
 }
 
 func (g *RedisDatabaseKeyGenerator) GetEntityTypeKey(entityType string) string {
-	return "instance:type:" + entityType
+	return g.prefix("instance:type:" + entityType)
 }
 
 func (g *RedisDatabaseKeyGenerator) GetEntityIdNotificationConfigKey(entityId, fieldName string) string {
-	return "instance:notification-config:" + entityId + ":" + fieldName
+	return g.prefix("instance:notification-config:" + entityId + ":" + fieldName)
 }
 
 func (g *RedisDatabaseKeyGenerator) GetEntityTypeNotificationConfigKey(entityType, fieldName string) string {
-	return "instance:notification-config:" + entityType + ":" + fieldName
+	return g.prefix("instance:notification-config:" + entityType + ":" + fieldName)
 }
 
 func (g *RedisDatabaseKeyGenerator) GetNotificationChannelKey(serviceId string) string {
-	return "instance:notification:" + serviceId
+	return g.prefix("instance:notification:" + serviceId)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetEntityReferrersKey(entityId string) string {
+	return g.prefix("instance:referrers:" + entityId)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetEntityEventChannelKey() string {
+	return g.prefix("instance:entity-events")
+}
+
+func (g *RedisDatabaseKeyGenerator) GetCacheInvalidationChannelKey() string {
+	return g.prefix("instance:cache-invalidations")
+}
+
+func (g *RedisDatabaseKeyGenerator) GetEntityNameKey(parentId, name string) string {
+	return g.prefix("instance:name:" + parentId + ":" + name)
+}
+
+// GetNameIndexedParentsKey holds the entities whose children are all in the name index, with an
+// empty member for the top-level entities
+func (g *RedisDatabaseKeyGenerator) GetNameIndexedParentsKey() string {
+	return g.prefix("instance:name-indexed")
+}
+
+func (g *RedisDatabaseKeyGenerator) GetFieldDependenciesKey(fieldName, entityId string) string {
+	return g.prefix("instance:dependencies:" + fieldName + ":" + entityId)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetFieldDependentsKey(fieldName, entityId string) string {
+	return g.prefix("instance:dependents:" + fieldName + ":" + entityId)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetFieldHashIndexKey(fieldName, value string) string {
+	return g.prefix("index:hash:" + fieldName + ":" + value)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetFieldSortedIndexKey(fieldName string) string {
+	return g.prefix("index:sorted:" + fieldName)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetBlobKey(hash string) string {
+	return g.prefix("blob:data:" + hash)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetBlobUploadKey(uploadId string) string {
+	return g.prefix("blob:upload:" + uploadId)
+}
+
+func (g *RedisDatabaseKeyGenerator) GetBlobRefCountKey(hash string) string {
+	return g.prefix("blob:refs:" + hash)
 }
 
 type RedisDatabase struct {
-	client              *redis.Client
-	config              RedisDatabaseConfig
-	callbacks           map[string][]INotificationCallback
-	lastStreamMessageId string
-	keygen              RedisDatabaseKeyGenerator
-	getServiceId        func() string
-	transformer         ITransformer // Transformer calls scripts to transform field values of type Transformation
+	client               redis.UniversalClient
+	config               RedisDatabaseConfig
+	callbacks            map[string][]INotificationCallback
+	lastStreamMessageId  string
+	keygen               RedisDatabaseKeyGenerator
+	getServiceId         func() string
+	transformer          ITransformer // Transformer calls scripts to transform field values of type Transformation
+	blobs                IBlobStore   // Blob store holds the content of BinaryFile fields
+	evaluating           map[string]bool
+	recomputing          map[string]bool
+	computedScripts      map[string]*computedScript // Compiled computed field scripts, by field name
+	muteNotifications    bool                       // Set while merging a snapshot that should not notify listeners
+	muteTransformations  bool                       // Set while merging a snapshot that should not run transformations
+	connection           connectionMonitor
+	connectionSignals    DatabaseConnectionSignals
+	cache                *readCache // Local read cache, nil when disabled
+	lastInvalidationId   string
+	lastInvalidationPoll time.Time
 }
 
 func NewRedisDatabase(config RedisDatabaseConfig) IDatabase {
@@ -254,12 +496,28 @@ func NewRedisDatabase(config RedisDatabaseConfig) IDatabase {
 		getServiceId = GetApplicationName
 	}
 
+	if config.ReconnectMinBackoff <= 0 {
+		config.ReconnectMinBackoff = DefaultReconnectMinBackoff
+	}
+
+	if config.ReconnectMaxBackoff < config.ReconnectMinBackoff {
+		config.ReconnectMaxBackoff = max(DefaultReconnectMaxBackoff, config.ReconnectMinBackoff)
+	}
+
+	if config.HealthCheckInterval <= 0 {
+		config.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+
 	db := &RedisDatabase{
 		config:              config,
 		callbacks:           map[string][]INotificationCallback{},
 		lastStreamMessageId: "$",
-		keygen:              RedisDatabaseKeyGenerator{},
+		keygen:              config.keyGenerator(),
 		getServiceId:        getServiceId,
+		evaluating:          map[string]bool{},
+		recomputing:         map[string]bool{},
+		computedScripts:     map[string]*computedScript{},
+		cache:               newReadCache(config.CacheSize),
 	}
 
 	db.transformer = NewTransformer(db)
@@ -270,12 +528,23 @@ func NewRedisDatabase(config RedisDatabaseConfig) IDatabase {
 func (db *RedisDatabase) Connect() {
 	db.Disconnect()
 
-	Info("[RedisDatabase::Connect] Connecting to %v", db.config.Address)
-	db.client = redis.NewClient(&redis.Options{
-		Addr:     db.config.Address,
-		Password: db.config.Password,
-		DB:       0,
-	})
+	Info("[RedisDatabase::Connect] Connecting to %v", db.config.describe())
+	client, err := db.config.newClient()
+	if err != nil {
+		Error("[RedisDatabase::Connect] Failed to configure connection: %v", err)
+		return
+	}
+	db.client = client
+	db.client.AddHook(connectionHook{db: db})
+
+	if db.config.BlobDirectory != "" {
+		db.blobs = NewDirectoryBlobStore(db.config.BlobDirectory)
+	} else {
+		db.blobs = NewRedisBlobStore(db.client, db.keygen)
+	}
+
+	db.probe()
+	db.reportConnection()
 }
 
 func (db *RedisDatabase) Disconnect() {
@@ -285,53 +554,28 @@ func (db *RedisDatabase) Disconnect() {
 
 	db.client.Close()
 	db.client = nil
+	db.setConnected(false, ErrDatabaseDisconnected)
+	db.reportConnection()
 }
 
+// IsConnected returns the state of the connection as of the last command, without a round trip
 func (db *RedisDatabase) IsConnected() bool {
-	return db.client != nil && db.client.Ping(context.Background()).Err() == nil
+	db.connection.mu.Lock()
+	defer db.connection.mu.Unlock()
+
+	return db.client != nil && db.connection.connected
 }
 
+// CreateSnapshot returns the content of the database. BinaryFile values only hold the hash of
+// their content, which stays in the blob store: see MissingSnapshotBlobs.
 func (db *RedisDatabase) CreateSnapshot() *DatabaseSnapshot {
-	snapshot := &DatabaseSnapshot{}
-
-	usedEntityType := map[string]bool{}
-	usedFields := map[string]bool{}
-	for _, entityType := range db.GetEntityTypes() {
-		entitySchema := db.GetEntitySchema(entityType)
-		for _, entityId := range db.FindEntities(entityType) {
-			usedEntityType[entityType] = true
-			snapshot.Entities = append(snapshot.Entities, db.GetEntity(entityId))
-			for _, fieldName := range entitySchema.Fields {
-				request := &DatabaseRequest{
-					Id:    entityId,
-					Field: fieldName,
-				}
-				db.Read([]*DatabaseRequest{request})
-				if request.Success {
-					snapshot.Fields = append(snapshot.Fields, new(DatabaseField).FromRequest(request))
-				}
-				usedFields[fieldName] = true
-			}
-		}
-
-		if usedEntityType[entityType] {
-			snapshot.EntitySchemas = append(snapshot.EntitySchemas, entitySchema)
-		}
-	}
-
-	for _, fieldSchema := range db.GetFieldSchemas() {
-		if usedFields[fieldSchema.Name] {
-			snapshot.FieldSchemas = append(snapshot.FieldSchemas, fieldSchema)
-		}
-	}
-
-	return snapshot
+	return db.CreatePartialSnapshot(SnapshotScope{})
 }
 
 func (db *RedisDatabase) RestoreSnapshot(snapshot *DatabaseSnapshot) {
 	Info("[RedisDatabase::RestoreSnapshot] Restoring snapshot...")
 
-	err := db.client.FlushDB(context.Background()).Err()
+	err := db.flush()
 	if err != nil {
 		Error("[RedisDatabase::RestoreSnapshot] Failed to flush database: %v", err)
 		return
@@ -342,9 +586,14 @@ func (db *RedisDatabase) RestoreSnapshot(snapshot *DatabaseSnapshot) {
 		Debug("[RedisDatabase::RestoreSnapshot] Restored entity schema: %v", schema)
 	}
 
+	computed := []string{}
 	for _, schema := range snapshot.FieldSchemas {
 		db.SetFieldSchema(schema.Name, schema)
 		Debug("[RedisDatabase::RestoreSnapshot] Restored field schema: %v", schema)
+
+		if schema.Computed != nil {
+			computed = append(computed, schema.Name)
+		}
 	}
 
 	for _, entity := range snapshot.Entities {
@@ -354,6 +603,11 @@ func (db *RedisDatabase) RestoreSnapshot(snapshot *DatabaseSnapshot) {
 	}
 
 	for _, field := range snapshot.Fields {
+		// Computed fields cannot be written: they are evaluated once their inputs are restored
+		if slices.Contains(computed, field.Name) {
+			continue
+		}
+
 		db.Write([]*DatabaseRequest{
 			{
 				Id:        field.Id,
@@ -366,9 +620,80 @@ func (db *RedisDatabase) RestoreSnapshot(snapshot *DatabaseSnapshot) {
 		Debug("[RedisDatabase::RestoreSnapshot] Restored field: %v", field)
 	}
 
+	for _, fieldName := range computed {
+		db.refreshComputedField(fieldName)
+	}
+
+	db.releaseOrphanedBlobs()
+
 	Info("[RedisDatabase::RestoreSnapshot] Snapshot restored.")
 }
 
+// flush deletes the schema, instance, index, blob and leader keys of the database. Other temp keys are kept.
+// Blob content is kept, since snapshots only hold the hashes of the blobs they reference: call
+// releaseOrphanedBlobs once the restored fields have counted their references again. The cache
+// invalidation stream is kept too.
+// Every cache is told to drop its entries.
+func (db *RedisDatabase) flush() error {
+	if err := db.flushKeys(); err != nil {
+		return err
+	}
+
+	db.invalidate(db.client, cacheInvalidateAll)
+	return nil
+}
+
+// flushedKeyFamilies are the key families owned by a database. Only these are scanned, so a
+// database without a namespace leaves the namespaced databases sharing its Redis DB alone.
+var flushedKeyFamilies = []string{"schema:", "instance:", "index:", "blob:", "leader:"}
+
+func (db *RedisDatabase) flushKeys() error {
+	keys := []string{}
+	blobPrefix := db.keygen.GetBlobKey("")
+	for _, family := range flushedKeyFamilies {
+		pattern := escapeScanPattern(db.keygen.GetNamespacedKey(family)) + "*"
+		it := db.scanner().Scan(context.Background(), 0, pattern, 1000).Iterator()
+		for it.Next(context.Background()) {
+			// Readers of the invalidation stream would miss entries if it started over with lower ids
+			if strings.HasPrefix(it.Val(), blobPrefix) || it.Val() == db.keygen.GetCacheInvalidationChannelKey() {
+				continue
+			}
+
+			keys = append(keys, it.Val())
+
+			if len(keys) == 1000 {
+				if err := db.client.Del(context.Background(), keys...).Err(); err != nil {
+					return err
+				}
+				keys = []string{}
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			return err
+		}
+	}
+
+	if len(keys) > 0 {
+		return db.client.Del(context.Background(), keys...).Err()
+	}
+
+	return nil
+}
+
+// escapeScanPattern escapes the glob characters of a key, so it can prefix a SCAN MATCH pattern
+func escapeScanPattern(key string) string {
+	var b strings.Builder
+	for _, c := range key {
+		if strings.ContainsRune(`*?[]\`, c) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
 func (db *RedisDatabase) CreateEntity(entityType, parentId, name string) {
 	entityId := uuid.New().String()
 
@@ -411,7 +736,11 @@ func (db *RedisDatabase) CreateEntity(entityType, parentId, name string) {
 	}
 
 	db.client.SAdd(context.Background(), db.keygen.GetEntityTypeKey(entityType), entityId)
-	db.client.Set(context.Background(), db.keygen.GetEntityKey(entityId), base64.StdEncoding.EncodeToString(b), 0)
+	db.client.SAdd(context.Background(), db.keygen.GetEntityNameKey(parentId, name), entityId)
+	db.client.SAdd(context.Background(), db.keygen.GetNameIndexedParentsKey(), entityId)
+	db.writeInvalidating(func(pipe redis.Pipeliner) {
+		pipe.Set(context.Background(), db.keygen.GetEntityKey(entityId), base64.StdEncoding.EncodeToString(b), 0)
+	}, db.keygen.GetEntityKey(entityId))
 
 	if parentId != "" {
 		parent := db.GetEntity(parentId)
@@ -422,9 +751,15 @@ func (db *RedisDatabase) CreateEntity(entityType, parentId, name string) {
 			Error("[RedisDatabase::CreateEntity] Failed to get parent entity: %v", parentId)
 		}
 	}
+
+	db.publishEntityEvent(DatabaseEntityEvent_CREATED, p, nil)
 }
 
 func (db *RedisDatabase) GetEntity(entityId string) *DatabaseEntity {
+	if cached := db.cache.get(db.keygen.GetEntityKey(entityId)); cached != nil {
+		return cached.(*DatabaseEntity)
+	}
+
 	e, err := db.client.Get(context.Background(), db.keygen.GetEntityKey(entityId)).Result()
 	if err != nil {
 		Error("[RedisDatabase::GetEntity] Failed to get entity: %v", err)
@@ -444,9 +779,66 @@ func (db *RedisDatabase) GetEntity(entityId string) *DatabaseEntity {
 		return nil
 	}
 
+	db.cache.put(db.keygen.GetEntityKey(entityId), p)
 	return p
 }
 
+// GetEntities fetches several entities in a single round trip. Entities that don't exist are nil.
+func (db *RedisDatabase) GetEntities(entityIds []string) []*DatabaseEntity {
+	entities := make([]*DatabaseEntity, len(entityIds))
+	if len(entityIds) == 0 {
+		return entities
+	}
+
+	// Only the entities missing from the cache are fetched
+	keys := []string{}
+	indexes := []int{}
+	for i, entityId := range entityIds {
+		if cached := db.cache.get(db.keygen.GetEntityKey(entityId)); cached != nil {
+			entities[i] = cached.(*DatabaseEntity)
+			continue
+		}
+
+		keys = append(keys, db.keygen.GetEntityKey(entityId))
+		indexes = append(indexes, i)
+	}
+
+	if len(keys) == 0 {
+		return entities
+	}
+
+	values, err := db.client.MGet(context.Background(), keys...).Result()
+	if err != nil {
+		Error("[RedisDatabase::GetEntities] Failed to get entities: %v", err)
+		return entities
+	}
+
+	for j, value := range values {
+		i := indexes[j]
+		e, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		b, err := base64.StdEncoding.DecodeString(e)
+		if err != nil {
+			Error("[RedisDatabase::GetEntities] Failed to decode entity: %v", err)
+			continue
+		}
+
+		p := &DatabaseEntity{}
+		if err := proto.Unmarshal(b, p); err != nil {
+			Error("[RedisDatabase::GetEntities] Failed to unmarshal entity: %v", err)
+			continue
+		}
+
+		db.cache.put(keys[j], p)
+		entities[i] = p
+	}
+
+	return entities
+}
+
 func (db *RedisDatabase) SetEntity(entityId string, value *DatabaseEntity) {
 	b, err := proto.Marshal(value)
 	if err != nil {
@@ -454,20 +846,56 @@ func (db *RedisDatabase) SetEntity(entityId string, value *DatabaseEntity) {
 		return
 	}
 
-	err = db.client.Set(context.Background(), db.keygen.GetEntityKey(entityId), base64.StdEncoding.EncodeToString(b), 0).Err()
+	var oldValue *DatabaseEntity
+	if db.EntityExists(entityId) {
+		oldValue = db.GetEntity(entityId)
+	}
+
+	err = db.writeInvalidating(func(pipe redis.Pipeliner) {
+		pipe.Set(context.Background(), db.keygen.GetEntityKey(entityId), base64.StdEncoding.EncodeToString(b), 0)
+	}, db.keygen.GetEntityKey(entityId))
 	if err != nil {
 		Error("[RedisDatabase::SetEntity] Failed to set entity '%s': %v", entityId, err)
 		return
 	}
+
+	db.updateNameIndex(entityId, oldValue, value)
 }
 
+// DeleteEntity deletes an entity and its subtree. References to the deleted entities are
+// handled by the onDelete policy of the referencing field, and nothing is deleted if one of
+// them restricts the deletion.
 func (db *RedisDatabase) DeleteEntity(entityId string) {
+	if !db.EntityExists(entityId) {
+		Error("[RedisDatabase::DeleteEntity] Failed to get entity: %v", entityId)
+		return
+	}
+
+	deleted := db.collectDeletions(entityId)
+	if referrer := db.findRestrictingReferrer(deleted); referrer != nil {
+		Error("[RedisDatabase::DeleteEntity] Cannot delete entity %s: an entity being deleted is referenced by %s.%s", entityId, referrer.EntityId, referrer.Field)
+		return
+	}
+
+	db.deleteEntity(entityId, deleted)
+
+	// Entities deleted by cascade outside of the subtree
+	for id := range deleted {
+		if db.EntityExists(id) {
+			db.deleteEntity(id, deleted)
+		}
+	}
+}
+
+func (db *RedisDatabase) deleteEntity(entityId string, deleted map[string]bool) {
 	p := db.GetEntity(entityId)
 	if p == nil {
-		Error("[RedisDatabase::DeleteEntity] Failed to get entity: %v", entityId)
+		Error("[RedisDatabase::deleteEntity] Failed to get entity: %v", entityId)
 		return
 	}
 
+	db.releaseReferrers(entityId, deleted)
+
 	parent := db.GetEntity(p.Parent.Raw)
 	if parent != nil {
 		newChildren := []*EntityReference{}
@@ -481,15 +909,43 @@ func (db *RedisDatabase) DeleteEntity(entityId string) {
 	}
 
 	for _, child := range p.Children {
-		db.DeleteEntity(child.Raw)
+		db.deleteEntity(child.Raw, deleted)
 	}
 
-	for _, fieldName := range db.GetEntitySchema(p.Type).Fields {
-		db.client.Del(context.Background(), db.keygen.GetFieldKey(fieldName, entityId))
-	}
+	db.deleteFields(p.Type, entityId)
 
 	db.client.SRem(context.Background(), db.keygen.GetEntityTypeKey(p.Type), entityId)
-	db.client.Del(context.Background(), db.keygen.GetEntityKey(entityId))
+	db.client.SRem(context.Background(), db.keygen.GetEntityNameKey(p.Parent.GetRaw(), p.Name), entityId)
+	db.client.SRem(context.Background(), db.keygen.GetNameIndexedParentsKey(), entityId)
+	db.writeInvalidating(func(pipe redis.Pipeliner) {
+		pipe.Del(context.Background(), db.keygen.GetEntityKey(entityId))
+	}, db.keygen.GetEntityKey(entityId))
+
+	db.publishEntityEvent(DatabaseEntityEvent_DELETED, nil, p)
+}
+
+// deleteFields removes the field values of an entity along with their blobs, indexes and dependencies
+func (db *RedisDatabase) deleteFields(entityType, entityId string) {
+	schema := db.GetEntitySchema(entityType)
+	if schema == nil {
+		return
+	}
+
+	for _, fieldName := range schema.Fields {
+		db.deleteField(fieldName, entityId)
+	}
+}
+
+// deleteField removes a field value along with its blob, index entries, references and dependencies
+func (db *RedisDatabase) deleteField(fieldName, entityId string) {
+	db.releaseFieldBlob(fieldName, entityId)
+	db.removeFieldIndex(fieldName, entityId)
+	db.removeFieldReferences(fieldName, entityId)
+	db.clearDependencies(fieldName, entityId)
+	db.client.Del(context.Background(), db.keygen.GetFieldDependentsKey(fieldName, entityId))
+	db.writeInvalidating(func(pipe redis.Pipeliner) {
+		pipe.Del(context.Background(), db.keygen.GetFieldKey(fieldName, entityId))
+	}, db.keygen.GetFieldKey(fieldName, entityId))
 }
 
 func (db *RedisDatabase) FindEntities(entityType string) []string {
@@ -497,6 +953,10 @@ func (db *RedisDatabase) FindEntities(entityType string) []string {
 }
 
 func (db *RedisDatabase) EntityExists(entityId string) bool {
+	if db.cache.get(db.keygen.GetEntityKey(entityId)) != nil {
+		return true
+	}
+
 	e, err := db.client.Get(context.Background(), db.keygen.GetEntityKey(entityId)).Result()
 	if err != nil {
 		return false
@@ -528,7 +988,7 @@ func (db *RedisDatabase) FieldExists(fieldName, entityType string) bool {
 }
 
 func (db *RedisDatabase) GetFieldSchemas() []*DatabaseFieldSchema {
-	it := db.client.Scan(context.Background(), 0, db.keygen.GetFieldSchemaKey("*"), 0).Iterator()
+	it := db.scanner().Scan(context.Background(), 0, db.keygen.GetFieldSchemaKey("*"), 0).Iterator()
 	schemas := []*DatabaseFieldSchema{}
 
 	for it.Next(context.Background()) {
@@ -559,6 +1019,10 @@ func (db *RedisDatabase) GetFieldSchemas() []*DatabaseFieldSchema {
 }
 
 func (db *RedisDatabase) GetFieldSchema(fieldName string) *DatabaseFieldSchema {
+	if cached := db.cache.get(db.keygen.GetFieldSchemaKey(fieldName)); cached != nil {
+		return cached.(*DatabaseFieldSchema)
+	}
+
 	e, err := db.client.Get(context.Background(), db.keygen.GetFieldSchemaKey(fieldName)).Result()
 	if err != nil {
 		Error("[RedisDatabase::GetFieldSchema] Failed to get field schema: %v", err)
@@ -578,6 +1042,7 @@ func (db *RedisDatabase) GetFieldSchema(fieldName string) *DatabaseFieldSchema {
 		return nil
 	}
 
+	db.cache.put(db.keygen.GetFieldSchemaKey(fieldName), a)
 	return a
 }
 
@@ -588,11 +1053,37 @@ func (db *RedisDatabase) SetFieldSchema(fieldName string, value *DatabaseFieldSc
 		return
 	}
 
-	db.client.Set(context.Background(), db.keygen.GetFieldSchemaKey(fieldName), base64.StdEncoding.EncodeToString(b), 0)
+	if value.Computed != nil {
+		if cycle := db.findComputedFieldCycle(value); cycle != nil {
+			Error("[RedisDatabase::SetFieldSchema] Computed field %s has a dependency cycle: %s", fieldName, strings.Join(cycle, " -> "))
+			return
+		}
+	}
+
+	var oldSchema *DatabaseFieldSchema
+	if db.client.Exists(context.Background(), db.keygen.GetFieldSchemaKey(fieldName)).Val() > 0 {
+		oldSchema = db.GetFieldSchema(fieldName)
+	}
+
+	db.writeInvalidating(func(pipe redis.Pipeliner) {
+		pipe.Set(context.Background(), db.keygen.GetFieldSchemaKey(fieldName), base64.StdEncoding.EncodeToString(b), 0)
+	}, db.keygen.GetFieldSchemaKey(fieldName))
+
+	if oldSchema.GetComputed() != nil || value.Computed != nil {
+		db.refreshComputedField(fieldName)
+	}
+
+	if oldSchema.GetIndex() != value.Index || (value.Index != DatabaseFieldSchema_UNSPECIFIED && oldSchema.GetComputed().IsEvaluatedOnRead() != value.Computed.IsEvaluatedOnRead()) {
+		db.rebuildIndex(value)
+	}
+
+	if oldSchema.GetType() != value.Type && (isReferenceType(oldSchema.GetType()) || isReferenceType(value.Type)) {
+		db.rebuildReferrers(fieldName)
+	}
 }
 
 func (db *RedisDatabase) GetEntityTypes() []string {
-	it := db.client.Scan(context.Background(), 0, db.keygen.GetEntitySchemaKey("*"), 0).Iterator()
+	it := db.scanner().Scan(context.Background(), 0, db.keygen.GetEntitySchemaKey("*"), 0).Iterator()
 	types := []string{}
 
 	for it.Next(context.Background()) {
@@ -603,6 +1094,10 @@ func (db *RedisDatabase) GetEntityTypes() []string {
 }
 
 func (db *RedisDatabase) GetEntitySchema(entityType string) *DatabaseEntitySchema {
+	if cached := db.cache.get(db.keygen.GetEntitySchemaKey(entityType)); cached != nil {
+		return cached.(*DatabaseEntitySchema)
+	}
+
 	e, err := db.client.Get(context.Background(), db.keygen.GetEntitySchemaKey(entityType)).Result()
 	if err != nil {
 		Error("[RedisDatabase::GetEntitySchema] Failed to get entity schema (%v): %v", entityType, err)
@@ -622,6 +1117,7 @@ func (db *RedisDatabase) GetEntitySchema(entityType string) *DatabaseEntitySchem
 		return nil
 	}
 
+	db.cache.put(db.keygen.GetEntitySchemaKey(entityType), p)
 	return p
 }
 
@@ -651,7 +1147,7 @@ func (db *RedisDatabase) SetEntitySchema(entityType string, value *DatabaseEntit
 
 		for _, entityId := range db.FindEntities(entityType) {
 			for _, field := range removedFields {
-				db.client.Del(context.Background(), db.keygen.GetFieldKey(field, entityId))
+				db.deleteField(field, entityId)
 			}
 
 			for _, field := range newFields {
@@ -664,45 +1160,103 @@ func (db *RedisDatabase) SetEntitySchema(entityType string, value *DatabaseEntit
 		}
 	}
 
-	db.client.Set(context.Background(), db.keygen.GetEntitySchemaKey(entityType), base64.StdEncoding.EncodeToString(b), 0)
+	db.writeInvalidating(func(pipe redis.Pipeliner) {
+		pipe.Set(context.Background(), db.keygen.GetEntitySchemaKey(entityType), base64.StdEncoding.EncodeToString(b), 0)
+	}, db.keygen.GetEntitySchemaKey(entityType))
 }
 
+// Read resolves the requested fields and fetches them from Redis in a single round trip
 func (db *RedisDatabase) Read(requests []*DatabaseRequest) {
-	for _, request := range requests {
+	indirectFields := make([]string, len(requests))
+	indirectEntities := make([]string, len(requests))
+	cached := make([]*DatabaseField, len(requests))
+	keys := []string{}
+
+	resolver := db.newIndirectionResolver()
+	for i, request := range requests {
 		request.Success = false
 
-		indirectField, indirectEntity := db.ResolveIndirection(request.Field, request.Id)
+		var err error
+		indirectFields[i], indirectEntities[i], err = resolver.resolve(request.Field, request.Id)
 
-		if indirectField == "" || indirectEntity == "" {
-			Error("[RedisDatabase::Read] Failed to resolve indirection: %v", request)
+		if err != nil {
+			Error("[RedisDatabase::Read] Failed to resolve %v", err)
 			continue
 		}
 
-		e, err := db.client.Get(context.Background(), db.keygen.GetFieldKey(indirectField, indirectEntity)).Result()
-		if err != nil {
-			if err != redis.Nil {
-				Error("[RedisDatabase::Read] Failed to read field: %v", err)
-			} else {
-				// If we can't read because the key doesn't exist, it's not a necessarily an issue.
-				// It would be good to know from a troubleshooting aspect though.
-				Trace("[RedisDatabase::Read] Failed to read field: %v", err)
-			}
+		if indirectFields[i] == "" || indirectEntities[i] == "" {
+			Error("[RedisDatabase::Read] Failed to resolve indirection: %v", request)
 			continue
 		}
 
-		b, err := base64.StdEncoding.DecodeString(e)
-		if err != nil {
-			Error("[RedisDatabase::Read] Failed to decode field: %v", err)
+		key := db.keygen.GetFieldKey(indirectFields[i], indirectEntities[i])
+		if field := db.cache.get(key); field != nil {
+			cached[i] = field.(*DatabaseField)
 			continue
 		}
 
-		p := &DatabaseField{}
-		err = proto.Unmarshal(b, p)
+		keys = append(keys, key)
+	}
+
+	values := []interface{}{}
+	if len(keys) > 0 {
+		var err error
+		values, err = db.client.MGet(context.Background(), keys...).Result()
 		if err != nil {
-			Error("[RedisDatabase::Read] Failed to unmarshal field: %v", err)
+			Error("[RedisDatabase::Read] Failed to read fields: %v", err)
+			return
+		}
+	}
+
+	for i, request := range requests {
+		indirectField, indirectEntity := indirectFields[i], indirectEntities[i]
+		if indirectField == "" || indirectEntity == "" {
 			continue
 		}
 
+		p := cached[i]
+		if p == nil {
+			value := values[0]
+			values = values[1:]
+
+			e, ok := value.(string)
+			if !ok {
+				// If we can't read because the key doesn't exist, it's not a necessarily an issue.
+				// It would be good to know from a troubleshooting aspect though.
+				Trace("[RedisDatabase::Read] Failed to read field: %v", redis.Nil)
+				continue
+			}
+
+			b, err := base64.StdEncoding.DecodeString(e)
+			if err != nil {
+				Error("[RedisDatabase::Read] Failed to decode field: %v", err)
+				continue
+			}
+
+			p = &DatabaseField{}
+			err = proto.Unmarshal(b, p)
+			if err != nil {
+				Error("[RedisDatabase::Read] Failed to unmarshal field: %v", err)
+				continue
+			}
+
+			db.cache.put(db.keygen.GetFieldKey(indirectField, indirectEntity), p)
+		}
+
+		if p.Computed {
+			schema := db.GetFieldSchema(indirectField)
+			if schema == nil || schema.Computed == nil {
+				Error("[RedisDatabase::Read] Failed to get computed field schema for %s", indirectField)
+				continue
+			}
+
+			p.Value = db.evaluateComputedField(schema, indirectEntity)
+			if p.Value == nil {
+				continue
+			}
+			p.WriteTime = timestamppb.Now()
+		}
+
 		request.Value = p.Value
 
 		if request.WriteTime == nil {
@@ -735,6 +1289,16 @@ func (db *RedisDatabase) Write(requests []*DatabaseRequest) {
 			continue
 		}
 
+		if schema.Computed != nil && request.Value != nil {
+			Error("[RedisDatabase::Write] Field %s is computed and cannot be written", indirectField)
+			continue
+		}
+
+		if schema.Computed.IsEvaluatedOnChange() {
+			request.Value = db.evaluateComputedField(schema, indirectEntity)
+			db.updateDependencies(schema, indirectEntity)
+		}
+
 		actualFieldType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(schema.Type))
 		if err != nil {
 			Error("[RedisDatabase::Write] Failed to find message type %s: %v", schema.Type, err)
@@ -756,6 +1320,12 @@ func (db *RedisDatabase) Write(requests []*DatabaseRequest) {
 			if request.Value.TypeUrl != sampleAnyType.TypeUrl && !sampleAnyType.MessageIs(&Transformation{}) {
 				Warn("[RedisDatabase::Write] Field type mismatch for %s.%s. Got: %v, Expected: %v. Writing default value instead.", request.Id, request.Field, request.Value.TypeUrl, sampleAnyType.TypeUrl)
 				request.Value = sampleAnyType
+			} else if len(schema.EnumValues) > 0 && request.Value.MessageIs(&Enum{}) {
+				enumValue := ValueCast[*Enum](request.Value)
+				if _, ok := schema.EnumName(enumValue.GetRaw()); !ok {
+					Error("[RedisDatabase::Write] Invalid enum value %d for %s.%s", enumValue.GetRaw(), request.Id, request.Field)
+					continue
+				}
 			}
 		}
 
@@ -777,7 +1347,7 @@ func (db *RedisDatabase) Write(requests []*DatabaseRequest) {
 		// Note that for a transformation, we don't actually write the value to the database
 		// unless the new value is a transformation. This is because the transformation is
 		// executed by the transformer, which will write the result to the database.
-		if oldRequest.Success && oldRequest.Value.MessageIs(&Transformation{}) && !request.Value.MessageIs(&Transformation{}) {
+		if !db.muteTransformations && oldRequest.Success && oldRequest.Value.MessageIs(&Transformation{}) && !request.Value.MessageIs(&Transformation{}) {
 			transformation := ValueCast[*Transformation](oldRequest.Value)
 			field := NewField(db, request.Id, request.Field)
 			field.req = &DatabaseRequest{
@@ -790,7 +1360,10 @@ func (db *RedisDatabase) Write(requests []*DatabaseRequest) {
 			request.Value = oldRequest.Value
 		}
 
+		db.updateBlobReferences(request, oldRequest)
+
 		p := new(DatabaseField).FromRequest(request)
+		p.Computed = schema.Computed.IsEvaluatedOnRead()
 
 		b, err := proto.Marshal(p)
 		if err != nil {
@@ -801,17 +1374,156 @@ func (db *RedisDatabase) Write(requests []*DatabaseRequest) {
 		p.Id = indirectEntity
 		p.Name = indirectField
 
-		_, err = db.client.Set(context.Background(), db.keygen.GetFieldKey(indirectField, indirectEntity), base64.StdEncoding.EncodeToString(b), 0).Result()
+		err = db.writeInvalidating(func(pipe redis.Pipeliner) {
+			pipe.Set(context.Background(), db.keygen.GetFieldKey(indirectField, indirectEntity), base64.StdEncoding.EncodeToString(b), 0)
+		}, db.keygen.GetFieldKey(indirectField, indirectEntity))
 
 		// Notify listeners of the change
-		db.triggerNotifications(request, oldRequest)
+		// Fields computed on read only hold a placeholder, so there is nothing to notify about
+		if !p.Computed && !db.muteNotifications {
+			db.triggerNotifications(request, oldRequest)
+		}
 
 		if err != nil {
 			Error("[RedisDatabase::Write] Failed to write field: %v", err)
 			continue
 		}
 		request.Success = true
+
+		db.updateIndex(schema, request, oldRequest, indirectEntity)
+		db.updateReferences(request, oldRequest, indirectField, indirectEntity)
+		db.recomputeDependents(indirectField, indirectEntity)
+	}
+}
+
+func (db *RedisDatabase) WriteBlob(content io.Reader, mimeType string) *BinaryFile {
+	hash, size, ok := db.blobs.Put(content)
+	if !ok {
+		Error("[RedisDatabase::WriteBlob] Failed to store blob")
+		return nil
+	}
+
+	return &BinaryFile{
+		Hash:     hash,
+		Size:     size,
+		MimeType: mimeType,
+	}
+}
+
+// ReadBlob writes the content of a BinaryFile to w. Files without a hash were stored
+// inline as a data URL before the blob store existed and are decoded directly.
+func (db *RedisDatabase) ReadBlob(file *BinaryFile, w io.Writer) bool {
+	if file.GetHash() == "" {
+		if _, err := w.Write(FileDecode(file.GetRaw())); err != nil {
+			Error("[RedisDatabase::ReadBlob] Failed to write inline file: %v", err)
+			return false
+		}
+
+		return true
+	}
+
+	return db.blobs.Get(file.GetHash(), w)
+}
+
+// DiscardBlob deletes the content stored by WriteBlob when the file could not be written to a field.
+// Content that a field references, possibly through another upload of the same data, is kept.
+func (db *RedisDatabase) DiscardBlob(file *BinaryFile) {
+	if file.GetHash() == "" {
+		return
+	}
+
+	if db.client.Exists(context.Background(), db.keygen.GetBlobRefCountKey(file.GetHash())).Val() == 0 {
+		Debug("[RedisDatabase::DiscardBlob] Deleting unreferenced blob %s", file.GetHash())
+		db.blobs.Delete(file.GetHash())
+	}
+}
+
+func (db *RedisDatabase) updateBlobReferences(request *DatabaseRequest, oldRequest *DatabaseRequest) {
+	newHash := blobHash(request.Value)
+	oldHash := ""
+	if oldRequest.Success {
+		oldHash = blobHash(oldRequest.Value)
+	}
+
+	if newHash == oldHash {
+		return
+	}
+
+	if newHash != "" {
+		db.client.Incr(context.Background(), db.keygen.GetBlobRefCountKey(newHash))
+	}
+
+	if oldHash != "" {
+		db.releaseBlob(oldHash)
+	}
+}
+
+func (db *RedisDatabase) releaseFieldBlob(fieldName, entityId string) {
+	request := &DatabaseRequest{
+		Id:    entityId,
+		Field: fieldName,
+	}
+	db.Read([]*DatabaseRequest{request})
+
+	if request.Success {
+		if hash := blobHash(request.Value); hash != "" {
+			db.releaseBlob(hash)
+		}
+	}
+}
+
+func (db *RedisDatabase) releaseBlob(hash string) {
+	refs, err := db.client.Decr(context.Background(), db.keygen.GetBlobRefCountKey(hash)).Result()
+	if err != nil {
+		Error("[RedisDatabase::releaseBlob] Failed to release blob %s: %v", hash, err)
+		return
+	}
+
+	if refs <= 0 {
+		Debug("[RedisDatabase::releaseBlob] Deleting unreferenced blob %s", hash)
+		db.client.Del(context.Background(), db.keygen.GetBlobRefCountKey(hash))
+		db.blobs.Delete(hash)
+	}
+}
+
+// releaseOrphanedBlobs deletes the blobs stored in Redis that no field references, such as the
+// blobs kept by flush that the restored snapshot does not use
+func (db *RedisDatabase) releaseOrphanedBlobs() {
+	db.blobs.ForEach(func(hash string) {
+		if db.client.Exists(context.Background(), db.keygen.GetBlobRefCountKey(hash)).Val() == 0 {
+			Debug("[RedisDatabase::releaseOrphanedBlobs] Deleting unreferenced blob %s", hash)
+			db.blobs.Delete(hash)
+		}
+	})
+}
+
+func blobHash(value *anypb.Any) string {
+	if value == nil || !value.MessageIs(&BinaryFile{}) {
+		return ""
+	}
+
+	return ValueCast[*BinaryFile](value).GetHash()
+}
+
+// BlobExists reports whether the content of a BinaryFile can be read
+func (db *RedisDatabase) BlobExists(file *BinaryFile) bool {
+	return file.GetHash() == "" || db.blobs.Exists(file.GetHash())
+}
+
+// MissingSnapshotBlobs returns the hashes of the BinaryFile values of a snapshot whose content is
+// not in the blob store of the database. Snapshots only hold these hashes, so they cannot be
+// restored without the content in another database.
+func MissingSnapshotBlobs(db IDatabase, snapshot *DatabaseSnapshot) []string {
+	missing := []string{}
+
+	for _, field := range snapshot.Fields {
+		hash := blobHash(field.Value)
+		if hash != "" && !slices.Contains(missing, hash) && !db.BlobExists(&BinaryFile{Hash: hash}) {
+			missing = append(missing, hash)
+		}
 	}
+
+	return missing
 }
 
 func (db *RedisDatabase) Notify(notification *DatabaseNotificationConfig, callback INotificationCallback) INotificationToken {
@@ -895,6 +1607,7 @@ func (db *RedisDatabase) UnnotifyCallback(e string, c INotificationCallback) {
 
 func (db *RedisDatabase) ProcessNotifications() {
 	db.transformer.ProcessPending()
+	db.processInvalidations()
 
 	r, err := db.client.XRead(context.Background(), &redis.XReadArgs{
 		Streams: []string{db.keygen.GetNotificationChannelKey(db.getServiceId()), db.lastStreamMessageId},
@@ -943,77 +1656,16 @@ func (db *RedisDatabase) ProcessNotifications() {
 	}
 }
 
+// ResolveIndirection returns the field and entity an indirect field such as "Parent->[Sensor]->Value"
+// points to, or empty strings if a hop cannot be resolved. See indirectionResolver for the syntax.
 func (db *RedisDatabase) ResolveIndirection(indirectField, entityId string) (string, string) {
-	fields := strings.Split(indirectField, "->")
-
-	if len(fields) == 1 {
-		return indirectField, entityId
-	}
-
-	for _, field := range fields[:len(fields)-1] {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: field,
-		}
-
-		db.Read([]*DatabaseRequest{request})
-
-		if request.Success {
-			entityReference := &EntityReference{}
-			if request.Value.MessageIs(entityReference) {
-				err := request.Value.UnmarshalTo(entityReference)
-				if err != nil {
-					Error("[RedisDatabase::ResolveIndirection] Failed to unmarshal entity reference: %v", err)
-					return "", ""
-				}
-
-				entityId = entityReference.Raw
-				continue
-			}
-
-			Error("[RedisDatabase::ResolveIndirection] Field is not an entity reference: %v", request)
-			return "", ""
-		}
-
-		// Fallback to parent entity reference by name
-		entity := db.GetEntity(entityId)
-		if entity == nil {
-			Error("[RedisDatabase::ResolveIndirection] Failed to get entity: %v", entityId)
-			return "", ""
-		}
-
-		if entity.Parent != nil && entity.Parent.Raw != "" {
-			parentEntity := db.GetEntity(entity.Parent.Raw)
-
-			if parentEntity != nil && parentEntity.Name == field {
-				entityId = entity.Parent.Raw
-				continue
-			}
-		}
-
-		// Fallback to child entity reference by name
-		foundChild := false
-		for _, child := range entity.Children {
-			childEntity := db.GetEntity(child.Raw)
-			if childEntity == nil {
-				Error("[RedisDatabase::ResolveIndirection] Failed to get child entity: %v", child.Raw)
-				continue
-			}
-
-			if childEntity.Name == field {
-				entityId = child.Raw
-				foundChild = true
-				break
-			}
-		}
-
-		if !foundChild {
-			Error("[RedisDatabase::ResolveIndirection] Failed to find child entity: %v", field)
-			return "", ""
-		}
+	field, entity, err := db.newIndirectionResolver().resolve(indirectField, entityId)
+	if err != nil {
+		Error("[RedisDatabase::ResolveIndirection] Failed to resolve %v", err)
+		return "", ""
 	}
 
-	return fields[len(fields)-1], entityId
+	return field, entity
 }
 
 func (db *RedisDatabase) triggerNotifications(request *DatabaseRequest, oldRequest *DatabaseRequest) {
@@ -1160,7 +1812,7 @@ func (db *RedisDatabase) triggerNotifications(request *DatabaseRequest, oldReque
 }
 
 func (db *RedisDatabase) TempSet(key, value string, expiration time.Duration) bool {
-	r, err := db.client.SetNX(context.Background(), key, value, expiration).Result()
+	r, err := db.client.SetNX(context.Background(), db.keygen.GetNamespacedKey(key), value, expiration).Result()
 	if err != nil {
 		return false
 	}
@@ -1169,7 +1821,7 @@ func (db *RedisDatabase) TempSet(key, value string, expiration time.Duration) bo
 }
 
 func (db *RedisDatabase) TempGet(key string) string {
-	r, err := db.client.Get(context.Background(), key).Result()
+	r, err := db.client.Get(context.Background(), db.keygen.GetNamespacedKey(key)).Result()
 	if err != nil {
 		return ""
 	}
@@ -1178,15 +1830,15 @@ func (db *RedisDatabase) TempGet(key string) string {
 }
 
 func (db *RedisDatabase) TempExpire(key string, expiration time.Duration) {
-	db.client.Expire(context.Background(), key, expiration)
+	db.client.Expire(context.Background(), db.keygen.GetNamespacedKey(key), expiration)
 }
 
 func (db *RedisDatabase) TempDel(key string) {
-	db.client.Del(context.Background(), key)
+	db.client.Del(context.Background(), db.keygen.GetNamespacedKey(key))
 }
 
 func (db *RedisDatabase) SortedSetAdd(key string, member string, score float64) int64 {
-	result, err := db.client.ZAdd(context.Background(), key, redis.Z{
+	result, err := db.client.ZAdd(context.Background(), db.keygen.GetNamespacedKey(key), redis.Z{
 		Score:  score,
 		Member: member,
 	}).Result()
@@ -1198,7 +1850,7 @@ func (db *RedisDatabase) SortedSetAdd(key string, member string, score float64)
 }
 
 func (db *RedisDatabase) SortedSetRemove(key string, member string) int64 {
-	result, err := db.client.ZRem(context.Background(), key, member).Result()
+	result, err := db.client.ZRem(context.Background(), db.keygen.GetNamespacedKey(key), member).Result()
 	if err != nil {
 		Error("[RedisDatabase::SortedSetRemove] Failed to remove member from sorted set: %v", err)
 		return 0
@@ -1207,7 +1859,7 @@ func (db *RedisDatabase) SortedSetRemove(key string, member string) int64 {
 }
 
 func (db *RedisDatabase) SortedSetRemoveRangeByRank(key string, start, stop int64) int64 {
-	result, err := db.client.ZRemRangeByRank(context.Background(), key, start, stop).Result()
+	result, err := db.client.ZRemRangeByRank(context.Background(), db.keygen.GetNamespacedKey(key), start, stop).Result()
 	if err != nil {
 		Error("[RedisDatabase::SortedSetRemoveRangeByRank] Failed to remove range from sorted set: %v", err)
 		return 0
@@ -1216,7 +1868,7 @@ func (db *RedisDatabase) SortedSetRemoveRangeByRank(key string, start, stop int6
 }
 
 func (db *RedisDatabase) SortedSetRangeByScoreWithScores(key string, min, max string) []SortedSetMember {
-	result, err := db.client.ZRangeByScoreWithScores(context.Background(), key, &redis.ZRangeBy{
+	result, err := db.client.ZRangeByScoreWithScores(context.Background(), db.keygen.GetNamespacedKey(key), &redis.ZRangeBy{
 		Min: min,
 		Max: max,
 	}).Result()
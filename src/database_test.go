@@ -1,12 +1,19 @@
 package qdb
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -395,3 +402,1657 @@ func TestRedisDatabase_RestoreSnapshot(t *testing.T) {
 	readReq.Value.UnmarshalTo(&readValue)
 	assert.Equal(t, "original-value", readValue.Raw)
 }
+
+func TestRedisDatabase_CompositeValues(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("int-list", &DatabaseFieldSchema{Name: "int-list", Type: "qdb.IntList"})
+	db.SetFieldSchema("string-list", &DatabaseFieldSchema{Name: "string-list", Type: "qdb.StringList"})
+	db.SetFieldSchema("string-map", &DatabaseFieldSchema{Name: "string-map", Type: "qdb.StringMap"})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"int-list", "string-list", "string-map"},
+	})
+
+	db.CreateEntity("test-type", "", "test-entity")
+	entityId := db.FindEntities("test-type")[0]
+	entity := NewEntity(db, entityId)
+
+	assert.True(t, entity.GetField("int-list").PushIntList([]int64{1, 2, 3}))
+	assert.True(t, entity.GetField("string-list").PushStringList([]interface{}{"a", "b"}))
+	assert.True(t, entity.GetField("string-map").PushStringMap(map[string]string{"k": "v"}))
+	assert.False(t, entity.GetField("int-list").PushIntList([]int64{1, 2, 3}, PushIfNotEqual))
+
+	assert.Equal(t, []int64{1, 2, 3}, entity.GetField("int-list").PullIntList())
+	assert.Equal(t, []string{"a", "b"}, entity.GetField("string-list").PullStringList())
+	assert.Equal(t, map[string]string{"k": "v"}, entity.GetField("string-map").PullStringMap())
+
+	found := NewEntityFinder(db).Find(SearchCriteria{
+		EntityType: "test-type",
+		Where: []*Condition{
+			NewIntListCondition().Where("int-list").Contains(2),
+			NewStringListCondition().Where("string-list").DoesNotContain("c"),
+			NewStringMapCondition().Where("string-map").HasEntry("k", "v"),
+		},
+	})
+	assert.Len(t, found, 1)
+
+	found = NewEntityFinder(db).Find(SearchCriteria{
+		EntityType: "test-type",
+		Where: []*Condition{
+			NewIntListCondition().Where("int-list").Contains(4),
+		},
+	})
+	assert.Empty(t, found)
+}
+
+func TestRedisDatabase_EnumValues(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("state", &DatabaseFieldSchema{
+		Name: "state",
+		Type: "qdb.Enum",
+		EnumValues: []*DatabaseEnumValue{
+			{Name: "Off", Value: 0},
+			{Name: "On", Value: 1},
+			{Name: "Fault", Value: 5},
+		},
+	})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"state"},
+	})
+
+	db.CreateEntity("test-type", "", "test-entity")
+	field := NewEntity(db, db.FindEntities("test-type")[0]).GetField("state")
+
+	assert.True(t, field.PushEnum("Fault"))
+	assert.Equal(t, int64(5), field.PullEnum())
+	assert.Equal(t, "Fault", field.PullEnumName())
+
+	assert.True(t, field.PushEnum(1))
+	assert.Equal(t, "On", field.PullEnumName())
+
+	assert.False(t, field.PushEnum("Unknown"))
+	assert.False(t, field.PushEnum(3))
+	assert.Equal(t, int64(1), field.PullEnum())
+}
+
+func TestRedisDatabase_BinaryFileBlobs(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("file", &DatabaseFieldSchema{Name: "file", Type: "qdb.BinaryFile"})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"file"},
+	})
+
+	db.CreateEntity("test-type", "", "entity-1")
+	db.CreateEntity("test-type", "", "entity-2")
+	entities := db.FindEntities("test-type")
+
+	// Content spanning more than one batch of chunks
+	content := strings.Repeat("x", BlobChunkSize*blobReadBatchSize+10)
+	for _, entityId := range entities {
+		field := NewEntity(db, entityId).GetField("file")
+		assert.True(t, field.PushBinaryFileContent(strings.NewReader(content), "text/plain"))
+	}
+
+	file := NewEntity(db, entities[0]).GetField("file").PullValue(new(BinaryFile)).(*BinaryFile)
+	assert.Equal(t, int64(len(content)), file.Size)
+	assert.Equal(t, "text/plain", file.MimeType)
+	assert.Equal(t, "2", db.TempGet(db.keygen.GetBlobRefCountKey(file.Hash)))
+
+	var b bytes.Buffer
+	assert.True(t, NewEntity(db, entities[1]).GetField("file").PullBinaryFileContent(&b))
+	assert.Equal(t, content, b.String())
+
+	// The blob is kept until the last reference to it is removed
+	db.DeleteEntity(entities[0])
+	assert.True(t, mr.Exists(db.keygen.GetBlobKey(file.Hash)))
+
+	NewEntity(db, entities[1]).GetField("file").PushBinaryFile(FileEncode([]byte("inline")))
+	assert.False(t, mr.Exists(db.keygen.GetBlobKey(file.Hash)))
+
+	b.Reset()
+	assert.True(t, NewEntity(db, entities[1]).GetField("file").PullBinaryFileContent(&b))
+	assert.Equal(t, "inline", b.String())
+
+	// Snapshots only hold the hash, so restoring one keeps the blobs it references
+	assert.True(t, NewEntity(db, entities[1]).GetField("file").PushBinaryFileContent(strings.NewReader(content), "text/plain"))
+	snapshot := db.CreateSnapshot()
+	db.CreateEntity("test-type", "", "entity-3")
+	added := slices.DeleteFunc(db.FindEntities("test-type"), func(id string) bool { return id == entities[1] })[0]
+	assert.True(t, NewEntity(db, added).GetField("file").PushBinaryFileContent(strings.NewReader("unused"), "text/plain"))
+	unused := NewEntity(db, added).GetField("file").PullValue(new(BinaryFile)).(*BinaryFile)
+
+	db.RestoreSnapshot(snapshot)
+	b.Reset()
+	assert.True(t, NewEntity(db, entities[1]).GetField("file").PullBinaryFileContent(&b))
+	assert.Equal(t, content, b.String())
+	assert.Equal(t, "1", db.TempGet(db.keygen.GetBlobRefCountKey(file.Hash)))
+	assert.False(t, mr.Exists(db.keygen.GetBlobKey(unused.Hash)))
+
+	var stream bytes.Buffer
+	assert.True(t, db.CreateSnapshotStream(&stream, nil))
+	assert.True(t, db.RestoreSnapshotStream(&stream, nil))
+	b.Reset()
+	assert.True(t, NewEntity(db, entities[1]).GetField("file").PullBinaryFileContent(&b))
+	assert.Equal(t, content, b.String())
+
+	// Content that could not be written to a field is discarded, unless a field references it
+	assert.False(t, NewField(db, entities[1], "missing-field").PushBinaryFileContent(strings.NewReader("orphan"), "text/plain"))
+	assert.False(t, NewField(db, entities[1], "missing-field").PushBinaryFileContent(strings.NewReader(content), "text/plain"))
+	keys := []string{}
+	for _, key := range mr.Keys() {
+		if strings.HasPrefix(key, db.keygen.GetBlobKey("")) {
+			keys = append(keys, key)
+		}
+	}
+	assert.Equal(t, []string{db.keygen.GetBlobKey(file.Hash)}, keys)
+
+	// Snapshots cannot be restored without the content they reference
+	assert.Empty(t, MissingSnapshotBlobs(db, snapshot))
+	mr.Del(db.keygen.GetBlobKey(file.Hash))
+	assert.Equal(t, []string{file.Hash}, MissingSnapshotBlobs(db, snapshot))
+
+	// Unreferenced blobs are released from a directory store too
+	db.blobs = NewDirectoryBlobStore(t.TempDir())
+	assert.True(t, NewEntity(db, entities[1]).GetField("file").PushBinaryFileContent(strings.NewReader("kept"), "text/plain"))
+	snapshot = db.CreateSnapshot()
+	kept := NewEntity(db, entities[1]).GetField("file").PullValue(new(BinaryFile)).(*BinaryFile)
+	db.CreateEntity("test-type", "", "entity-4")
+	added = slices.DeleteFunc(db.FindEntities("test-type"), func(id string) bool { return slices.Contains(entities, id) })[0]
+	assert.True(t, NewEntity(db, added).GetField("file").PushBinaryFileContent(strings.NewReader("released"), "text/plain"))
+	released := NewEntity(db, added).GetField("file").PullValue(new(BinaryFile)).(*BinaryFile)
+	assert.True(t, db.BlobExists(released))
+	db.RestoreSnapshot(snapshot)
+	assert.True(t, db.BlobExists(kept))
+	assert.False(t, db.BlobExists(released))
+}
+
+func TestDirectoryBlobStore(t *testing.T) {
+	store := NewDirectoryBlobStore(t.TempDir())
+
+	hash, size, ok := store.Put(strings.NewReader("content"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), size)
+	assert.True(t, store.Exists(hash))
+
+	hashes := []string{}
+	assert.True(t, store.ForEach(func(hash string) { hashes = append(hashes, hash) }))
+	assert.Equal(t, []string{hash}, hashes)
+
+	var b bytes.Buffer
+	assert.True(t, store.Get(hash, &b))
+	assert.Equal(t, "content", b.String())
+
+	store.Delete(hash)
+	assert.False(t, store.Exists(hash))
+	assert.False(t, store.Get("../../etc/passwd", &b))
+}
+
+func TestRedisDatabase_ComputedFields(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("a", &DatabaseFieldSchema{Name: "a", Type: "qdb.Int"})
+	db.SetFieldSchema("target", &DatabaseFieldSchema{Name: "target", Type: "qdb.EntityReference"})
+	db.SetFieldSchema("double", &DatabaseFieldSchema{
+		Name: "double",
+		Type: "qdb.Int",
+		Computed: &DatabaseComputedField{
+			Script:     `inputs["a"] * 2`,
+			Inputs:     []string{"a"},
+			Evaluation: DatabaseComputedField_ON_READ,
+		},
+	})
+	db.SetFieldSchema("total", &DatabaseFieldSchema{
+		Name: "total",
+		Type: "qdb.Int",
+		Computed: &DatabaseComputedField{
+			Script:     `result = is_undefined(inputs["target->a"]) ? 0 : inputs["target->a"] + 1`,
+			Inputs:     []string{"target->a"},
+			Evaluation: DatabaseComputedField_ON_CHANGE,
+		},
+	})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"a", "target", "double", "total"},
+	})
+
+	db.CreateEntity("test-type", "", "entity-1")
+	db.CreateEntity("test-type", "", "entity-2")
+	entities := db.FindEntities("test-type")
+	entity1 := NewEntity(db, entities[0])
+	entity2 := NewEntity(db, entities[1])
+
+	// Evaluated on read
+	assert.True(t, entity1.GetField("a").PushInt(21))
+	assert.Equal(t, int64(42), entity1.GetField("double").PullInt())
+	assert.False(t, entity1.GetField("double").PushInt(1))
+
+	// Evaluated when an input changes, including the references traversed to reach it
+	assert.Equal(t, int64(0), entity1.GetField("total").PullInt())
+	assert.True(t, entity2.GetField("a").PushInt(5))
+	assert.True(t, entity1.GetField("target").PushEntityReference(entity2.GetId()))
+	assert.Equal(t, int64(6), entity1.GetField("total").PullInt())
+	assert.True(t, entity2.GetField("a").PushInt(9))
+	assert.Equal(t, int64(10), entity1.GetField("total").PullInt())
+
+	// Restoring a snapshot evaluates computed fields again rather than writing their values
+	snapshot := db.CreateSnapshot()
+	for _, restore := range []func(){
+		func() { db.RestoreSnapshot(snapshot) },
+		func() {
+			var b bytes.Buffer
+			assert.True(t, db.CreateSnapshotStream(&b, nil))
+			assert.True(t, db.RestoreSnapshotStream(&b, nil))
+		},
+	} {
+		restore()
+		assert.Equal(t, int64(42), entity1.GetField("double").PullInt())
+		assert.Equal(t, int64(10), entity1.GetField("total").PullInt())
+
+		assert.True(t, entity1.GetField("a").PushInt(4))
+		assert.Equal(t, int64(8), entity1.GetField("double").PullInt())
+		assert.True(t, entity2.GetField("a").PushInt(3))
+		assert.Equal(t, int64(4), entity1.GetField("total").PullInt())
+
+		assert.True(t, entity1.GetField("a").PushInt(21))
+		assert.True(t, entity2.GetField("a").PushInt(9))
+	}
+
+	// Clones have their computed fields, and fields missing on the source get default values
+	clone := NewEntity(db, db.CloneEntity(entity1.GetId(), "", "clone", false))
+	assert.Equal(t, int64(42), clone.GetField("double").PullInt())
+	assert.Equal(t, int64(10), clone.GetField("total").PullInt())
+
+	mr.Del(db.keygen.GetFieldKey("a", clone.GetId()))
+	request := &DatabaseRequest{Id: db.CloneEntity(clone.GetId(), "", "clone-2", false), Field: "a"}
+	db.Read([]*DatabaseRequest{request})
+	assert.True(t, request.Success)
+	assert.Equal(t, int64(0), NewEntity(db, request.Id).GetField("double").PullInt())
+
+	// Scripts are recompiled when they change, and may only import the safe modules
+	db.SetFieldSchema("double", &DatabaseFieldSchema{
+		Name:     "double",
+		Type:     "qdb.Int",
+		Computed: &DatabaseComputedField{Script: `math := import("math"); result = int(math.pow(inputs["a"], 2))`, Inputs: []string{"a"}},
+	})
+	assert.Equal(t, int64(441), entity1.GetField("double").PullInt())
+	db.SetFieldSchema("double", &DatabaseFieldSchema{
+		Name:     "double",
+		Type:     "qdb.Int",
+		Computed: &DatabaseComputedField{Script: `os := import("os"); result = len(os.args())`, Inputs: []string{"a"}},
+	})
+	assert.Equal(t, int64(0), entity1.GetField("double").PullInt())
+
+	// Cycles are rejected
+	db.SetFieldSchema("x", &DatabaseFieldSchema{
+		Name:     "x",
+		Type:     "qdb.Int",
+		Computed: &DatabaseComputedField{Script: `inputs["y"]`, Inputs: []string{"y"}},
+	})
+	db.SetFieldSchema("y", &DatabaseFieldSchema{
+		Name:     "y",
+		Type:     "qdb.Int",
+		Computed: &DatabaseComputedField{Script: `inputs["x"]`, Inputs: []string{"x"}},
+	})
+	assert.NotNil(t, db.GetFieldSchema("x"))
+	assert.Nil(t, db.GetFieldSchema("y"))
+
+	// Only the field read at the end of an input counts, not the references followed to reach it
+	db.SetFieldSchema("target", &DatabaseFieldSchema{
+		Name:     "target",
+		Type:     "qdb.EntityReference",
+		Computed: &DatabaseComputedField{Script: `inputs["a"]`, Inputs: []string{"a"}},
+	})
+	db.SetFieldSchema("a", &DatabaseFieldSchema{
+		Name:     "a",
+		Type:     "qdb.Int",
+		Computed: &DatabaseComputedField{Script: `inputs["target->x"]`, Inputs: []string{"target->x"}},
+	})
+	assert.NotNil(t, db.GetFieldSchema("a").Computed)
+
+	// Scripts cannot write to the database
+	db.SetFieldSchema("a", &DatabaseFieldSchema{Name: "a", Type: "qdb.Int"})
+	db.SetFieldSchema("double", &DatabaseFieldSchema{
+		Name: "double",
+		Type: "qdb.Int",
+		Computed: &DatabaseComputedField{
+			Script: `entity.field("a").pushInt(7); qdb.entity(entity.id()).field("a").pushInt(7); result = 1`,
+			Inputs: []string{"a"},
+		},
+	})
+	assert.True(t, entity1.GetField("a").PushInt(3))
+	assert.Equal(t, int64(1), entity1.GetField("double").PullInt())
+	assert.Equal(t, int64(3), entity1.GetField("a").PullInt())
+	_, scheduled := db.computedScripts["double"].compiled.Get("qdb").Map()["schedule"]
+	assert.False(t, scheduled)
+}
+
+func TestRedisDatabase_EngineeringUnits(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("temperature", &DatabaseFieldSchema{
+		Name:             "temperature",
+		Type:             "qdb.Int",
+		Unit:             "C",
+		DisplayPrecision: 1,
+		Scale:            0.1,
+		AlarmLimits: []*DatabaseAlarmLimit{
+			{Level: DatabaseAlarmLimit_HIGH, Value: 80},
+			{Level: DatabaseAlarmLimit_HIGH_HIGH, Value: 95},
+		},
+	})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"temperature"},
+	})
+
+	db.CreateEntity("test-type", "", "test-entity")
+	field := NewEntity(db, db.FindEntities("test-type")[0]).GetField("temperature")
+
+	assert.True(t, field.PushInt(1000))
+	assert.InDelta(t, 100.0, field.PullFloatIn(""), 1e-9)
+	assert.InDelta(t, 212.0, field.PullFloatIn("F"), 1e-9)
+	assert.InDelta(t, 373.15, field.PullFloatIn("K"), 1e-9)
+	assert.Equal(t, float64(0), field.PullFloatIn("psi"))
+
+	schema := db.GetFieldSchema("temperature")
+	assert.Equal(t, DatabaseAlarmLimit_HIGH_HIGH, schema.AlarmLevel(100))
+	assert.Equal(t, DatabaseAlarmLimit_HIGH, schema.AlarmLevel(85))
+	assert.Equal(t, DatabaseAlarmLimit_UNSPECIFIED, schema.AlarmLevel(20))
+	assert.Equal(t, int64(250), int64(schema.FromEngineering(25)))
+
+	v, ok := ConvertUnit(1, "bar", "kPa")
+	assert.True(t, ok)
+	assert.InDelta(t, 100.0, v, 1e-9)
+	_, ok = ConvertUnit(1, "bar", "s")
+	assert.False(t, ok)
+}
+
+func TestRedisDatabase_SecondaryIndexes(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("name", &DatabaseFieldSchema{Name: "name", Type: "qdb.String", Index: DatabaseFieldSchema_HASH})
+	db.SetFieldSchema("level", &DatabaseFieldSchema{Name: "level", Type: "qdb.Int"})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"name", "level"},
+	})
+
+	for i := 0; i < 5; i++ {
+		db.CreateEntity("test-type", "", "entity")
+	}
+	entities := db.FindEntities("test-type")
+	for i, entityId := range entities {
+		NewEntity(db, entityId).GetField("name").PushString([]string{"a", "b"}[i%2])
+		NewEntity(db, entityId).GetField("level").PushInt(i)
+	}
+
+	// Indexes can be added after the fact
+	db.SetFieldSchema("level", &DatabaseFieldSchema{Name: "level", Type: "qdb.Int", Index: DatabaseFieldSchema_SORTED})
+
+	matches, ok := db.FindEntitiesByIndex("test-type", "name", []*anypb.Any{NewStringValue("a")})
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []string{entities[0], entities[2], entities[4]}, matches)
+
+	matches, ok = db.FindEntitiesByRange("test-type", "level", 1, 3)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, entities[1:4], matches)
+
+	NewEntity(db, entities[0]).GetField("name").PushString("b")
+	found := NewEntityFinder(db).Find(SearchCriteria{
+		EntityType: "test-type",
+		Where: []*Condition{
+			NewStringCondition().Where("name").IsEqualTo(&String{Raw: "a"}),
+			NewIntCondition().Where("level").IsGreaterThan(&Int{Raw: 2}),
+		},
+	})
+	assert.Len(t, found, 1)
+	assert.Equal(t, entities[4], found[0].GetId())
+
+	db.DeleteEntity(entities[4])
+	matches, _ = db.FindEntitiesByIndex("test-type", "name", []*anypb.Any{NewStringValue("a")})
+	assert.ElementsMatch(t, []string{entities[2]}, matches)
+	matches, _ = db.FindEntitiesByRange("test-type", "level", 0, 10)
+	assert.Len(t, matches, 4)
+}
+
+func TestRedisDatabase_Query(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("Temperature", &DatabaseFieldSchema{Name: "Temperature", Type: "qdb.Float", Index: DatabaseFieldSchema_SORTED})
+	db.SetFieldSchema("Disabled", &DatabaseFieldSchema{Name: "Disabled", Type: "qdb.Bool"})
+	db.SetEntitySchema("Building", &DatabaseEntitySchema{Name: "Building"})
+	db.SetEntitySchema("Sensor", &DatabaseEntitySchema{
+		Name:   "Sensor",
+		Fields: []string{"Temperature", "Disabled"},
+	})
+
+	db.CreateEntity("Building", "", "Building1")
+	db.CreateEntity("Building", "", "Building2")
+	buildings := map[string]string{}
+	for _, entityId := range db.FindEntities("Building") {
+		buildings[db.GetEntity(entityId).Name] = entityId
+	}
+
+	temperatures := map[string]float64{"S1": 25, "S2": 35, "S3": 45, "S4": 50}
+	for name, temperature := range temperatures {
+		parent := buildings["Building1"]
+		if name == "S4" {
+			parent = buildings["Building2"]
+		}
+		db.CreateEntity("Sensor", parent, name)
+		for _, entityId := range db.FindEntities("Sensor") {
+			if db.GetEntity(entityId).Name == name {
+				NewEntity(db, entityId).GetField("Temperature").PushFloat(temperature)
+				NewEntity(db, entityId).GetField("Disabled").PushBool(name == "S3")
+			}
+		}
+	}
+
+	rows, err := ExecuteQuery(db, `SELECT Name, Temperature FROM Sensor WHERE Parent->Name = "Building1" AND Temperature > 30 ORDER BY Temperature DESC LIMIT 20`)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "S3", ValueCast[*String](rows[0].Fields[0].Value).Raw)
+	assert.Equal(t, 45.0, ValueCast[*Float](rows[0].Fields[1].Value).Raw)
+	assert.Equal(t, "S2", ValueCast[*String](rows[1].Fields[0].Value).Raw)
+
+	rows, err = ExecuteQuery(db, `select Name from Sensor where (Temperature <= 25 or Temperature >= 50) and not Disabled = true order by Name limit 1 offset 1`)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, "S4", ValueCast[*String](rows[0].Fields[0].Value).Raw)
+
+	rows, err = ExecuteQuery(db, `SELECT * FROM Sensor WHERE Name != 'S1'`)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.Len(t, rows[0].Fields, 2)
+
+	_, err = ExecuteQuery(db, `SELECT Name FROM Sensor WHERE Temperature >`)
+	assert.Error(t, err)
+	_, err = ExecuteQuery(db, `SELECT FROM Sensor`)
+	assert.Error(t, err)
+	_, err = ExecuteQuery(db, `SELECT Name FROM Sensor LIMIT 5 extra`)
+	assert.Error(t, err)
+}
+
+type countingDatabase struct {
+	IDatabase
+	reads int
+}
+
+func (db *countingDatabase) Read(requests []*DatabaseRequest) {
+	db.reads++
+	db.IDatabase.Read(requests)
+}
+
+func TestEntityFinder_Combinators(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("Status", &DatabaseFieldSchema{Name: "Status", Type: "qdb.String"})
+	db.SetFieldSchema("Disabled", &DatabaseFieldSchema{Name: "Disabled", Type: "qdb.Bool"})
+	db.SetFieldSchema("Value", &DatabaseFieldSchema{Name: "Value", Type: "qdb.Int"})
+	db.SetFieldSchema("Limit", &DatabaseFieldSchema{Name: "Limit", Type: "qdb.Int"})
+	db.SetFieldSchema("Peer", &DatabaseFieldSchema{Name: "Peer", Type: "qdb.EntityReference"})
+	db.SetEntitySchema("Device", &DatabaseEntitySchema{
+		Name:   "Device",
+		Fields: []string{"Status", "Disabled", "Value", "Limit", "Peer"},
+	})
+
+	devices := map[string]string{}
+	for _, name := range []string{"D1", "D2", "D3", "D4"} {
+		db.CreateEntity("Device", "", name)
+	}
+	for _, entityId := range db.FindEntities("Device") {
+		devices[db.GetEntity(entityId).Name] = entityId
+	}
+
+	set := func(name, status string, disabled bool, value, limit int64) {
+		entity := NewEntity(db, devices[name])
+		entity.GetField("Status").PushString(status)
+		entity.GetField("Disabled").PushBool(disabled)
+		entity.GetField("Value").PushInt(value)
+		entity.GetField("Limit").PushInt(limit)
+	}
+	set("D1", "Fault", false, 10, 5)
+	set("D2", "Warning", true, 1, 5)
+	set("D3", "Warning", false, 7, 7)
+	set("D4", "Ok", false, 9, 5)
+	NewEntity(db, devices["D4"]).GetField("Peer").PushEntityReference(devices["D1"])
+
+	names := func(entities []IEntity) []string {
+		result := []string{}
+		for _, entity := range entities {
+			result = append(result, db.GetEntity(entity.GetId()).Name)
+		}
+		return result
+	}
+
+	counting := &countingDatabase{IDatabase: db}
+	found := NewEntityFinder(counting).Find(SearchCriteria{
+		EntityType: "Device",
+		Where: []*Condition{
+			Any(
+				NewStringCondition().Where("Status").IsEqualTo(&String{Raw: "Fault"}),
+				NewStringCondition().Where("Status").IsEqualTo(&String{Raw: "Warning"}),
+			),
+			Not(NewBoolCondition().Where("Disabled").IsEqualTo(&Bool{Raw: true})),
+		},
+	})
+	assert.ElementsMatch(t, []string{"D1", "D3"}, names(found))
+	assert.Equal(t, 2, counting.reads)
+
+	found = NewEntityFinder(db).Find(SearchCriteria{
+		EntityType: "Device",
+		Where: []*Condition{
+			NewIntCondition().Where("Value").IsGreaterThanField("Limit"),
+		},
+	})
+	assert.ElementsMatch(t, []string{"D1", "D4"}, names(found))
+
+	found = NewEntityFinder(db).Find(SearchCriteria{
+		EntityType: "Device",
+		Where: []*Condition{
+			All(
+				NewStringCondition().Where("Peer->Status").IsEqualTo(&String{Raw: "Fault"}),
+				NewIntCondition().Where("Peer->Value").IsGreaterThanOrEqualToField("Value"),
+			),
+		},
+	})
+	assert.ElementsMatch(t, []string{"D4"}, names(found))
+
+	// Plain functions are still conditions, and can be combined with the built ones
+	var fault FieldConditionEval = func(db IDatabase, entityId string) bool {
+		return NewEntity(db, entityId).GetField("Status").PullString() == "Fault"
+	}
+	found = NewEntityFinder(db).Find(SearchCriteria{
+		EntityType: "Device",
+		Where:      []*Condition{Not(Custom(fault))},
+		Conditions: []FieldConditionEval{
+			func(db IDatabase, entityId string) bool {
+				return NewEntity(db, entityId).GetField("Value").PullInt() > 5
+			},
+		},
+	})
+	assert.ElementsMatch(t, []string{"D3", "D4"}, names(found))
+}
+
+func TestRedisDatabase_Pagination(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("level", &DatabaseFieldSchema{Name: "level", Type: "qdb.Int", Index: DatabaseFieldSchema_SORTED})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"level"},
+	})
+	db.SetEntitySchema("other-type", &DatabaseEntitySchema{Name: "other-type"})
+
+	for i := 0; i < 25; i++ {
+		db.CreateEntity("test-type", "", "entity")
+	}
+	for i, entityId := range db.FindEntities("test-type") {
+		NewEntity(db, entityId).GetField("level").PushInt(i)
+	}
+
+	all := []string{}
+	cursor := ""
+	for pages := 0; ; pages++ {
+		page, next := db.FindEntitiesPage("test-type", cursor, 10)
+		assert.LessOrEqual(t, len(page), 10)
+		all = append(all, page...)
+		cursor = next
+		if cursor == "" {
+			break
+		}
+		assert.Less(t, pages, 5)
+	}
+	assert.ElementsMatch(t, db.FindEntities("test-type"), all)
+
+	types, next := db.GetEntityTypesPage("", 1)
+	assert.Len(t, types, 1)
+	types2, _ := db.GetEntityTypesPage(next, 1)
+	assert.ElementsMatch(t, []string{"test-type", "other-type"}, append(types, types2...))
+
+	schemas, next := db.GetFieldSchemasPage("", 3)
+	assert.Len(t, schemas, 3)
+	schemas2, next := db.GetFieldSchemasPage(next, 3)
+	assert.Len(t, schemas2, 1)
+	assert.Equal(t, "", next)
+
+	// Indexed searches page through the candidates in id order
+	criteria := SearchCriteria{
+		EntityType: "test-type",
+		Where: []*Condition{
+			NewIntCondition().Where("level").IsGreaterThanOrEqualTo(&Int{Raw: 5}),
+		},
+	}
+	found := []string{}
+	cursor = ""
+	for {
+		entities, next := NewEntityFinder(db).FindPage(criteria, cursor, 7)
+		assert.LessOrEqual(t, len(entities), 7)
+		for _, entity := range entities {
+			found = append(found, entity.GetId())
+		}
+		cursor = next
+		if cursor == "" {
+			break
+		}
+	}
+	assert.Len(t, found, 20)
+	assert.IsIncreasing(t, found)
+
+	_, next = db.FindEntitiesPage("test-type", "bogus", 10)
+	assert.Equal(t, "", next)
+}
+
+func TestRedisDatabase_EntityPaths(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	for _, entityType := range []string{"Root", "Building", "Floor", "Sensor"} {
+		db.SetEntitySchema(entityType, &DatabaseEntitySchema{Name: entityType})
+	}
+
+	db.CreateEntity("Root", "", "Root")
+	rootId := db.FindEntities("Root")[0]
+	db.CreateEntity("Building", rootId, "Building1")
+	buildingId := db.FindEntities("Building")[0]
+	db.CreateEntity("Floor", buildingId, "Floor2")
+	floorId := db.FindEntities("Floor")[0]
+	db.CreateEntity("Sensor", floorId, "Sensor7")
+	sensorId := db.FindEntities("Sensor")[0]
+
+	assert.Equal(t, sensorId, db.ResolveEntityPath("Root/Building1/Floor2/Sensor7"))
+	assert.Equal(t, buildingId, db.ResolveEntityPath("/Root/Building1/"))
+	assert.Equal(t, "", db.ResolveEntityPath("Root/Building2"))
+	assert.Equal(t, "Root/Building1/Floor2/Sensor7", NewEntity(db, sensorId).GetPath())
+
+	// Renaming updates the index
+	sensor := db.GetEntity(sensorId)
+	sensor.Name = "Sensor8"
+	db.SetEntity(sensorId, sensor)
+	assert.Equal(t, "", db.ResolveEntityPath("Root/Building1/Floor2/Sensor7"))
+	assert.Equal(t, sensorId, db.ResolveEntityPath("Root/Building1/Floor2/Sensor8"))
+
+	// Entities missing from the index are found by walking the tree, unless the index of their
+	// parent is known to be complete
+	mr.Del(db.keygen.GetEntityNameKey(rootId, "Building1"))
+	assert.Equal(t, "", db.ResolveEntityPath("Root/Building1/Floor2"))
+	mr.SRem(db.keygen.GetNameIndexedParentsKey(), rootId)
+	assert.Equal(t, floorId, db.ResolveEntityPath("Root/Building1/Floor2"))
+	assert.True(t, mr.Exists(db.keygen.GetEntityNameKey(rootId, "Building1")))
+
+	// Including top-level entities of any type, and siblings missing from a partially filled index
+	db.CreateEntity("Building", "", "Annex")
+	annexId := db.ResolveEntityPath("Annex")
+	db.CreateEntity("Floor", buildingId, "Floor2")
+	floorIds := db.FindEntities("Floor")
+	slices.Sort(floorIds)
+	mr.Del(db.keygen.GetEntityNameKey("", "Annex"))
+	mr.SRem(db.keygen.GetNameIndexedParentsKey(), "", buildingId)
+	mr.SRem(db.keygen.GetEntityNameKey(buildingId, "Floor2"), floorId)
+	assert.Equal(t, annexId, db.ResolveEntityPath("Annex"))
+	assert.Equal(t, floorIds[0], db.ResolveEntityPath("Root/Building1/Floor2"))
+	members, _ := mr.Members(db.keygen.GetEntityNameKey(buildingId, "Floor2"))
+	assert.ElementsMatch(t, floorIds, members)
+
+	db.DeleteEntity(sensorId)
+	assert.Equal(t, "", db.ResolveEntityPath("Root/Building1/Floor2/Sensor8"))
+}
+
+func TestRedisDatabase_MoveRenameClone(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("target", &DatabaseFieldSchema{Name: "target", Type: "qdb.EntityReference"})
+	db.SetEntitySchema("Root", &DatabaseEntitySchema{Name: "Root"})
+	db.SetEntitySchema("Folder", &DatabaseEntitySchema{Name: "Folder"})
+	db.SetEntitySchema("Item", &DatabaseEntitySchema{Name: "Item", Fields: []string{"test-field", "target"}})
+
+	db.CreateEntity("Root", "", "Root")
+	rootId := db.ResolveEntityPath("Root")
+	db.CreateEntity("Folder", rootId, "A")
+	db.CreateEntity("Folder", rootId, "B")
+	aId := db.ResolveEntityPath("Root/A")
+	bId := db.ResolveEntityPath("Root/B")
+	db.CreateEntity("Item", aId, "Item1")
+	db.CreateEntity("Item", aId, "Item2")
+	item1Id := db.ResolveEntityPath("Root/A/Item1")
+	item2Id := db.ResolveEntityPath("Root/A/Item2")
+
+	NewEntity(db, item1Id).GetField("test-field").PushString("hello")
+	NewEntity(db, item1Id).GetField("target").PushEntityReference(item2Id)
+
+	_, cursor := db.ReadEntityEvents("")
+
+	// Moving updates both parents and the path of the subtree
+	assert.True(t, db.MoveEntity(aId, bId))
+	assert.Equal(t, "Root/B/A/Item1", db.GetEntityPath(item1Id))
+	assert.Len(t, db.GetEntity(rootId).Children, 1)
+	assert.Equal(t, bId, db.GetEntity(rootId).Children[0].Raw)
+	assert.Equal(t, aId, db.GetEntity(bId).Children[0].Raw)
+
+	// An entity can't be moved under its own subtree
+	assert.False(t, db.MoveEntity(bId, item1Id))
+
+	assert.True(t, db.RenameEntity(aId, "C"))
+	assert.Equal(t, item1Id, db.ResolveEntityPath("Root/B/C/Item1"))
+	assert.Equal(t, "", db.ResolveEntityPath("Root/B/A/Item1"))
+
+	events, cursor := db.ReadEntityEvents(cursor)
+	assert.Len(t, events, 2)
+	assert.Equal(t, DatabaseEntityEvent_MOVED, events[0].Type)
+	assert.Equal(t, rootId, events[0].Previous.Parent.Raw)
+	assert.Equal(t, bId, events[0].Current.Parent.Raw)
+	assert.Equal(t, DatabaseEntityEvent_RENAMED, events[1].Type)
+	assert.Equal(t, "C", events[1].Current.Name)
+
+	// Cloning copies the subtree and its values, rewriting internal references
+	cloneId := db.CloneEntity(aId, rootId, "D", true)
+	assert.NotEmpty(t, cloneId)
+	assert.NotEqual(t, aId, cloneId)
+	cloneItem1Id := db.ResolveEntityPath("Root/D/Item1")
+	cloneItem2Id := db.ResolveEntityPath("Root/D/Item2")
+	assert.NotEqual(t, item1Id, cloneItem1Id)
+	assert.Equal(t, "hello", NewEntity(db, cloneItem1Id).GetField("test-field").PullString())
+	assert.Equal(t, cloneItem2Id, NewEntity(db, cloneItem1Id).GetField("target").PullEntityReference())
+	assert.Len(t, db.FindEntities("Item"), 4)
+
+	copyId := db.CloneEntity(item1Id, "", "", false)
+	assert.Equal(t, item2Id, NewEntity(db, copyId).GetField("target").PullEntityReference())
+	assert.Equal(t, copyId, db.ResolveEntityPath("Item1"))
+
+	events, _ = db.ReadEntityEvents(cursor)
+	assert.Len(t, events, 4)
+	for _, event := range events {
+		assert.Equal(t, DatabaseEntityEvent_CREATED, event.Type)
+	}
+}
+
+func TestRedisDatabase_ReferentialIntegrity(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("owner", &DatabaseFieldSchema{Name: "owner", Type: "qdb.EntityReference"})
+	db.SetFieldSchema("members", &DatabaseFieldSchema{Name: "members", Type: "qdb.EntityReferenceList"})
+	db.SetFieldSchema("site", &DatabaseFieldSchema{Name: "site", Type: "qdb.EntityReference", OnDelete: DatabaseFieldSchema_RESTRICT})
+	db.SetFieldSchema("device", &DatabaseFieldSchema{Name: "device", Type: "qdb.EntityReference", OnDelete: DatabaseFieldSchema_CASCADE})
+	db.SetEntitySchema("Thing", &DatabaseEntitySchema{Name: "Thing", Fields: []string{"owner", "members", "site", "device"}})
+
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		db.CreateEntity("Thing", "", name)
+	}
+	a, b, c := db.ResolveEntityPath("A"), db.ResolveEntityPath("B"), db.ResolveEntityPath("C")
+	d, e := db.ResolveEntityPath("D"), db.ResolveEntityPath("E")
+
+	NewEntity(db, b).GetField("owner").PushEntityReference(a)
+	NewEntity(db, c).GetField("members").PushEntityReferenceList([]string{a, b})
+	NewEntity(db, d).GetField("site").PushEntityReference(c)
+	NewEntity(db, e).GetField("device").PushEntityReference(b)
+
+	assert.ElementsMatch(t, []*DatabaseReference{
+		{EntityId: b, Field: "owner"},
+		{EntityId: c, Field: "members"},
+	}, db.GetReferrers(a))
+
+	// Overwriting a reference updates the index
+	NewEntity(db, b).GetField("owner").PushEntityReference(c)
+	assert.Len(t, db.GetReferrers(a), 1)
+	NewEntity(db, b).GetField("owner").PushEntityReference(a)
+
+	// Restricted by D.site
+	db.DeleteEntity(c)
+	assert.True(t, db.EntityExists(c))
+
+	// Set-null clears B.owner and drops A from C.members
+	db.DeleteEntity(a)
+	assert.False(t, db.EntityExists(a))
+	assert.Equal(t, "", NewEntity(db, b).GetField("owner").PullEntityReference())
+	assert.Equal(t, []string{b}, NewEntity(db, c).GetField("members").PullEntityReferenceList())
+
+	// Cascade deletes E along with B
+	db.DeleteEntity(b)
+	assert.False(t, db.EntityExists(b))
+	assert.False(t, db.EntityExists(e))
+	assert.Empty(t, NewEntity(db, c).GetField("members").PullEntityReferenceList())
+	assert.Empty(t, db.GetReferrers(b))
+
+	// References written before the index existed are restored by a repair
+	mr.Del(db.keygen.GetEntityReferrersKey(c))
+	checker := NewIntegrityChecker(db)
+	checker.Repair(checker.Check(), false)
+	assert.Equal(t, []*DatabaseReference{{EntityId: d, Field: "site"}}, db.GetReferrers(c))
+	db.DeleteEntity(c)
+	assert.True(t, db.EntityExists(c))
+
+	db.DeleteEntity(d)
+	db.DeleteEntity(c)
+	assert.False(t, db.EntityExists(c))
+}
+
+func TestIntegrityChecker(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("owner", &DatabaseFieldSchema{Name: "owner", Type: "qdb.EntityReference"})
+	db.SetEntitySchema("Folder", &DatabaseEntitySchema{Name: "Folder", Fields: []string{"test-field", "owner"}})
+	db.CreateEntity("Folder", "", "Parent")
+	parentId := db.ResolveEntityPath("Parent")
+	db.CreateEntity("Folder", parentId, "Child")
+	childId := db.ResolveEntityPath("Parent/Child")
+	NewEntity(db, childId).GetField("owner").PushEntityReference(parentId)
+
+	checker := NewIntegrityChecker(db)
+	assert.True(t, checker.Check().IsClean())
+
+	// Corrupt the database in every way the checker knows about
+	parent := db.GetEntity(parentId)
+	parent.Children = []*EntityReference{{Raw: "deleted-entity"}}
+	db.SetEntity(parentId, parent)
+	mr.SAdd(db.keygen.GetEntityTypeKey("Folder"), "deleted-entity")
+	mr.SRem(db.keygen.GetEntityTypeKey("Folder"), childId)
+	mr.Set(db.keygen.GetFieldKey("field1", childId), "stale")
+	mr.SAdd(db.keygen.GetEntityTypeNotificationConfigKey("Missing", "test-field"), "config")
+	mr.SAdd(db.keygen.GetEntityNameKey("", "Ghost"), "deleted-entity")
+	mr.SRem(db.keygen.GetEntityReferrersKey(parentId), childId+":owner")
+
+	report := checker.Check()
+	categories := report.ByCategory()
+	assert.Len(t, categories[IntegrityChildNotInParent], 1)
+	assert.Len(t, categories[IntegrityDanglingChild], 1)
+	assert.Len(t, categories[IntegrityDanglingTypeMember], 1)
+	assert.Len(t, categories[IntegrityMissingTypeMember], 1)
+	assert.Len(t, categories[IntegrityOrphanedField], 1)
+	assert.Len(t, categories[IntegrityOrphanedNotificationConfig], 1)
+	assert.Len(t, categories[IntegrityNameIndex], 1)
+	assert.Len(t, categories[IntegrityReferrerIndex], 1)
+
+	// A dry run changes nothing
+	assert.Len(t, checker.Repair(report, true), len(report.Issues))
+	assert.Len(t, checker.Check().Issues, len(report.Issues))
+
+	checker.Repair(report, false)
+	assert.True(t, checker.Check().IsClean())
+	assert.Equal(t, []*EntityReference{{Raw: childId}}, db.GetEntity(parentId).Children)
+	assert.ElementsMatch(t, []string{parentId, childId}, db.FindEntities("Folder"))
+	assert.Len(t, db.GetReferrers(parentId), 1)
+}
+
+func TestEntity_Traversal(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	for _, entityType := range []string{"Root", "Building", "Floor", "Sensor"} {
+		db.SetEntitySchema(entityType, &DatabaseEntitySchema{Name: entityType})
+	}
+
+	db.CreateEntity("Root", "", "Root")
+	db.CreateEntity("Building", db.ResolveEntityPath("Root"), "B1")
+	db.CreateEntity("Floor", db.ResolveEntityPath("Root/B1"), "F1")
+	db.CreateEntity("Floor", db.ResolveEntityPath("Root/B1"), "F2")
+	db.CreateEntity("Sensor", db.ResolveEntityPath("Root/B1/F1"), "S1")
+	db.CreateEntity("Sensor", db.ResolveEntityPath("Root/B1/F2"), "S2")
+
+	names := func(entities []IEntity) []string {
+		result := []string{}
+		for _, entity := range entities {
+			result = append(result, entity.GetName())
+		}
+		return result
+	}
+
+	root := NewEntity(db, db.ResolveEntityPath("Root"))
+	sensor := NewEntity(db, db.ResolveEntityPath("Root/B1/F1/S1"))
+
+	assert.Equal(t, []string{"F1", "B1", "Root"}, names(sensor.GetAncestors()))
+	assert.Equal(t, "B1", sensor.FindAncestorOfType("Building").GetName())
+	assert.Nil(t, sensor.FindAncestorOfType("Sensor"))
+	assert.Equal(t, []string{"F2"}, names(NewEntity(db, db.ResolveEntityPath("Root/B1/F1")).GetSiblings()))
+
+	assert.Equal(t, []string{"B1", "F1", "F2", "S1", "S2"}, names(root.GetDescendants(TraversalOptions{})))
+	assert.Equal(t, []string{"B1", "F1", "S1", "F2", "S2"}, names(root.GetDescendants(TraversalOptions{Order: DepthFirst})))
+	assert.Equal(t, []string{"B1", "F1", "F2"}, names(root.GetDescendants(TraversalOptions{MaxDepth: 2})))
+	assert.Equal(t, []string{"S1", "S2"}, names(root.GetDescendants(TraversalOptions{EntityTypes: []string{"Sensor"}})))
+
+	entities := TraverseEntity(db, &WebConfigTraverseEntityRequest{
+		Id:          sensor.GetId(),
+		Direction:   WebConfigTraverseEntityRequest_ANCESTORS,
+		EntityTypes: []string{"Floor"},
+	})
+	assert.Len(t, entities, 1)
+	assert.Equal(t, "F1", entities[0].Name)
+}
+
+func TestRedisDatabase_IndirectionSelectors(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("Location", &DatabaseFieldSchema{Name: "Location", Type: "qdb.String"})
+	db.SetEntitySchema("Floor", &DatabaseEntitySchema{Name: "Floor", Fields: []string{"test-field"}})
+	db.SetEntitySchema("Sensor", &DatabaseEntitySchema{Name: "Sensor", Fields: []string{"test-field", "Location"}})
+	db.SetEntitySchema("Light", &DatabaseEntitySchema{Name: "Light", Fields: []string{"test-field"}})
+
+	db.CreateEntity("Floor", "", "Floor")
+	floorId := db.ResolveEntityPath("Floor")
+	db.CreateEntity("Light", floorId, "L1")
+	db.CreateEntity("Sensor", floorId, "S1")
+	db.CreateEntity("Sensor", floorId, "S2")
+	l1, s1, s2 := db.ResolveEntityPath("Floor/L1"), db.ResolveEntityPath("Floor/S1"), db.ResolveEntityPath("Floor/S2")
+
+	NewEntity(db, floorId).GetField("test-field").PushString("floor")
+	NewEntity(db, l1).GetField("test-field").PushString("l1")
+	NewEntity(db, s1).GetField("test-field").PushString("s1")
+	NewEntity(db, s2).GetField("test-field").PushString("s2")
+	NewEntity(db, s1).GetField("Location").PushString("South")
+	NewEntity(db, s2).GetField("Location").PushString("North")
+
+	read := func(entityId, field string) string {
+		return NewEntity(db, entityId).GetField(field).PullString()
+	}
+
+	assert.Equal(t, "s1", read(floorId, "[Sensor]->test-field"))
+	assert.Equal(t, "s2", read(floorId, "[Sensor][1]->test-field"))
+	assert.Equal(t, "l1", read(floorId, "[0]->test-field"))
+	assert.Equal(t, "s2", read(floorId, "[-1]->test-field"))
+	assert.Equal(t, "s2", read(floorId, "[Location=North]->test-field"))
+	assert.Equal(t, "s1", read(floorId, "[Sensor][Name=\"S1\"]->test-field"))
+	assert.Equal(t, "floor", read(s1, "..->test-field"))
+	assert.Equal(t, "s2", read(s1, "..->[Location=North]->test-field"))
+
+	field, entity := db.ResolveIndirection("[Sensor][5]->test-field", floorId)
+	assert.Equal(t, "", field)
+	assert.Equal(t, "", entity)
+
+	_, _, err := db.newIndirectionResolver().resolve("..->[Pump]->test-field", s1)
+	assert.ErrorContains(t, err, "hop 2 '[Pump]'")
+
+	// Hops shared by requests of a batch are resolved once
+	resolver := db.newIndirectionResolver()
+	resolver.resolve("..->[Sensor]->test-field", s1)
+	resolver.resolve("..->[Sensor]->Location", s1)
+	assert.Len(t, resolver.hops, 2)
+
+	rows, err := ExecuteQuery(db, "SELECT [Sensor][-1]->Location FROM Floor")
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, "North", ValueCast[*String](rows[0].Fields[0].Value).GetRaw())
+
+	rows, err = ExecuteQuery(db, "SELECT Location FROM Sensor WHERE ..->[Sensor][1]->Location = 'North'")
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+}
+
+func TestSnapshotDocument_RoundTrip(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("count", &DatabaseFieldSchema{Name: "count", Type: "qdb.Int"})
+	db.SetFieldSchema("level", &DatabaseFieldSchema{Name: "level", Type: "qdb.Float", Unit: "degC", AlarmLimits: []*DatabaseAlarmLimit{{Level: DatabaseAlarmLimit_HIGH, Value: 30}}})
+	db.SetFieldSchema("mode", &DatabaseFieldSchema{Name: "mode", Type: "qdb.Enum", EnumValues: []*DatabaseEnumValue{{Name: "OFF", Value: 0}, {Name: "ON", Value: 1}}})
+	db.SetFieldSchema("since", &DatabaseFieldSchema{Name: "since", Type: "qdb.Timestamp"})
+	db.SetFieldSchema("peer", &DatabaseFieldSchema{Name: "peer", Type: "qdb.EntityReference"})
+	db.SetFieldSchema("peers", &DatabaseFieldSchema{Name: "peers", Type: "qdb.EntityReferenceList"})
+	db.SetFieldSchema("tags", &DatabaseFieldSchema{Name: "tags", Type: "qdb.StringMap"})
+	db.SetFieldSchema("file", &DatabaseFieldSchema{Name: "file", Type: "qdb.BinaryFile"})
+	db.SetEntitySchema("Root", &DatabaseEntitySchema{Name: "Root"})
+	db.SetEntitySchema("Device", &DatabaseEntitySchema{Name: "Device", Fields: []string{"test-field", "count", "level", "mode", "since", "peer", "peers", "tags", "file"}})
+
+	db.CreateEntity("Root", "", "Root")
+	rootId := db.ResolveEntityPath("Root")
+	db.CreateEntity("Device", rootId, "D1")
+	db.CreateEntity("Device", rootId, "D2")
+	d1, d2 := NewEntity(db, db.ResolveEntityPath("Root/D1")), NewEntity(db, db.ResolveEntityPath("Root/D2"))
+
+	d1.GetField("test-field").PushString("hello: world")
+	d1.GetField("count").PushInt(int64(1) << 60)
+	d1.GetField("level").PushFloat(21.5)
+	d1.GetField("mode").PushEnum(1)
+	d1.GetField("since").PushTimestamp(time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC))
+	d1.GetField("peer").PushEntityReference(d2.GetId())
+	d1.GetField("peers").PushEntityReferenceList([]string{d2.GetId(), rootId})
+	d1.GetField("tags").PushStringMap(map[string]string{"zone": "a", "floor": "2"})
+	d1.GetField("file").PushValue(&BinaryFile{Hash: "abc", Size: 3, MimeType: "text/plain"})
+
+	snapshot := db.CreateSnapshot()
+
+	normalize := func(s *DatabaseSnapshot) *DatabaseSnapshot {
+		s = proto.Clone(s).(*DatabaseSnapshot)
+		slices.SortFunc(s.Entities, func(a, b *DatabaseEntity) int { return strings.Compare(a.Id, b.Id) })
+		slices.SortFunc(s.Fields, func(a, b *DatabaseField) int { return strings.Compare(a.Id+a.Name, b.Id+b.Name) })
+		slices.SortFunc(s.EntitySchemas, func(a, b *DatabaseEntitySchema) int { return strings.Compare(a.Name, b.Name) })
+		slices.SortFunc(s.FieldSchemas, func(a, b *DatabaseFieldSchema) int { return strings.Compare(a.Name, b.Name) })
+		for _, field := range s.Fields {
+			if m, err := field.Value.UnmarshalNew(); err == nil {
+				anypb.MarshalFrom(field.Value, m, proto.MarshalOptions{Deterministic: true})
+			}
+		}
+		return s
+	}
+
+	b, err := MarshalSnapshotJSON(snapshot)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"path": "Root/D2"`)
+	assert.Contains(t, string(b), `"value": "ON"`)
+	fromJSON, err := UnmarshalSnapshotJSON(b)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(normalize(snapshot), normalize(fromJSON)))
+
+	again, err := MarshalSnapshotJSON(fromJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, string(b), string(again))
+
+	y, err := MarshalSnapshotYAML(snapshot)
+	assert.NoError(t, err)
+	fromYAML, err := UnmarshalSnapshotYAML(y)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(normalize(snapshot), normalize(fromYAML)))
+
+	// References can be written by path alone
+	document := strings.Replace(string(y), "id: "+d2.GetId()+"\n          path: Root/D2", "path: Root/D2", 1)
+	assert.NotEqual(t, string(y), document)
+	fromPath, err := UnmarshalSnapshotYAML([]byte(document))
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(normalize(snapshot), normalize(fromPath)))
+}
+
+func TestRedisDatabase_MergeSnapshot(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{
+		Name:   "test-type",
+		Fields: []string{"field1", "field2"},
+	})
+	db.CreateEntity("test-type", "", "A")
+	aId := db.ResolveEntityPath("A")
+	db.CreateEntity("test-type", aId, "B")
+	bId := db.ResolveEntityPath("A/B")
+
+	a := NewEntity(db, aId)
+	a.GetField("field1").PushString("original")
+	NewEntity(db, bId).GetField("field2").PushInt(7)
+
+	snapshot := db.CreateSnapshot()
+
+	a.GetField("field1").PushString("modified")
+	db.DeleteEntity(bId)
+	db.CreateEntity("test-type", aId, "C")
+	cId := db.ResolveEntityPath("A/C")
+	db.TempSet("unrelated", "kept", 0)
+
+	notified := false
+	db.Notify(&DatabaseNotificationConfig{
+		Id:             aId,
+		Field:          "field1",
+		NotifyOnChange: true,
+		ServiceId:      "test-service",
+	}, NewNotificationCallback(func(n *DatabaseNotification) {
+		notified = true
+	}))
+
+	// A dry run reports the changes without making them
+	summary := db.MergeSnapshot(snapshot, SnapshotMergeOptions{DryRun: true, Prune: true})
+	assert.Equal(t, []string{bId}, summary.CreatedEntities)
+	assert.Equal(t, []string{aId}, summary.UpdatedEntities)
+	assert.Equal(t, []string{cId}, summary.PrunedEntities)
+	assert.Len(t, summary.WrittenFields, 3)
+	assert.False(t, db.EntityExists(bId))
+	assert.Equal(t, "modified", a.GetField("field1").PullString())
+
+	// Merging keeps the entities and keys the snapshot does not own
+	summary = db.MergeSnapshot(snapshot, SnapshotMergeOptions{SuppressNotifications: true})
+	assert.True(t, summary.HasChanges())
+	assert.Empty(t, summary.PrunedEntities)
+	assert.Equal(t, "original", a.GetField("field1").PullString())
+	assert.Equal(t, int64(7), NewEntity(db, bId).GetField("field2").PullInt())
+	assert.Equal(t, bId, db.ResolveEntityPath("A/B"))
+	assert.Equal(t, cId, db.ResolveEntityPath("A/C"))
+	assert.Equal(t, "kept", db.TempGet("unrelated"))
+
+	db.ProcessNotifications()
+	assert.False(t, notified)
+
+	// Nothing is written when the database already matches the snapshot
+	summary = db.MergeSnapshot(snapshot, SnapshotMergeOptions{})
+	assert.False(t, summary.HasChanges())
+
+	summary = db.MergeSnapshot(snapshot, SnapshotMergeOptions{Prune: true})
+	assert.Equal(t, []string{cId}, summary.PrunedEntities)
+	assert.False(t, db.EntityExists(cId))
+	assert.Len(t, db.GetEntity(aId).Children, 1)
+	assert.True(t, NewIntegrityChecker(db).Check().IsClean())
+}
+
+func TestRedisDatabase_PartialSnapshot(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetFieldSchema("peer", &DatabaseFieldSchema{Name: "peer", Type: "qdb.EntityReference"})
+	db.SetEntitySchema("Site", &DatabaseEntitySchema{Name: "Site", Fields: []string{"field1"}})
+	db.SetEntitySchema("Device", &DatabaseEntitySchema{Name: "Device", Fields: []string{"field2", "peer"}})
+	db.SetEntitySchema("Other", &DatabaseEntitySchema{Name: "Other", Fields: []string{"test-field"}})
+
+	db.CreateEntity("Site", "", "SiteA")
+	db.CreateEntity("Site", "", "SiteB")
+	db.CreateEntity("Other", "", "Elsewhere")
+	siteA := db.ResolveEntityPath("SiteA")
+	siteB := db.ResolveEntityPath("SiteB")
+	db.CreateEntity("Device", siteA, "D1")
+	db.CreateEntity("Device", siteA, "D2")
+	d1 := db.ResolveEntityPath("SiteA/D1")
+	d2 := db.ResolveEntityPath("SiteA/D2")
+
+	NewEntity(db, siteA).GetField("field1").PushString("north")
+	NewEntity(db, d1).GetField("field2").PushInt(5)
+	NewEntity(db, d1).GetField("peer").PushEntityReference(d2)
+
+	entityIds := func(snapshot *DatabaseSnapshot) []string {
+		ids := []string{}
+		for _, entity := range snapshot.Entities {
+			ids = append(ids, entity.Id)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	sorted := func(ids ...string) []string {
+		slices.Sort(ids)
+		return ids
+	}
+
+	// A subtree only carries the schemas its entities use
+	snapshot := db.CreatePartialSnapshot(SnapshotScope{RootId: siteA})
+	assert.Equal(t, sorted(siteA, d1, d2), entityIds(snapshot))
+	assert.Len(t, snapshot.EntitySchemas, 2)
+	assert.Len(t, snapshot.FieldSchemas, 3)
+
+	// Scopes combine
+	devices := db.CreatePartialSnapshot(SnapshotScope{RootId: siteA, EntityTypes: []string{"Device"}})
+	assert.Equal(t, sorted(d1, d2), entityIds(devices))
+	queried := db.CreatePartialSnapshot(SnapshotScope{Query: "SELECT * FROM Device WHERE field2 = 5"})
+	assert.Equal(t, []string{d1}, entityIds(queried))
+	assert.Empty(t, queried.Entities[0].Children)
+
+	// Importing under another parent remaps ids and the references between imported entities
+	ids := db.ImportSnapshot(snapshot, siteB)
+	assert.Len(t, ids, 3)
+	assert.NotEqual(t, siteA, ids[siteA])
+
+	copyId := db.ResolveEntityPath("SiteB/SiteA")
+	assert.Equal(t, ids[siteA], copyId)
+	assert.Equal(t, "north", NewEntity(db, copyId).GetField("field1").PullString())
+	assert.Equal(t, ids[d2], NewEntity(db, ids[d1]).GetField("peer").PullEntityReference())
+	assert.Equal(t, ids[d1], db.ResolveEntityPath("SiteB/SiteA/D1"))
+
+	// The source is untouched
+	assert.Equal(t, d2, NewEntity(db, d1).GetField("peer").PullEntityReference())
+	assert.Len(t, db.GetEntity(siteA).Children, 2)
+
+	// Fields the schema in the database does not have are not imported
+	ghost := proto.Clone(snapshot).(*DatabaseSnapshot)
+	value, _ := anypb.New(&String{Raw: "ghost"})
+	ghost.Fields = append(ghost.Fields, &DatabaseField{Id: d1, Name: "field1", Value: value})
+	ids = db.ImportSnapshot(ghost, siteB)
+	assert.Len(t, db.GetEntity(siteB).Children, 2)
+	assert.False(t, mr.Exists(db.keygen.GetFieldKey("field1", ids[d1])))
+	assert.Equal(t, 5, int(NewEntity(db, ids[d1]).GetField("field2").PullInt()))
+	assert.True(t, NewIntegrityChecker(db).Check().IsClean())
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{Name: "test-type", Fields: []string{"field1"}})
+	db.CreateEntity("test-type", "", "A")
+	db.CreateEntity("test-type", "", "B")
+	aId := db.ResolveEntityPath("A")
+	bId := db.ResolveEntityPath("B")
+	db.CreateEntity("test-type", aId, "Moved")
+	db.CreateEntity("test-type", aId, "Removed")
+	movedId := db.ResolveEntityPath("A/Moved")
+	removedId := db.ResolveEntityPath("A/Removed")
+	NewEntity(db, bId).GetField("field1").PushString("before")
+
+	before := db.CreateSnapshot()
+	assert.True(t, DiffDatabase(db, before).IsEmpty())
+
+	db.CreateEntity("test-type", bId, "Added")
+	addedId := db.ResolveEntityPath("B/Added")
+	NewEntity(db, addedId).GetField("field1").PushString("new")
+	db.DeleteEntity(removedId)
+	db.MoveEntity(movedId, bId)
+	NewEntity(db, bId).GetField("field1").PushString("after")
+	db.SetFieldSchema("field1", &DatabaseFieldSchema{Name: "field1", Type: "qdb.String", Index: DatabaseFieldSchema_HASH})
+
+	after := db.CreateSnapshot()
+	diff := DiffSnapshots(before, after)
+
+	assert.Len(t, diff.AddedEntities, 1)
+	assert.Equal(t, addedId, diff.AddedEntities[0].New.Id)
+	assert.Len(t, diff.RemovedEntities, 1)
+	assert.Equal(t, "A/Removed", diff.RemovedEntities[0].OldPath)
+	assert.Len(t, diff.MovedEntities, 1)
+	assert.Equal(t, "A/Moved", diff.MovedEntities[0].OldPath)
+	assert.Equal(t, "B/Moved", diff.MovedEntities[0].NewPath)
+	assert.Empty(t, diff.ReorderedEntities)
+	assert.Len(t, diff.ChangedFields, 2)
+	assert.Equal(t, "before", ValueCast[*String](diff.ChangedFields[0].OldValue).Raw)
+	assert.Equal(t, "after", ValueCast[*String](diff.ChangedFields[0].NewValue).Raw)
+	assert.Nil(t, diff.ChangedFields[1].OldValue)
+	assert.Equal(t, "new", ValueCast[*String](diff.ChangedFields[1].NewValue).Raw)
+	assert.Len(t, diff.FieldSchemaChanges, 1)
+	assert.Empty(t, diff.EntitySchemaChanges)
+
+	text := diff.Text()
+	assert.Contains(t, text, "~ field schema field1\n")
+	assert.Contains(t, text, "+ entity B/Added (test-type, "+addedId+")\n")
+	assert.Contains(t, text, "- entity A/Removed (test-type, "+removedId+")\n")
+	assert.Contains(t, text, "~ entity A/Moved -> B/Moved\n")
+	assert.Contains(t, text, `~ field B.field1: "before" -> "after"`)
+	assert.Equal(t, text, DiffSnapshots(before, after).Text())
+
+	// Only a different order of the same children is a reordering
+	parent := db.GetEntity(bId)
+	parent.Children = []*EntityReference{parent.Children[1], parent.Children[0]}
+	db.SetEntity(bId, parent)
+	reordered := DiffSnapshots(after, db.CreateSnapshot())
+	assert.Len(t, reordered.ReorderedEntities, 1)
+	assert.Equal(t, bId, reordered.ReorderedEntities[0].New.Id)
+	assert.Equal(t, "~ children of B reordered\n", reordered.Text())
+
+	// Comparing with the database shows what a restore would undo
+	restore := DiffSnapshotFromRequest(db, &WebConfigDiffSnapshotRequest{New: before})
+	assert.Equal(t, removedId, restore.AddedEntities[0].New.Id)
+	assert.Equal(t, addedId, restore.RemovedEntities[0].Old.Id)
+}
+
+func TestBackupWorker(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{Name: "test-type", Fields: []string{"field1"}})
+	db.CreateEntity("test-type", "", "A")
+	aId := db.ResolveEntityPath("A")
+	NewEntity(db, aId).GetField("field1").PushString("backed up")
+
+	w := NewBackupWorker(db, BackupWorkerConfig{
+		Directory:  t.TempDir(),
+		KeepHourly: 3,
+		KeepDaily:  3,
+	})
+	w.Init()
+	defer w.Deinit()
+
+	// Followers do not take backups
+	w.backupTicker.Reset(time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	w.DoWork()
+	assert.Empty(t, w.ListBackups())
+
+	w.OnBecameLeader()
+	time.Sleep(5 * time.Millisecond)
+	w.DoWork()
+	assert.Len(t, w.ListBackups(), 1)
+
+	// Retention keeps the newest backup of the last hours and days
+	start := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	for _, offset := range []time.Duration{0, 30 * time.Minute, 24 * time.Hour, 48 * time.Hour, 49 * time.Hour, 49*time.Hour + 10*time.Minute} {
+		w.createBackupAt(start.Add(offset))
+	}
+	w.OnLosingLeadership()
+
+	names := []string{}
+	for _, backup := range w.ListBackups()[1:] {
+		names = append(names, backup.Time.Format("02 15:04"))
+	}
+	assert.Equal(t, []string{"03 10:10", "03 09:00", "02 09:00"}, names)
+
+	latest := w.ListBackups()[1]
+	assert.Len(t, latest.Checksum, 64)
+
+	NewEntity(db, aId).GetField("field1").PushString("changed")
+	assert.True(t, w.RestoreBackup(latest.Name))
+	assert.Equal(t, "backed up", NewEntity(db, aId).GetField("field1").PullString())
+
+	// A backup referencing content missing from the blob store is not restored
+	db.SetFieldSchema("file", &DatabaseFieldSchema{Name: "file", Type: "qdb.BinaryFile"})
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{Name: "test-type", Fields: []string{"field1", "file"}})
+	assert.True(t, NewEntity(db, aId).GetField("file").PushBinaryFileContent(strings.NewReader("content"), "text/plain"))
+	withFile := w.CreateBackup()
+	assert.NotNil(t, withFile)
+	_, err := w.LoadBackup(withFile.Name)
+	assert.NoError(t, err)
+	mr.Del(db.keygen.GetBlobKey(NewEntity(db, aId).GetField("file").PullValue(new(BinaryFile)).(*BinaryFile).Hash))
+	_, err = w.LoadBackup(withFile.Name)
+	assert.ErrorContains(t, err, "missing from the blob store")
+
+	// A corrupted backup is not restored
+	os.WriteFile(filepath.Join(w.config.Directory, latest.Name), []byte("corrupted"), 0644)
+	_, err = w.LoadBackup(latest.Name)
+	assert.Error(t, err)
+	assert.False(t, w.RestoreBackup(latest.Name))
+	assert.False(t, w.RestoreBackup("../"+latest.Name))
+}
+
+func TestRedisDatabase_SnapshotStream(t *testing.T) {
+	db, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	db.SetEntitySchema("test-type", &DatabaseEntitySchema{Name: "test-type", Fields: []string{"field1", "field2"}})
+	db.CreateEntity("test-type", "", "Root")
+	rootId := db.ResolveEntityPath("Root")
+	for i := 0; i < SnapshotStreamBatchSize+5; i++ {
+		db.CreateEntity("test-type", rootId, fmt.Sprintf("E%d", i))
+	}
+	NewEntity(db, db.ResolveEntityPath("Root/E42")).GetField("field2").PushInt(42)
+
+	normalize := func(s *DatabaseSnapshot) *DatabaseSnapshot {
+		s = proto.Clone(s).(*DatabaseSnapshot)
+		slices.SortFunc(s.Entities, func(a, b *DatabaseEntity) int { return strings.Compare(a.Id, b.Id) })
+		slices.SortFunc(s.Fields, func(a, b *DatabaseField) int { return strings.Compare(a.Id+a.Name, b.Id+b.Name) })
+		slices.SortFunc(s.EntitySchemas, func(a, b *DatabaseEntitySchema) int { return strings.Compare(a.Name, b.Name) })
+		slices.SortFunc(s.FieldSchemas, func(a, b *DatabaseFieldSchema) int { return strings.Compare(a.Name, b.Name) })
+		return s
+	}
+
+	progress := []SnapshotProgress{}
+	var stream bytes.Buffer
+	assert.True(t, db.CreateSnapshotStream(&stream, func(p SnapshotProgress) { progress = append(progress, p) }))
+	assert.Greater(t, len(progress), 1)
+	assert.Equal(t, SnapshotProgress{Entities: 106, TotalEntities: 106, Fields: 212}, progress[len(progress)-1])
+
+	expected := db.CreateSnapshot()
+	streamed, err := ReadSnapshotStream(bytes.NewReader(stream.Bytes()))
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(normalize(expected), normalize(streamed)))
+
+	// The header comes first
+	record, err := NewSnapshotStreamReader(bytes.NewReader(stream.Bytes())).Next()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(106), record.Header.GetEntityCount())
+
+	db.CreateEntity("test-type", rootId, "Extra")
+	progress = nil
+	assert.True(t, db.RestoreSnapshotStream(bytes.NewReader(stream.Bytes()), func(p SnapshotProgress) { progress = append(progress, p) }))
+	assert.Equal(t, SnapshotProgress{Entities: 106, TotalEntities: 106, Fields: 212}, progress[len(progress)-1])
+	assert.Empty(t, db.ResolveEntityPath("Root/Extra"))
+	assert.Equal(t, int64(42), NewEntity(db, db.ResolveEntityPath("Root/E42")).GetField("field2").PullInt())
+	assert.True(t, proto.Equal(normalize(expected), normalize(db.CreateSnapshot())))
+
+	// A truncated or altered stream is rejected before anything is flushed
+	db.CreateEntity("test-type", rootId, "Extra")
+	assert.False(t, db.RestoreSnapshotStream(bytes.NewReader(stream.Bytes()[:stream.Len()-3]), nil))
+	_, err = ReadSnapshotStream(bytes.NewReader(stream.Bytes()[:bytes.LastIndex(stream.Bytes(), []byte("E42"))]))
+	assert.Error(t, err)
+	assert.False(t, db.RestoreSnapshotStream(bytes.NewReader(bytes.Replace(stream.Bytes(), []byte("E42"), []byte("E24"), 1)), nil))
+	assert.NotEmpty(t, db.ResolveEntityPath("Root/Extra"))
+	assert.Equal(t, int64(42), NewEntity(db, db.ResolveEntityPath("Root/E42")).GetField("field2").PullInt())
+}
+
+func TestRedisDatabase_Namespaces(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	connect := func(namespace string, index int) *RedisDatabase {
+		db := NewRedisDatabase(RedisDatabaseConfig{
+			Address:   mr.Addr(),
+			ServiceID: func() string { return "test-service" },
+			Namespace: namespace,
+			DB:        index,
+		}).(*RedisDatabase)
+		db.Connect()
+
+		db.SetFieldSchema("field1", &DatabaseFieldSchema{Name: "field1", Type: "qdb.String"})
+		db.SetEntitySchema("test-type", &DatabaseEntitySchema{Name: "test-type", Fields: []string{"field1"}})
+		db.CreateEntity("test-type", "", "Root")
+		NewEntity(db, db.ResolveEntityPath("Root")).GetField("field1").PushString(namespace)
+		db.TempSet("lease", namespace, 0)
+
+		return db
+	}
+
+	siteA := connect("site", 0)
+	siteEast := connect("site:east", 0)
+	root := connect("", 0)
+	other := connect("", 1)
+
+	assert.Len(t, siteA.FindEntities("test-type"), 1)
+	assert.Equal(t, []string{"test-type"}, siteEast.GetEntityTypes())
+	assert.Equal(t, "site:east", siteEast.TempGet("lease"))
+	assert.NotEmpty(t, mr.DB(1).Keys())
+
+	// Restoring a snapshot only replaces the keys of its own namespace, even when another namespace
+	// starts with it or when it has no namespace at all
+	leaderKeygen := &LeaderElectionKeyGenerator{}
+	for _, db := range []*RedisDatabase{siteA, siteEast, root} {
+		db.TempSet(leaderKeygen.GetLeaderKey("app"), "instance", 0)
+	}
+
+	snapshot := siteA.CreateSnapshot()
+	siteA.CreateEntity("test-type", "", "Extra")
+	siteA.RestoreSnapshot(snapshot)
+	root.RestoreSnapshot(root.CreateSnapshot())
+
+	assert.Empty(t, siteA.TempGet(leaderKeygen.GetLeaderKey("app")))
+	assert.Empty(t, root.TempGet(leaderKeygen.GetLeaderKey("app")))
+	assert.Equal(t, "instance", siteEast.TempGet(leaderKeygen.GetLeaderKey("app")))
+	assert.Equal(t, "site", siteA.TempGet("lease"))
+
+	for _, db := range []*RedisDatabase{siteA, siteEast, root} {
+		assert.Len(t, db.FindEntities("test-type"), 1)
+		assert.True(t, NewIntegrityChecker(db).Check().IsClean())
+	}
+	assert.Equal(t, "site:east", NewEntity(siteEast, siteEast.ResolveEntityPath("Root")).GetField("field1").PullString())
+	assert.Equal(t, "site:east", siteEast.TempGet("lease"))
+	assert.Len(t, other.FindEntities("test-type"), 1)
+}
+
+func TestRedisDatabaseConfig(t *testing.T) {
+	t.Setenv("QDB_ADDR", "")
+	t.Setenv("QDB_USERNAME", "qdb-user")
+	t.Setenv("QDB_DB", "3")
+	t.Setenv("QDB_TLS", "true")
+	t.Setenv("QDB_SENTINEL_MASTER", "mymaster")
+	t.Setenv("QDB_SENTINEL_ADDRS", "s1:26379, s2:26379,")
+
+	config, err := RedisDatabaseConfigFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "redis:6379", config.Address)
+	assert.Equal(t, "qdb-user", config.Username)
+	assert.Equal(t, 3, config.DB)
+	assert.True(t, config.TLSEnabled)
+	assert.Equal(t, "mymaster", config.SentinelMasterName)
+	assert.Equal(t, []string{"s1:26379", "s2:26379"}, config.SentinelAddresses)
+	assert.Empty(t, config.ClusterAddresses)
+	assert.Equal(t, "master 'mymaster' through sentinels s1:26379,s2:26379", config.describe())
+	assert.Equal(t, "cluster c1:6379,c2:6379", RedisDatabaseConfig{ClusterAddresses: []string{"c1:6379", "c2:6379"}}.describe())
+
+	// Invalid settings are refused instead of falling back to defaults
+	t.Setenv("QDB_TLS", "maybe")
+	_, err = RedisDatabaseConfigFromEnv()
+	assert.ErrorContains(t, err, "QDB_TLS")
+	t.Setenv("QDB_TLS", "true")
+	t.Setenv("QDB_DB", "three")
+	_, err = RedisDatabaseConfigFromEnv()
+	assert.ErrorContains(t, err, "QDB_DB")
+	t.Setenv("QDB_DB", "3")
+	t.Setenv("QDB_CACHE_SIZE", "-x")
+	_, err = RedisDatabaseConfigFromEnv()
+	assert.ErrorContains(t, err, "QDB_CACHE_SIZE")
+
+	// Cluster keys share the hash tag of the namespace
+	keygen := RedisDatabaseConfig{ClusterAddresses: []string{"c1:6379"}}.keyGenerator()
+	assert.Equal(t, "{qdb}:instance:entity:e1", keygen.GetEntityKey("e1"))
+	keygen = RedisDatabaseConfig{ClusterAddresses: []string{"c1:6379"}, Namespace: "site"}.keyGenerator()
+	assert.Equal(t, "{site}:schema:field:f1", keygen.GetFieldSchemaKey("f1"))
+
+	_, err = RedisDatabaseConfig{ClusterAddresses: []string{"c1:6379"}, DB: 1}.newClient()
+	assert.Error(t, err)
+	_, err = RedisDatabaseConfig{TLSCAFile: filepath.Join(t.TempDir(), "missing.pem")}.newClient()
+	assert.Error(t, err)
+
+	// ACL users
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+	mr.RequireUserAuth("qdb-user", "secret")
+
+	db := NewRedisDatabase(RedisDatabaseConfig{Address: mr.Addr(), Username: "qdb-user", Password: "secret"})
+	db.Connect()
+	assert.True(t, db.IsConnected())
+
+	db = NewRedisDatabase(RedisDatabaseConfig{Address: mr.Addr(), Username: "qdb-user", Password: "wrong"})
+	db.Connect()
+	assert.False(t, db.IsConnected())
+}
+
+func TestRedisDatabase_Reconnect(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	db := NewRedisDatabase(RedisDatabaseConfig{
+		Address:             mr.Addr(),
+		ReconnectMinBackoff: 10 * time.Millisecond,
+		ReconnectMaxBackoff: 40 * time.Millisecond,
+	}).(*RedisDatabase)
+
+	connected, disconnected := 0, 0
+	var lastErr error
+	db.ConnectionSignals().Connected.Connect(Slot(func() { connected++ }))
+	db.ConnectionSignals().Disconnected.Connect(SlotWithArgs(func(args ...interface{}) {
+		disconnected++
+		lastErr = args[0].(error)
+	}))
+
+	db.Connect()
+	assert.True(t, db.IsConnected())
+	assert.Equal(t, 1, connected)
+
+	// The first failed command marks the database disconnected, the next ones fail fast. The
+	// signals are emitted by MaintainConnection, from the main loop.
+	mr.Close()
+	assert.Error(t, db.client.Set(context.Background(), "key", "value", 0).Err())
+	assert.False(t, db.IsConnected())
+	assert.Equal(t, 0, disconnected)
+	db.MaintainConnection()
+	assert.Equal(t, 1, disconnected)
+	assert.NotErrorIs(t, lastErr, ErrDatabaseDisconnected)
+	assert.ErrorIs(t, db.client.Get(context.Background(), "key").Err(), ErrDatabaseDisconnected)
+
+	// Failed attempts double the backoff up to the maximum
+	for i := 0; i < 4; i++ {
+		time.Sleep(db.connection.backoff)
+		db.MaintainConnection()
+	}
+	assert.Equal(t, 40*time.Millisecond, db.connection.backoff)
+	assert.Equal(t, 1, disconnected)
+
+	assert.NoError(t, mr.Restart())
+	assert.Eventually(t, func() bool {
+		db.MaintainConnection()
+		return db.IsConnected()
+	}, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, connected)
+	assert.NoError(t, db.client.Set(context.Background(), "key", "value", 0).Err())
+
+	// With a retry timeout, commands wait for the connection to come back
+	db.config.RetryTimeout = 3 * time.Second
+	mr.Close()
+	assert.Error(t, db.client.Get(context.Background(), "key").Err())
+	assert.False(t, db.IsConnected())
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		mr.Restart()
+	}()
+	assert.NoError(t, db.client.Set(context.Background(), "key", "value", 0).Err())
+	assert.True(t, db.IsConnected())
+	assert.Equal(t, 2, connected)
+
+	// Both changes are reported, even though the connection is already back
+	db.MaintainConnection()
+	assert.Equal(t, 3, connected)
+	assert.Equal(t, 2, disconnected)
+}
+
+func TestRedisDatabase_ReadCache(t *testing.T) {
+	writer, mr := setupTestRedis(t)
+	defer mr.Close()
+
+	writer.SetEntitySchema("Root", &DatabaseEntitySchema{
+		Name:   "Root",
+		Fields: []string{"field1"},
+	})
+	writer.CreateEntity("Root", "", "Root")
+	rootId := writer.FindEntities("Root")[0]
+
+	db := NewRedisDatabase(RedisDatabaseConfig{Address: mr.Addr(), CacheSize: 3}).(*RedisDatabase)
+	db.Connect()
+
+	read := func() string {
+		return NewField(db, rootId, "field1").PullString()
+	}
+
+	NewField(writer, rootId, "field1").PushString("before")
+	assert.Equal(t, "before", read())
+	assert.Equal(t, "before", read())
+	assert.NotNil(t, db.GetEntity(rootId))
+	assert.NotNil(t, db.GetEntitySchema("Root"))
+
+	stats := db.CacheStats()
+	assert.Equal(t, 3, stats.Entries)
+	assert.NotZero(t, stats.Hits)
+
+	// Cached values stay until the invalidations of other services are processed
+	NewField(writer, rootId, "field1").PushString("after")
+	assert.Equal(t, "before", read())
+	db.ProcessNotifications()
+	assert.Equal(t, "after", read())
+	assert.NotZero(t, db.CacheStats().Invalidations)
+
+	// Own writes are visible right away, and callers cannot modify the cached values
+	NewField(db, rootId, "field1").PushString("own")
+	assert.Equal(t, "own", read())
+
+	entity := db.GetEntity(rootId)
+	entity.Name = "Modified"
+	assert.Equal(t, "Root", db.GetEntity(rootId).Name)
+
+	// The size bound evicts the least recently used entries
+	db.GetFieldSchema("field1")
+	db.GetFieldSchema("field2")
+	db.GetFieldSchema("test-field")
+	assert.Equal(t, 3, db.CacheStats().Entries)
+	assert.NotZero(t, db.CacheStats().Evictions)
+
+	// Restoring a snapshot drops everything
+	snapshot := writer.CreateSnapshot()
+	writer.RestoreSnapshot(snapshot)
+	db.ProcessNotifications()
+	assert.Equal(t, 0, db.CacheStats().Entries)
+
+	// A disabled cache never hits
+	writer.GetEntity(rootId)
+	assert.Equal(t, CacheStats{}, writer.CacheStats())
+}
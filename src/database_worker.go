@@ -1,7 +1,5 @@
 package qdb
 
-import "time"
-
 type DatabaseWorkerSignals struct {
 	Connected     Signal
 	Disconnected  Signal
@@ -11,23 +9,22 @@ type DatabaseWorkerSignals struct {
 type DatabaseWorker struct {
 	Signals DatabaseWorkerSignals
 
-	db                    IDatabase
-	connectionState       ConnectionState_ConnectionStateEnum
-	connectionCheckTicker *time.Ticker
-	notificationTokens    []INotificationToken
+	db                 IDatabase
+	connectionState    ConnectionState_ConnectionStateEnum
+	notificationTokens []INotificationToken
 }
 
 func NewDatabaseWorker(db IDatabase) *DatabaseWorker {
 	return &DatabaseWorker{
-		db:                    db,
-		connectionState:       ConnectionState_DISCONNECTED,
-		notificationTokens:    []INotificationToken{},
-		connectionCheckTicker: time.NewTicker(5 * time.Second),
+		db:                 db,
+		connectionState:    ConnectionState_DISCONNECTED,
+		notificationTokens: []INotificationToken{},
 	}
 }
 
 func (w *DatabaseWorker) Init() {
 	w.Signals.Connected.Connect(Slot(w.onDatabaseConnected))
+	w.db.Connect()
 }
 
 func (w *DatabaseWorker) Deinit() {
@@ -35,16 +32,9 @@ func (w *DatabaseWorker) Deinit() {
 }
 
 func (w *DatabaseWorker) DoWork() {
-	select {
-	case <-w.connectionCheckTicker.C:
-		w.setConnectionStatus(w.db.IsConnected())
-
-		if !w.IsConnected() {
-			w.db.Connect()
-			return
-		}
-	default:
-	}
+	// The database reconnects with backoff and checks its connection on its own schedule
+	w.db.MaintainConnection()
+	w.setConnectionStatus(w.db.IsConnected())
 
 	if w.IsConnected() {
 		w.db.ProcessNotifications()
@@ -2,6 +2,11 @@ package qdb
 
 import (
 	"cmp"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -21,24 +26,39 @@ type IField interface {
 	PullValue(m proto.Message) proto.Message
 	PullInt() int64
 	PullFloat() float64
+	PullFloatIn(unit string) float64
 	PullString() string
 	PullBool() bool
 	PullBinaryFile() string
+	PullBinaryFileContent(w io.Writer) bool
 	PullEntityReference() string
 	PullTimestamp() time.Time
 	PullTransformation() string
+	PullIntList() []int64
+	PullStringList() []string
+	PullEntityReferenceList() []string
+	PullStringMap() map[string]string
+	PullEnum() int64
+	PullEnumName() string
 	PullWriteTime() time.Time
 	PullWriter() string
 
 	GetValue(m proto.Message) proto.Message
 	GetInt() int64
 	GetFloat() float64
+	GetFloatIn(unit string) float64
 	GetString() string
 	GetBool() bool
 	GetBinaryFile() string
 	GetEntityReference() string
 	GetTimestamp() time.Time
 	GetTransformation() string
+	GetIntList() []int64
+	GetStringList() []string
+	GetEntityReferenceList() []string
+	GetStringMap() map[string]string
+	GetEnum() int64
+	GetEnumName() string
 	GetWriteTime() time.Time
 	GetWriter() string
 	GetId() string
@@ -50,9 +70,15 @@ type IField interface {
 	PushString(...interface{}) bool
 	PushBool(...interface{}) bool
 	PushBinaryFile(...interface{}) bool
+	PushBinaryFileContent(content io.Reader, mimeType string) bool
 	PushEntityReference(...interface{}) bool
 	PushTimestamp(...interface{}) bool
 	PushTransformation(...interface{}) bool
+	PushIntList(...interface{}) bool
+	PushStringList(...interface{}) bool
+	PushEntityReferenceList(...interface{}) bool
+	PushStringMap(...interface{}) bool
+	PushEnum(...interface{}) bool
 }
 
 type Field struct {
@@ -150,6 +176,56 @@ func NewTransformationValue(value string) *anypb.Any {
 	return a
 }
 
+func NewIntListValue(value []int64) *anypb.Any {
+	a, err := anypb.New(&IntList{Raw: value})
+	if err != nil {
+		Error("[NewIntListValue] Failed to create Any: %s", err.Error())
+		return nil
+	}
+
+	return a
+}
+
+func NewStringListValue(value []string) *anypb.Any {
+	a, err := anypb.New(&StringList{Raw: value})
+	if err != nil {
+		Error("[NewStringListValue] Failed to create Any: %s", err.Error())
+		return nil
+	}
+
+	return a
+}
+
+func NewEntityReferenceListValue(value []string) *anypb.Any {
+	a, err := anypb.New(&EntityReferenceList{Raw: value})
+	if err != nil {
+		Error("[NewEntityReferenceListValue] Failed to create Any: %s", err.Error())
+		return nil
+	}
+
+	return a
+}
+
+func NewStringMapValue(value map[string]string) *anypb.Any {
+	a, err := anypb.New(&StringMap{Raw: value})
+	if err != nil {
+		Error("[NewStringMapValue] Failed to create Any: %s", err.Error())
+		return nil
+	}
+
+	return a
+}
+
+func NewEnumValue(value int64) *anypb.Any {
+	a, err := anypb.New(&Enum{Raw: value})
+	if err != nil {
+		Error("[NewEnumValue] Failed to create Any: %s", err.Error())
+		return nil
+	}
+
+	return a
+}
+
 func (f *Field) PullValue(m proto.Message) proto.Message {
 	f.db.Read([]*DatabaseRequest{f.req})
 
@@ -178,6 +254,12 @@ func (f *Field) PullFloat() float64 {
 	return f.PullValue(new(Float)).(*Float).GetRaw()
 }
 
+func (f *Field) PullFloatIn(unit string) float64 {
+	f.db.Read([]*DatabaseRequest{f.req})
+
+	return f.GetFloatIn(unit)
+}
+
 func (f *Field) PullString() string {
 	return f.PullValue(new(String)).(*String).GetRaw()
 }
@@ -190,6 +272,17 @@ func (f *Field) PullBinaryFile() string {
 	return f.PullValue(new(BinaryFile)).(*BinaryFile).GetRaw()
 }
 
+// PullBinaryFileContent streams the content of the file to w, whether it is kept in the
+// blob store or inline in the field value
+func (f *Field) PullBinaryFileContent(w io.Writer) bool {
+	file := f.PullValue(new(BinaryFile)).(*BinaryFile)
+	if !f.req.Success {
+		return false
+	}
+
+	return f.db.ReadBlob(file, w)
+}
+
 func (f *Field) PullEntityReference() string {
 	return f.PullValue(new(EntityReference)).(*EntityReference).GetRaw()
 }
@@ -202,6 +295,32 @@ func (f *Field) PullTransformation() string {
 	return f.PullValue(new(Transformation)).(*Transformation).GetRaw()
 }
 
+func (f *Field) PullIntList() []int64 {
+	return f.PullValue(new(IntList)).(*IntList).GetRaw()
+}
+
+func (f *Field) PullStringList() []string {
+	return f.PullValue(new(StringList)).(*StringList).GetRaw()
+}
+
+func (f *Field) PullEntityReferenceList() []string {
+	return f.PullValue(new(EntityReferenceList)).(*EntityReferenceList).GetRaw()
+}
+
+func (f *Field) PullStringMap() map[string]string {
+	return f.PullValue(new(StringMap)).(*StringMap).GetRaw()
+}
+
+func (f *Field) PullEnum() int64 {
+	return f.PullValue(new(Enum)).(*Enum).GetRaw()
+}
+
+func (f *Field) PullEnumName() string {
+	f.db.Read([]*DatabaseRequest{f.req})
+
+	return f.GetEnumName()
+}
+
 func (f *Field) PushInt(args ...interface{}) bool {
 	value := int64(0)
 
@@ -398,6 +517,21 @@ func (f *Field) PushBinaryFile(args ...interface{}) bool {
 	return f.PushValue(&BinaryFile{Raw: value})
 }
 
+// PushBinaryFileContent stores the content in the blob store and writes a reference to it
+func (f *Field) PushBinaryFileContent(content io.Reader, mimeType string) bool {
+	file := f.db.WriteBlob(content, mimeType)
+	if file == nil {
+		return false
+	}
+
+	if !f.PushValue(file) {
+		f.db.DiscardBlob(file)
+		return false
+	}
+
+	return true
+}
+
 func (f *Field) PushEntityReference(args ...interface{}) bool {
 	value := ""
 
@@ -495,6 +629,202 @@ func (f *Field) PushTransformation(args ...interface{}) bool {
 	return f.PushValue(&Transformation{Raw: value})
 }
 
+func (f *Field) PushIntList(args ...interface{}) bool {
+	value := []int64{}
+
+	if len(args) > 0 {
+		switch v := args[0].(type) {
+		case []int64:
+			value = v
+		case []int:
+			for _, i := range v {
+				value = append(value, int64(i))
+			}
+		case []interface{}:
+			for _, i := range v {
+				switch c := i.(type) {
+				case int:
+					value = append(value, int64(c))
+				case int64:
+					value = append(value, c)
+				case float64:
+					value = append(value, int64(c))
+				default:
+					Error("[Field::PushIntList] Unsupported element type: %T", c)
+					return false
+				}
+			}
+		default:
+			Error("[Field::PushIntList] Unsupported type: %T", v)
+			return false
+		}
+	}
+
+	if len(args) > 1 {
+		pushIfNotEqual := args[1].(PushOpt) == PushIfNotEqual
+
+		if pushIfNotEqual && slices.Equal(f.PullIntList(), value) {
+			return false
+		}
+	}
+
+	return f.PushValue(&IntList{Raw: value})
+}
+
+func (f *Field) PushStringList(args ...interface{}) bool {
+	value := []string{}
+
+	if len(args) > 0 {
+		switch v := args[0].(type) {
+		case []string:
+			value = v
+		case []interface{}:
+			for _, i := range v {
+				c, ok := i.(string)
+				if !ok {
+					Error("[Field::PushStringList] Unsupported element type: %T", i)
+					return false
+				}
+				value = append(value, c)
+			}
+		default:
+			Error("[Field::PushStringList] Unsupported type: %T", v)
+			return false
+		}
+	}
+
+	if len(args) > 1 {
+		pushIfNotEqual := args[1].(PushOpt) == PushIfNotEqual
+
+		if pushIfNotEqual && slices.Equal(f.PullStringList(), value) {
+			return false
+		}
+	}
+
+	return f.PushValue(&StringList{Raw: value})
+}
+
+func (f *Field) PushEntityReferenceList(args ...interface{}) bool {
+	value := []string{}
+
+	if len(args) > 0 {
+		switch v := args[0].(type) {
+		case []string:
+			value = v
+		case []interface{}:
+			for _, i := range v {
+				c, ok := i.(string)
+				if !ok {
+					Error("[Field::PushEntityReferenceList] Unsupported element type: %T", i)
+					return false
+				}
+				value = append(value, c)
+			}
+		default:
+			Error("[Field::PushEntityReferenceList] Unsupported type: %T", v)
+			return false
+		}
+	}
+
+	if len(args) > 1 {
+		pushIfNotEqual := args[1].(PushOpt) == PushIfNotEqual
+
+		if pushIfNotEqual && slices.Equal(f.PullEntityReferenceList(), value) {
+			return false
+		}
+	}
+
+	// Check if entities exist
+	for _, entityId := range value {
+		if entityId != "" && f.db.GetEntity(entityId) == nil {
+			Error("[Field::PushEntityReferenceList] Entity does not exist: %s", entityId)
+			return false
+		}
+	}
+
+	return f.PushValue(&EntityReferenceList{Raw: value})
+}
+
+func (f *Field) PushStringMap(args ...interface{}) bool {
+	value := map[string]string{}
+
+	if len(args) > 0 {
+		switch v := args[0].(type) {
+		case map[string]string:
+			value = v
+		case map[string]interface{}:
+			for k, i := range v {
+				c, ok := i.(string)
+				if !ok {
+					Error("[Field::PushStringMap] Unsupported element type: %T", i)
+					return false
+				}
+				value[k] = c
+			}
+		default:
+			Error("[Field::PushStringMap] Unsupported type: %T", v)
+			return false
+		}
+	}
+
+	if len(args) > 1 {
+		pushIfNotEqual := args[1].(PushOpt) == PushIfNotEqual
+
+		if pushIfNotEqual && maps.Equal(f.PullStringMap(), value) {
+			return false
+		}
+	}
+
+	return f.PushValue(&StringMap{Raw: value})
+}
+
+func (f *Field) PushEnum(args ...interface{}) bool {
+	value := int64(0)
+
+	if len(args) > 0 {
+		switch v := args[0].(type) {
+		case int:
+			value = int64(v)
+		case int32:
+			value = int64(v)
+		case int64:
+			value = v
+		case uint:
+			value = int64(v)
+		case uint32:
+			value = int64(v)
+		case uint64:
+			value = int64(v)
+		case string:
+			schema := f.getSchema()
+			if schema == nil {
+				Error("[Field::PushEnum] Failed to get field schema for %s", f.req.Field)
+				return false
+			}
+
+			enumValue, ok := schema.EnumValue(v)
+			if !ok {
+				Error("[Field::PushEnum] Unknown enum name '%s' for %s", v, f.req.Field)
+				return false
+			}
+			value = enumValue
+		default:
+			Error("[Field::PushEnum] Unsupported type: %T", v)
+			return false
+		}
+	}
+
+	if len(args) > 1 {
+		pushIfNotEqual := args[1].(PushOpt) == PushIfNotEqual
+
+		if pushIfNotEqual && f.PullEnum() == value {
+			return false
+		}
+	}
+
+	return f.PushValue(&Enum{Raw: value})
+}
+
 func (f *Field) PullWriteTime() time.Time {
 	f.db.Read([]*DatabaseRequest{f.req})
 
@@ -527,6 +857,43 @@ func (f *Field) GetFloat() float64 {
 	return f.GetValue(new(Float)).(*Float).GetRaw()
 }
 
+// GetFloatIn returns the engineering value of an Int or Float field converted to the
+// requested unit. An empty unit returns the value in the unit of the field schema.
+func (f *Field) GetFloatIn(unit string) float64 {
+	if !f.req.Success {
+		return 0
+	}
+
+	schema := f.getSchema()
+	if schema == nil {
+		return 0
+	}
+
+	raw := float64(0)
+	switch {
+	case f.req.Value.MessageIs(&Int{}):
+		raw = float64(f.GetInt())
+	case f.req.Value.MessageIs(&Float{}):
+		raw = f.GetFloat()
+	default:
+		Error("[Field::GetFloatIn] Field %s is not numeric", f.req.Field)
+		return 0
+	}
+
+	value := schema.ToEngineering(raw)
+	if unit == "" {
+		return value
+	}
+
+	converted, ok := ConvertUnit(value, schema.GetUnit(), unit)
+	if !ok {
+		Error("[Field::GetFloatIn] Cannot convert %s from '%s' to '%s'", f.req.Field, schema.GetUnit(), unit)
+		return 0
+	}
+
+	return converted
+}
+
 func (f *Field) GetString() string {
 	return f.GetValue(new(String)).(*String).GetRaw()
 }
@@ -551,6 +918,36 @@ func (f *Field) GetTransformation() string {
 	return f.GetValue(new(Transformation)).(*Transformation).GetRaw()
 }
 
+func (f *Field) GetIntList() []int64 {
+	return f.GetValue(new(IntList)).(*IntList).GetRaw()
+}
+
+func (f *Field) GetStringList() []string {
+	return f.GetValue(new(StringList)).(*StringList).GetRaw()
+}
+
+func (f *Field) GetEntityReferenceList() []string {
+	return f.GetValue(new(EntityReferenceList)).(*EntityReferenceList).GetRaw()
+}
+
+func (f *Field) GetStringMap() map[string]string {
+	return f.GetValue(new(StringMap)).(*StringMap).GetRaw()
+}
+
+func (f *Field) GetEnum() int64 {
+	return f.GetValue(new(Enum)).(*Enum).GetRaw()
+}
+
+func (f *Field) GetEnumName() string {
+	schema := f.getSchema()
+	if schema == nil {
+		return ""
+	}
+
+	name, _ := schema.EnumName(f.GetEnum())
+	return name
+}
+
 func (f *Field) GetWriteTime() time.Time {
 	if !f.req.Success {
 		return time.Time{}
@@ -575,13 +972,25 @@ func (f *Field) GetName() string {
 	return f.req.Field
 }
 
+// getSchema returns the schema of the field at the end of any indirection
+func (f *Field) getSchema() *DatabaseFieldSchema {
+	fields := strings.Split(f.req.Field, "->")
+	return f.db.GetFieldSchema(fields[len(fields)-1])
+}
+
 type IEntity interface {
 	GetId() string
 	GetType() string
 	GetName() string
 	GetChildren() []*EntityReference
 	GetParent() *EntityReference
+	GetPath() string
 	GetField(string) IField
+
+	GetAncestors() []IEntity
+	GetDescendants(options TraversalOptions) []IEntity
+	GetSiblings() []IEntity
+	FindAncestorOfType(entityType string) IEntity
 }
 
 type Entity struct {
@@ -616,6 +1025,11 @@ func (e *Entity) GetParent() *EntityReference {
 	return e.entity.Parent
 }
 
+// GetPath returns the entity's full path of names, for example "Root/Building1/Floor2/Sensor7"
+func (e *Entity) GetPath() string {
+	return e.db.GetEntityPath(e.GetId())
+}
+
 func (e *Entity) GetField(fieldName string) IField {
 	return NewField(e.db, e.GetId(), fieldName)
 }
@@ -629,279 +1043,756 @@ func DefaultCaster[A any, B any](in A) B {
 	return any(in).(B)
 }
 
+// FieldConditionEval decides whether an entity matches a search condition
 type FieldConditionEval func(IDatabase, string) bool
-type FieldCondition[T IFieldProto[K], C cmp.Ordered, K comparable] struct {
-	Lhs      string
-	LhsValue T
-	Caster   func(K) C
+
+// plannedCondition is the plan of a Condition. It knows the fields it reads, so that EntityFinder
+// can read them for all remaining entities at once before evaluating, and it can narrow down the
+// entities to evaluate with a secondary index. Candidates returns false if no index can be used.
+type plannedCondition interface {
+	Evaluate(db IDatabase, entityId string) bool
+	Fields() []string
+	Candidates(db IDatabase, entityType string) ([]string, bool)
 }
 
-func (f *FieldCondition[T, C, K]) Where(lhs string) *FieldCondition[T, C, K] {
-	f.Lhs = lhs
-	return f
+// Condition is a search condition built by this package, carrying the plan EntityFinder uses to
+// batch its reads and pick a secondary index
+type Condition struct {
+	plan plannedCondition
 }
 
-func (f *FieldCondition[T, C, K]) IsEqualTo(rhs T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+func planned(plan plannedCondition) *Condition {
+	return &Condition{plan: plan}
+}
 
-		if !request.Success {
-			return false
-		}
+// Custom turns a function into a Condition, so it can be combined with the built ones. It has no
+// plan: the fields it reads are read one entity at a time.
+func Custom(eval FieldConditionEval) *Condition {
+	return planned(&fieldCondition{eval: eval})
+}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+func (c *Condition) Evaluate(db IDatabase, entityId string) bool {
+	return c.plan.Evaluate(db, entityId)
+}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsEqualTo] Failed to unmarshal value: %s", err.Error())
-			return false
-		}
-		f.LhsValue = lhsValue.(T)
+type fieldCondition struct {
+	eval       FieldConditionEval
+	fields     []string
+	candidates func(IDatabase, string) ([]string, bool)
+}
 
-		return f.Caster(f.LhsValue.GetRaw()) == f.Caster(rhs.GetRaw())
-	}
+func (c *fieldCondition) Evaluate(db IDatabase, entityId string) bool {
+	return c.eval(db, entityId)
 }
 
-func (f *FieldCondition[T, C, K]) IsNotEqualTo(rhs T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+func (c *fieldCondition) Fields() []string {
+	return c.fields
+}
 
-		if !request.Success {
-			return false
-		}
+func (c *fieldCondition) Candidates(db IDatabase, entityType string) ([]string, bool) {
+	if c.candidates == nil {
+		return nil, false
+	}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+	return c.candidates(db, entityType)
+}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsNotEqualTo] Failed to unmarshal value: %s", err.Error())
-			return false
-		}
-		f.LhsValue = lhsValue.(T)
+// All matches entities meeting every condition, evaluated in order until one fails
+func All(conditions ...*Condition) *Condition {
+	return planned(&allCondition{conditions: conditions})
+}
 
-		return f.Caster(f.LhsValue.GetRaw()) != f.Caster(rhs.GetRaw())
-	}
+// Any matches entities meeting at least one condition, evaluated in order until one succeeds
+func Any(conditions ...*Condition) *Condition {
+	return planned(&anyCondition{conditions: conditions})
 }
 
-func (f *FieldCondition[T, C, K]) IsGreaterThan(rhs T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+// Not matches entities that do not meet the condition
+func Not(condition *Condition) *Condition {
+	return planned(&notCondition{condition: condition})
+}
+
+type allCondition struct {
+	conditions []*Condition
+}
 
-		if !request.Success {
+func (c *allCondition) Evaluate(db IDatabase, entityId string) bool {
+	for _, condition := range c.conditions {
+		if !condition.Evaluate(db, entityId) {
 			return false
 		}
+	}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+	return true
+}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsGreaterThan] Failed to unmarshal value: %s", err.Error())
-			return false
-		}
-		f.LhsValue = lhsValue.(T)
+func (c *allCondition) Fields() []string {
+	return conditionFields(c.conditions...)
+}
 
-		return f.Caster(f.LhsValue.GetRaw()) > f.Caster(rhs.GetRaw())
-	}
+// Candidates of All are those of its most selective indexed condition
+func (c *allCondition) Candidates(db IDatabase, entityType string) ([]string, bool) {
+	return smallestCandidates(db, entityType, c.conditions)
 }
 
-func (f *FieldCondition[T, C, K]) IsLessThan(rhs T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+type anyCondition struct {
+	conditions []*Condition
+}
 
-		if !request.Success {
-			return false
+func (c *anyCondition) Evaluate(db IDatabase, entityId string) bool {
+	for _, condition := range c.conditions {
+		if condition.Evaluate(db, entityId) {
+			return true
 		}
+	}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+	return false
+}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsLessThan] Failed to unmarshal value: %s", err.Error())
-			return false
+func (c *anyCondition) Fields() []string {
+	return conditionFields(c.conditions...)
+}
+
+// Candidates of Any are the union of those of its conditions, if all of them are indexed
+func (c *anyCondition) Candidates(db IDatabase, entityType string) ([]string, bool) {
+	candidates := []string{}
+
+	for _, condition := range c.conditions {
+		entityIds, ok := condition.plan.Candidates(db, entityType)
+		if !ok {
+			return nil, false
 		}
-		f.LhsValue = lhsValue.(T)
 
-		return f.Caster(f.LhsValue.GetRaw()) < f.Caster(rhs.GetRaw())
+		for _, entityId := range entityIds {
+			if !slices.Contains(candidates, entityId) {
+				candidates = append(candidates, entityId)
+			}
+		}
 	}
+
+	return candidates, true
 }
 
-func (f *FieldCondition[T, C, K]) IsGreaterThanOrEqualTo(rhs T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+type notCondition struct {
+	condition *Condition
+}
 
-		if !request.Success {
-			return false
-		}
+func (c *notCondition) Evaluate(db IDatabase, entityId string) bool {
+	return !c.condition.Evaluate(db, entityId)
+}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+func (c *notCondition) Fields() []string {
+	return conditionFields(c.condition)
+}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsGreaterThanOrEqualTo] Failed to unmarshal value: %s", err.Error())
-			return false
-		}
-		f.LhsValue = lhsValue.(T)
+func (c *notCondition) Candidates(IDatabase, string) ([]string, bool) {
+	return nil, false
+}
+
+func conditionFields(conditions ...*Condition) []string {
+	fields := []string{}
 
-		return f.Caster(f.LhsValue.GetRaw()) >= f.Caster(rhs.GetRaw())
+	for _, condition := range conditions {
+		for _, field := range condition.plan.Fields() {
+			if !slices.Contains(fields, field) {
+				fields = append(fields, field)
+			}
+		}
 	}
+
+	return fields
 }
 
-func (f *FieldCondition[T, C, K]) IsLessThanOrEqualTo(rhs T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+func smallestCandidates(db IDatabase, entityType string, conditions []*Condition) ([]string, bool) {
+	var candidates []string
+	planned := false
 
-		if !request.Success {
-			return false
+	for _, condition := range conditions {
+		entityIds, ok := condition.plan.Candidates(db, entityType)
+		if ok && (!planned || len(entityIds) < len(candidates)) {
+			candidates = entityIds
+			planned = true
 		}
+	}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+	return candidates, planned
+}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			return false
-		}
-		f.LhsValue = lhsValue.(T)
+type FieldCondition[T IFieldProto[K], C cmp.Ordered, K comparable] struct {
+	Lhs      string
+	LhsValue T
+	Caster   func(K) C
+}
 
-		return f.Caster(f.LhsValue.GetRaw()) <= f.Caster(rhs.GetRaw())
-	}
+func (f *FieldCondition[T, C, K]) Where(lhs string) *FieldCondition[T, C, K] {
+	f.Lhs = lhs
+	return f
 }
 
-func (f *FieldCondition[T, C, K]) IsBetween(lower T, upper T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+func (f *FieldCondition[T, C, K]) IsEqualTo(rhs T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
 
-		if !request.Success {
-			return false
-		}
+			if !request.Success {
+				return false
+			}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsBetween] Failed to unmarshal value: %s", err.Error())
-			return false
-		}
-		f.LhsValue = lhsValue.(T)
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsEqualTo] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
 
-		return f.Caster(f.LhsValue.GetRaw()) >= f.Caster(lower.GetRaw()) && f.Caster(f.LhsValue.GetRaw()) <= f.Caster(upper.GetRaw())
-	}
+			return f.Caster(f.LhsValue.GetRaw()) == f.Caster(rhs.GetRaw())
+		},
+		fields:     []string{f.Lhs},
+		candidates: f.byValue(rhs),
+	})
 }
 
-func (f *FieldCondition[T, C, K]) IsIn(values []T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
-		}
-		db.Read([]*DatabaseRequest{request})
+func (f *FieldCondition[T, C, K]) IsNotEqualTo(rhs T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
 
-		if !request.Success {
-			return false
-		}
+			if !request.Success {
+				return false
+			}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsNotEqualTo] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			return f.Caster(f.LhsValue.GetRaw()) != f.Caster(rhs.GetRaw())
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsGreaterThan(rhs T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				return false
+			}
+
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsGreaterThan] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			return f.Caster(f.LhsValue.GetRaw()) > f.Caster(rhs.GetRaw())
+		},
+		fields:     []string{f.Lhs},
+		candidates: f.byRange(rhs, nil),
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsLessThan(rhs T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				return false
+			}
+
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsLessThan] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			return f.Caster(f.LhsValue.GetRaw()) < f.Caster(rhs.GetRaw())
+		},
+		fields:     []string{f.Lhs},
+		candidates: f.byRange(nil, rhs),
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsGreaterThanOrEqualTo(rhs T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				return false
+			}
+
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsGreaterThanOrEqualTo] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			return f.Caster(f.LhsValue.GetRaw()) >= f.Caster(rhs.GetRaw())
+		},
+		fields:     []string{f.Lhs},
+		candidates: f.byRange(rhs, nil),
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsLessThanOrEqualTo(rhs T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				return false
+			}
+
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			return f.Caster(f.LhsValue.GetRaw()) <= f.Caster(rhs.GetRaw())
+		},
+		fields:     []string{f.Lhs},
+		candidates: f.byRange(nil, rhs),
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsBetween(lower T, upper T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				return false
+			}
+
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsBetween] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			return f.Caster(f.LhsValue.GetRaw()) >= f.Caster(lower.GetRaw()) && f.Caster(f.LhsValue.GetRaw()) <= f.Caster(upper.GetRaw())
+		},
+		fields:     []string{f.Lhs},
+		candidates: f.byRange(lower, upper),
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsIn(values []T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				return false
+			}
+
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsIn] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			for _, value := range values {
+				if f.Caster(f.LhsValue.GetRaw()) == f.Caster(value.GetRaw()) {
+					return true
+				}
+			}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsIn] Failed to unmarshal value: %s", err.Error())
 			return false
+		},
+		fields:     []string{f.Lhs},
+		candidates: f.byValue(values...),
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsNotIn(values []T) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: f.Lhs,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				return false
+			}
+
+			if !request.Value.MessageIs(f.LhsValue) {
+				return false
+			}
+
+			lhsValue, err := request.Value.UnmarshalNew()
+			if err != nil {
+				Error("[FieldCondition::IsNotIn] Failed to unmarshal value: %s", err.Error())
+				return false
+			}
+			f.LhsValue = lhsValue.(T)
+
+			for _, value := range values {
+				if f.Caster(f.LhsValue.GetRaw()) == f.Caster(value.GetRaw()) {
+					return false
+				}
+			}
+
+			return true
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+// compareToField compares the field with another field of the same entity. Both fields must
+// hold values of the condition type.
+func (f *FieldCondition[T, C, K]) compareToField(rhs string, accept func(int) bool) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			requests := []*DatabaseRequest{
+				{
+					Id:    entityId,
+					Field: f.Lhs,
+				},
+				{
+					Id:    entityId,
+					Field: rhs,
+				},
+			}
+			db.Read(requests)
+
+			values := []T{}
+			for _, request := range requests {
+				if !request.Success || !request.Value.MessageIs(f.LhsValue) {
+					return false
+				}
+
+				value, err := request.Value.UnmarshalNew()
+				if err != nil {
+					Error("[FieldCondition::compareToField] Failed to unmarshal value: %s", err.Error())
+					return false
+				}
+				values = append(values, value.(T))
+			}
+
+			return accept(cmp.Compare(f.Caster(values[0].GetRaw()), f.Caster(values[1].GetRaw())))
+		},
+		fields: []string{f.Lhs, rhs},
+	})
+}
+
+func (f *FieldCondition[T, C, K]) IsEqualToField(rhs string) *Condition {
+	return f.compareToField(rhs, func(c int) bool { return c == 0 })
+}
+
+func (f *FieldCondition[T, C, K]) IsNotEqualToField(rhs string) *Condition {
+	return f.compareToField(rhs, func(c int) bool { return c != 0 })
+}
+
+func (f *FieldCondition[T, C, K]) IsGreaterThanField(rhs string) *Condition {
+	return f.compareToField(rhs, func(c int) bool { return c > 0 })
+}
+
+func (f *FieldCondition[T, C, K]) IsLessThanField(rhs string) *Condition {
+	return f.compareToField(rhs, func(c int) bool { return c < 0 })
+}
+
+func (f *FieldCondition[T, C, K]) IsGreaterThanOrEqualToField(rhs string) *Condition {
+	return f.compareToField(rhs, func(c int) bool { return c >= 0 })
+}
+
+func (f *FieldCondition[T, C, K]) IsLessThanOrEqualToField(rhs string) *Condition {
+	return f.compareToField(rhs, func(c int) bool { return c <= 0 })
+}
+
+// byValue looks up the entities whose field equals one of the values in the index of the field
+func (f *FieldCondition[T, C, K]) byValue(values ...T) func(IDatabase, string) ([]string, bool) {
+	return func(db IDatabase, entityType string) ([]string, bool) {
+		if strings.Contains(f.Lhs, "->") {
+			return nil, false
 		}
-		f.LhsValue = lhsValue.(T)
 
+		anys := []*anypb.Any{}
 		for _, value := range values {
-			if f.Caster(f.LhsValue.GetRaw()) == f.Caster(value.GetRaw()) {
-				return true
+			a, err := anypb.New(value)
+			if err != nil {
+				return nil, false
 			}
+			anys = append(anys, a)
 		}
 
-		return false
+		return db.FindEntitiesByIndex(entityType, f.Lhs, anys)
 	}
 }
 
-func (f *FieldCondition[T, C, K]) IsNotIn(values []T) FieldConditionEval {
-	return func(db IDatabase, entityId string) bool {
-		request := &DatabaseRequest{
-			Id:    entityId,
-			Field: f.Lhs,
+// byRange looks up the entities whose field is within the bounds in the sorted index of the field.
+// A nil bound is open. Bounds are inclusive; strict comparisons are left to the condition itself.
+func (f *FieldCondition[T, C, K]) byRange(lower, upper proto.Message) func(IDatabase, string) ([]string, bool) {
+	return func(db IDatabase, entityType string) ([]string, bool) {
+		if strings.Contains(f.Lhs, "->") {
+			return nil, false
 		}
-		db.Read([]*DatabaseRequest{request})
 
-		if !request.Success {
-			return false
-		}
+		bounds := []float64{math.Inf(-1), math.Inf(1)}
+		for i, bound := range []proto.Message{lower, upper} {
+			if bound == nil {
+				continue
+			}
 
-		if !request.Value.MessageIs(f.LhsValue) {
-			return false
-		}
+			a, err := anypb.New(bound)
+			if err != nil {
+				return nil, false
+			}
 
-		lhsValue, err := request.Value.UnmarshalNew()
-		if err != nil {
-			Error("[FieldCondition::IsNotIn] Failed to unmarshal value: %s", err.Error())
-			return false
+			score, ok := indexScore(a)
+			if !ok {
+				return nil, false
+			}
+			bounds[i] = score
 		}
-		f.LhsValue = lhsValue.(T)
 
-		for _, value := range values {
-			if f.Caster(f.LhsValue.GetRaw()) == f.Caster(value.GetRaw()) {
+		return db.FindEntitiesByRange(entityType, f.Lhs, bounds[0], bounds[1])
+	}
+}
+
+type IFieldListProto[T comparable] interface {
+	protoreflect.ProtoMessage
+	GetRaw() []T
+}
+
+type FieldListCondition[T IFieldListProto[K], K comparable] struct {
+	Lhs      string
+	LhsValue T
+}
+
+func (f *FieldListCondition[T, K]) Where(lhs string) *FieldListCondition[T, K] {
+	f.Lhs = lhs
+	return f
+}
+
+func (f *FieldListCondition[T, K]) pull(db IDatabase, entityId string) ([]K, bool) {
+	request := &DatabaseRequest{
+		Id:    entityId,
+		Field: f.Lhs,
+	}
+	db.Read([]*DatabaseRequest{request})
+
+	if !request.Success {
+		return nil, false
+	}
+
+	if !request.Value.MessageIs(f.LhsValue) {
+		return nil, false
+	}
+
+	lhsValue, err := request.Value.UnmarshalNew()
+	if err != nil {
+		Error("[FieldListCondition::pull] Failed to unmarshal value: %s", err.Error())
+		return nil, false
+	}
+	f.LhsValue = lhsValue.(T)
+
+	return f.LhsValue.GetRaw(), true
+}
+
+func (f *FieldListCondition[T, K]) Contains(rhs K) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			lhs, ok := f.pull(db, entityId)
+			return ok && slices.Contains(lhs, rhs)
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+func (f *FieldListCondition[T, K]) DoesNotContain(rhs K) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			lhs, ok := f.pull(db, entityId)
+			return ok && !slices.Contains(lhs, rhs)
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+func (f *FieldListCondition[T, K]) IsEqualTo(rhs []K) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			lhs, ok := f.pull(db, entityId)
+			return ok && slices.Equal(lhs, rhs)
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+func (f *FieldListCondition[T, K]) IsEmpty() *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			lhs, ok := f.pull(db, entityId)
+			return ok && len(lhs) == 0
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+func (f *FieldListCondition[T, K]) HasLength(length int) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			lhs, ok := f.pull(db, entityId)
+			return ok && len(lhs) == length
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+type FieldMapCondition struct {
+	Lhs string
+}
+
+func (f *FieldMapCondition) Where(lhs string) *FieldMapCondition {
+	f.Lhs = lhs
+	return f
+}
+
+func (f *FieldMapCondition) pull(db IDatabase, entityId string) (map[string]string, bool) {
+	request := &DatabaseRequest{
+		Id:    entityId,
+		Field: f.Lhs,
+	}
+	db.Read([]*DatabaseRequest{request})
+
+	if !request.Success {
+		return nil, false
+	}
+
+	lhsValue := &StringMap{}
+	if !request.Value.MessageIs(lhsValue) {
+		return nil, false
+	}
+
+	if err := request.Value.UnmarshalTo(lhsValue); err != nil {
+		Error("[FieldMapCondition::pull] Failed to unmarshal value: %s", err.Error())
+		return nil, false
+	}
+
+	return lhsValue.GetRaw(), true
+}
+
+func (f *FieldMapCondition) HasKey(key string) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			lhs, ok := f.pull(db, entityId)
+			if !ok {
 				return false
 			}
-		}
 
-		return true
-	}
+			_, ok = lhs[key]
+			return ok
+		},
+		fields: []string{f.Lhs},
+	})
 }
 
+func (f *FieldMapCondition) HasEntry(key string, value string) *Condition {
+	return planned(&fieldCondition{
+		eval: func(db IDatabase, entityId string) bool {
+			lhs, ok := f.pull(db, entityId)
+			if !ok {
+				return false
+			}
+
+			v, ok := lhs[key]
+			return ok && v == value
+		},
+		fields: []string{f.Lhs},
+	})
+}
+
+// SearchCriteria selects the entities of a type meeting every condition. Conditions built by this
+// package go in Where, so EntityFinder can use their plan; plain functions go in Conditions and
+// are evaluated after them.
 type SearchCriteria struct {
 	EntityType string
+	Where      []*Condition
 	Conditions []FieldConditionEval
 }
 
 type IEntityFinder interface {
 	Find(SearchCriteria) []IEntity
+	FindPage(criteria SearchCriteria, cursor string, pageSize int) ([]IEntity, string)
 }
 
 type EntityFinder struct {
@@ -914,27 +1805,157 @@ func NewEntityFinder(db IDatabase) *EntityFinder {
 	}
 }
 
+// Find returns the entities of a type meeting all conditions. When some conditions can be
+// answered by a secondary index, only the smallest set of indexed candidates is evaluated.
 func (f *EntityFinder) Find(criteria SearchCriteria) []IEntity {
-	entities := make([]IEntity, 0)
+	entityIds, ok := smallestCandidates(f.db, criteria.EntityType, criteria.Where)
+	if !ok {
+		entityIds = f.db.FindEntities(criteria.EntityType)
+	}
+
+	return f.toEntities(f.filter(criteria, entityIds))
+}
 
-	for _, entityId := range f.db.FindEntities(criteria.EntityType) {
-		allConditionsMet := true
+// FindPage examines up to pageSize entities starting at cursor and returns those meeting all
+// conditions, so a page can hold fewer matches than pageSize while more remain. An empty cursor
+// starts the search and an empty next cursor means there are no more pages. Indexed searches
+// page through the candidates in entity id order, so results are stable while entities change.
+func (f *EntityFinder) FindPage(criteria SearchCriteria, cursor string, pageSize int) ([]IEntity, string) {
+	pageSize = clampPageSize(pageSize)
 
-		for _, condition := range criteria.Conditions {
-			if !condition(f.db, entityId) {
-				allConditionsMet = false
-				break
-			}
+	entityIds, ok := smallestCandidates(f.db, criteria.EntityType, criteria.Where)
+	if !ok {
+		if strings.HasPrefix(cursor, indexCursorPrefix) {
+			Error("[EntityFinder::FindPage] Cursor '%s' does not belong to an indexed search", cursor)
+			return nil, ""
+		}
+
+		entityIds, next := f.db.FindEntitiesPage(criteria.EntityType, cursor, pageSize)
+		return f.toEntities(f.filter(criteria, entityIds)), next
+	}
+
+	slices.Sort(entityIds)
+	if cursor != "" {
+		after, found := strings.CutPrefix(cursor, indexCursorPrefix)
+		if !found {
+			Error("[EntityFinder::FindPage] Cursor '%s' does not belong to an indexed search", cursor)
+			return nil, ""
+		}
+
+		i, _ := slices.BinarySearch(entityIds, after)
+		if i < len(entityIds) && entityIds[i] == after {
+			i++
+		}
+		entityIds = entityIds[i:]
+	}
+
+	next := ""
+	if len(entityIds) > pageSize {
+		entityIds = entityIds[:pageSize]
+		next = indexCursorPrefix + entityIds[pageSize-1]
+	}
+
+	return f.toEntities(f.filter(criteria, entityIds)), next
+}
+
+// indexCursorPrefix marks FindPage cursors holding the last entity id of an indexed page
+const indexCursorPrefix = "@"
+
+// filter evaluates the conditions one at a time over the remaining entities, so that the fields
+// each one reads are fetched in a single batch and later conditions are skipped for entities
+// that already failed
+func (f *EntityFinder) filter(criteria SearchCriteria, entityIds []string) []string {
+	for _, condition := range criteria.Where {
+		if len(entityIds) == 0 {
+			break
 		}
 
-		if allConditionsMet {
-			entities = append(entities, NewEntity(f.db, entityId))
+		db := f.prefetch(condition, entityIds)
+		entityIds = slices.DeleteFunc(entityIds, func(entityId string) bool {
+			return !condition.Evaluate(db, entityId)
+		})
+	}
+
+	for _, condition := range criteria.Conditions {
+		if len(entityIds) == 0 {
+			break
 		}
+
+		entityIds = slices.DeleteFunc(entityIds, func(entityId string) bool {
+			return !condition(f.db, entityId)
+		})
+	}
+
+	return entityIds
+}
+
+func (f *EntityFinder) toEntities(entityIds []string) []IEntity {
+	entities := make([]IEntity, 0, len(entityIds))
+	for _, entityId := range entityIds {
+		entities = append(entities, NewEntity(f.db, entityId))
 	}
 
 	return entities
 }
 
+// prefetch reads the fields used by a condition for all entities in one request and returns
+// a database that serves those reads from memory
+func (f *EntityFinder) prefetch(condition *Condition, entityIds []string) IDatabase {
+	fields := conditionFields(condition)
+	if len(fields) == 0 {
+		return f.db
+	}
+
+	requests := make([]*DatabaseRequest, 0, len(fields)*len(entityIds))
+	for _, entityId := range entityIds {
+		for _, field := range fields {
+			requests = append(requests, &DatabaseRequest{
+				Id:    entityId,
+				Field: field,
+			})
+		}
+	}
+	f.db.Read(requests)
+
+	db := &prefetchedDatabase{
+		IDatabase: f.db,
+		requests:  map[string]*DatabaseRequest{},
+	}
+	for _, request := range requests {
+		db.requests[request.Id+":"+request.Field] = request
+	}
+
+	return db
+}
+
+// prefetchedDatabase answers reads from a set of already completed requests and defers
+// everything else to the underlying database
+type prefetchedDatabase struct {
+	IDatabase
+	requests map[string]*DatabaseRequest
+}
+
+func (db *prefetchedDatabase) Read(requests []*DatabaseRequest) {
+	misses := []*DatabaseRequest{}
+
+	for _, request := range requests {
+		prefetched, ok := db.requests[request.Id+":"+request.Field]
+		if !ok {
+			misses = append(misses, request)
+			continue
+		}
+
+		request.Value = prefetched.Value
+		request.WriteTime = prefetched.WriteTime
+		request.WriterId = prefetched.WriterId
+		request.Success = prefetched.Success
+	}
+
+	if len(misses) > 0 {
+		db.IDatabase.Read(misses)
+	}
+}
+
 type FCString = FieldCondition[*String, string, string]
 type FCBool = FieldCondition[*Bool, int, bool]
 type FCInt = FieldCondition[*Int, int64, int64]
@@ -944,6 +1965,11 @@ type FCEnum[T ~int32] struct {
 }
 type FCTimestamp = FieldCondition[*Timestamp, int64, *timestamppb.Timestamp]
 type FCReference = FieldCondition[*EntityReference, string, string]
+type FCEnumValue = FieldCondition[*Enum, int64, int64]
+type FCIntList = FieldListCondition[*IntList, int64]
+type FCStringList = FieldListCondition[*StringList, string]
+type FCReferenceList = FieldListCondition[*EntityReferenceList, string]
+type FCStringMap = FieldMapCondition
 
 func NewStringCondition() *FCString {
 	return &FCString{
@@ -982,6 +2008,12 @@ func NewEnumCondition[T ~int32]() *FCEnum[T] {
 	}
 }
 
+func NewEnumValueCondition() *FCEnumValue {
+	return &FCEnumValue{
+		Caster: DefaultCaster[int64, int64],
+	}
+}
+
 func NewTimestampCondition() *FCTimestamp {
 	return &FCTimestamp{
 		Caster: func(t *timestamppb.Timestamp) int64 {
@@ -995,3 +2027,19 @@ func NewReferenceCondition() *FCReference {
 		Caster: DefaultCaster[string, string],
 	}
 }
+
+func NewIntListCondition() *FCIntList {
+	return &FCIntList{}
+}
+
+func NewStringListCondition() *FCStringList {
+	return &FCStringList{}
+}
+
+func NewReferenceListCondition() *FCReferenceList {
+	return &FCReferenceList{}
+}
+
+func NewStringMapCondition() *FCStringMap {
+	return &FCStringMap{}
+}
@@ -0,0 +1,486 @@
+package qdb
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// entityTransactionRetries bounds how often a transaction is retried when a watched entity changes
+const entityTransactionRetries = 10
+
+var errEntityNotFound = errors.New("entity not found")
+
+// transact runs fn as an optimistic transaction over the watched keys, retrying if another
+// client modifies one of them before the transaction commits
+func (db *RedisDatabase) transact(fn func(tx *redis.Tx) error, keys ...string) error {
+	for i := 0; i < entityTransactionRetries; i++ {
+		err := db.client.Watch(context.Background(), fn, keys...)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+
+	return redis.TxFailedErr
+}
+
+func encodeEntity(entity *DatabaseEntity) (string, error) {
+	b, err := proto.Marshal(entity)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// getEntityInTx reads an entity within a transaction so that it is covered by the watch
+func (db *RedisDatabase) getEntityInTx(tx *redis.Tx, entityId string) (*DatabaseEntity, error) {
+	e, err := tx.Get(context.Background(), db.keygen.GetEntityKey(entityId)).Result()
+	if err == redis.Nil {
+		return nil, errEntityNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	b, err := base64.StdEncoding.DecodeString(e)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &DatabaseEntity{}
+	if err := proto.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// setEntitiesInTx queues the given entities to be written when the transaction commits
+func (db *RedisDatabase) setEntitiesInTx(pipe redis.Pipeliner, entities ...*DatabaseEntity) error {
+	for _, entity := range entities {
+		e, err := encodeEntity(entity)
+		if err != nil {
+			return err
+		}
+
+		pipe.Set(context.Background(), db.keygen.GetEntityKey(entity.Id), e, 0)
+		db.invalidate(pipe, db.keygen.GetEntityKey(entity.Id))
+	}
+
+	return nil
+}
+
+// isAncestor returns true if ancestorId is entityId or one of its parents
+func (db *RedisDatabase) isAncestor(ancestorId, entityId string) bool {
+	visited := map[string]bool{}
+
+	for entityId != "" && !visited[entityId] {
+		if entityId == ancestorId {
+			return true
+		}
+		visited[entityId] = true
+
+		entity := db.GetEntity(entityId)
+		if entity == nil {
+			return false
+		}
+
+		entityId = entity.Parent.GetRaw()
+	}
+
+	return false
+}
+
+// MoveEntity re-parents an entity and its subtree. An empty parentId makes it a top-level entity.
+func (db *RedisDatabase) MoveEntity(entityId, parentId string) bool {
+	current := db.GetEntity(entityId)
+	if current == nil {
+		Error("[RedisDatabase::MoveEntity] Failed to get entity: %v", entityId)
+		return false
+	}
+
+	if parentId != "" && db.isAncestor(entityId, parentId) {
+		Error("[RedisDatabase::MoveEntity] Cannot move entity %s under itself or its descendant %s", entityId, parentId)
+		return false
+	}
+
+	keys := []string{db.keygen.GetEntityKey(entityId)}
+	for _, id := range []string{current.Parent.GetRaw(), parentId} {
+		if id != "" {
+			keys = append(keys, db.keygen.GetEntityKey(id))
+		}
+	}
+
+	var previous, moved *DatabaseEntity
+	err := db.transact(func(tx *redis.Tx) error {
+		entity, err := db.getEntityInTx(tx, entityId)
+		if err != nil {
+			return err
+		}
+
+		oldParentId := entity.Parent.GetRaw()
+		if oldParentId != current.Parent.GetRaw() {
+			// The entity was moved since the keys to watch were chosen
+			return redis.TxFailedErr
+		}
+
+		previous = proto.Clone(entity).(*DatabaseEntity)
+		if oldParentId == parentId {
+			moved = nil
+			return nil
+		}
+
+		updated := []*DatabaseEntity{entity}
+
+		if oldParentId != "" {
+			oldParent, err := db.getEntityInTx(tx, oldParentId)
+			if err == nil {
+				oldParent.Children = slices.DeleteFunc(oldParent.Children, func(child *EntityReference) bool {
+					return child.Raw == entityId
+				})
+				updated = append(updated, oldParent)
+			} else if err != errEntityNotFound {
+				return err
+			}
+		}
+
+		if parentId != "" {
+			newParent, err := db.getEntityInTx(tx, parentId)
+			if err != nil {
+				return err
+			}
+
+			newParent.Children = append(newParent.Children, &EntityReference{Raw: entityId})
+			updated = append(updated, newParent)
+		}
+
+		entity.Parent = &EntityReference{Raw: parentId}
+		moved = entity
+
+		_, err = tx.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			pipe.SRem(context.Background(), db.keygen.GetEntityNameKey(oldParentId, entity.Name), entityId)
+			pipe.SAdd(context.Background(), db.keygen.GetEntityNameKey(parentId, entity.Name), entityId)
+			return db.setEntitiesInTx(pipe, updated...)
+		})
+
+		return err
+	}, keys...)
+
+	if err != nil {
+		Error("[RedisDatabase::MoveEntity] Failed to move entity %s under %s: %v", entityId, parentId, err)
+		return false
+	}
+
+	if moved != nil {
+		db.publishEntityEvent(DatabaseEntityEvent_MOVED, moved, previous)
+	}
+
+	return true
+}
+
+// RenameEntity changes the name of an entity
+func (db *RedisDatabase) RenameEntity(entityId, name string) bool {
+	var previous, renamed *DatabaseEntity
+
+	err := db.transact(func(tx *redis.Tx) error {
+		entity, err := db.getEntityInTx(tx, entityId)
+		if err != nil {
+			return err
+		}
+
+		previous = proto.Clone(entity).(*DatabaseEntity)
+		if entity.Name == name {
+			renamed = nil
+			return nil
+		}
+
+		entity.Name = name
+		renamed = entity
+
+		_, err = tx.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			pipe.SRem(context.Background(), db.keygen.GetEntityNameKey(entity.Parent.GetRaw(), previous.Name), entityId)
+			pipe.SAdd(context.Background(), db.keygen.GetEntityNameKey(entity.Parent.GetRaw(), name), entityId)
+			return db.setEntitiesInTx(pipe, entity)
+		})
+
+		return err
+	}, db.keygen.GetEntityKey(entityId))
+
+	if err != nil {
+		Error("[RedisDatabase::RenameEntity] Failed to rename entity %s: %v", entityId, err)
+		return false
+	}
+
+	if renamed != nil {
+		db.publishEntityEvent(DatabaseEntityEvent_RENAMED, renamed, previous)
+	}
+
+	return true
+}
+
+// CloneEntity copies an entity, its subtree and their field values under parentId with fresh ids
+// and returns the id of the copy. An empty name keeps the original name. When rewriteReferences is
+// set, entity references to entities inside the subtree are pointed at their copies.
+func (db *RedisDatabase) CloneEntity(entityId, parentId, name string, rewriteReferences bool) string {
+	source := db.GetEntity(entityId)
+	if source == nil {
+		Error("[RedisDatabase::CloneEntity] Failed to get entity: %v", entityId)
+		return ""
+	}
+
+	if parentId != "" && !db.EntityExists(parentId) {
+		Error("[RedisDatabase::CloneEntity] Failed to get parent entity: %v", parentId)
+		return ""
+	}
+
+	// Collect the subtree, parents before children, and assign the ids of the copies
+	sources := []*DatabaseEntity{}
+	ids := map[string]string{}
+	pending := []*DatabaseEntity{source}
+	for len(pending) > 0 {
+		entity := pending[0]
+		pending = pending[1:]
+
+		if _, ok := ids[entity.Id]; ok {
+			continue
+		}
+
+		sources = append(sources, entity)
+		ids[entity.Id] = uuid.New().String()
+
+		for _, child := range entity.Children {
+			if c := db.GetEntity(child.Raw); c != nil {
+				pending = append(pending, c)
+			}
+		}
+	}
+
+	clones := []*DatabaseEntity{}
+	copies := []*DatabaseRequest{}
+	computed := []*DatabaseRequest{}
+	for _, entity := range sources {
+		clone := &DatabaseEntity{
+			Id:       ids[entity.Id],
+			Type:     entity.Type,
+			Name:     entity.Name,
+			Parent:   &EntityReference{Raw: ids[entity.Parent.GetRaw()]},
+			Children: []*EntityReference{},
+		}
+
+		if entity == source {
+			clone.Parent.Raw = parentId
+			if name != "" {
+				clone.Name = name
+			}
+		}
+
+		for _, child := range entity.Children {
+			if childId, ok := ids[child.Raw]; ok {
+				clone.Children = append(clone.Children, &EntityReference{Raw: childId})
+			}
+		}
+
+		clones = append(clones, clone)
+
+		schema := db.GetEntitySchema(entity.Type)
+		if schema == nil {
+			continue
+		}
+
+		for _, fieldName := range schema.Fields {
+			fieldSchema := db.GetFieldSchema(fieldName)
+			if fieldSchema == nil {
+				continue
+			}
+
+			// Computed fields are initialized like CreateEntity does, and evaluated on the clone
+			if fieldSchema.Computed != nil {
+				computed = append(computed, &DatabaseRequest{Id: clone.Id, Field: fieldName})
+				continue
+			}
+
+			copies = append(copies, &DatabaseRequest{Id: entity.Id, Field: fieldName})
+		}
+	}
+
+	db.Read(copies)
+	writes := []*DatabaseRequest{}
+	for _, request := range copies {
+		// Fields that cannot be read are initialized with their default value
+		if !request.Success {
+			writes = append(writes, &DatabaseRequest{Id: ids[request.Id], Field: request.Field})
+			continue
+		}
+
+		value := request.Value
+		if rewriteReferences {
+			value = rewriteEntityReferences(value, ids)
+		}
+
+		writes = append(writes, &DatabaseRequest{
+			Id:    ids[request.Id],
+			Field: request.Field,
+			Value: value,
+		})
+	}
+
+	keys := []string{}
+	if parentId != "" {
+		keys = append(keys, db.keygen.GetEntityKey(parentId))
+	}
+
+	err := db.transact(func(tx *redis.Tx) error {
+		updated := slices.Clone(clones)
+
+		if parentId != "" {
+			parent, err := db.getEntityInTx(tx, parentId)
+			if err != nil {
+				return err
+			}
+
+			parent.Children = append(parent.Children, &EntityReference{Raw: clones[0].Id})
+			updated = append(updated, parent)
+		}
+
+		_, err := tx.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			for _, clone := range clones {
+				pipe.SAdd(context.Background(), db.keygen.GetEntityTypeKey(clone.Type), clone.Id)
+				pipe.SAdd(context.Background(), db.keygen.GetEntityNameKey(clone.Parent.GetRaw(), clone.Name), clone.Id)
+				pipe.SAdd(context.Background(), db.keygen.GetNameIndexedParentsKey(), clone.Id)
+			}
+
+			return db.setEntitiesInTx(pipe, updated...)
+		})
+
+		return err
+	}, keys...)
+
+	if err != nil {
+		Error("[RedisDatabase::CloneEntity] Failed to clone entity %s: %v", entityId, err)
+		return ""
+	}
+
+	// Field values are written once the copies exist, so a failed clone leaves nothing behind and
+	// listeners are only notified about entities they can read
+	if len(writes) > 0 {
+		db.Write(writes)
+	}
+
+	if len(computed) > 0 {
+		db.Write(computed)
+	}
+
+	for _, clone := range clones {
+		db.publishEntityEvent(DatabaseEntityEvent_CREATED, clone, nil)
+	}
+
+	return clones[0].Id
+}
+
+// rewriteEntityReferences points references to entities in ids at their replacements
+func rewriteEntityReferences(value *anypb.Any, ids map[string]string) *anypb.Any {
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		return value
+	}
+
+	switch v := m.(type) {
+	case *EntityReference:
+		if id, ok := ids[v.Raw]; ok {
+			return NewEntityReferenceValue(id)
+		}
+	case *EntityReferenceList:
+		rewritten := make([]string, len(v.Raw))
+		for i, raw := range v.Raw {
+			rewritten[i] = raw
+			if id, ok := ids[raw]; ok {
+				rewritten[i] = id
+			}
+		}
+
+		return NewEntityReferenceListValue(rewritten)
+	}
+
+	return value
+}
+
+// publishEntityEvent adds an entity lifecycle event to the entity event stream
+func (db *RedisDatabase) publishEntityEvent(eventType DatabaseEntityEvent_TypeEnum, current, previous *DatabaseEntity) {
+	if db.muteNotifications {
+		return
+	}
+
+	b, err := proto.Marshal(&DatabaseEntityEvent{
+		Type:     eventType,
+		Current:  current,
+		Previous: previous,
+	})
+	if err != nil {
+		Error("[RedisDatabase::publishEntityEvent] Failed to marshal entity event: %v", err)
+		return
+	}
+
+	_, err = db.client.XAdd(context.Background(), &redis.XAddArgs{
+		Stream: db.keygen.GetEntityEventChannelKey(),
+		Values: []string{"data", base64.StdEncoding.EncodeToString(b)},
+		MaxLen: 1000,
+		Approx: true,
+	}).Result()
+	if err != nil {
+		Error("[RedisDatabase::publishEntityEvent] Failed to add entity event: %v", err)
+	}
+}
+
+// ReadEntityEvents returns the entity lifecycle events published after cursor and the cursor
+// to pass on the next call. An empty cursor starts from the oldest retained event.
+func (db *RedisDatabase) ReadEntityEvents(cursor string) ([]*DatabaseEntityEvent, string) {
+	if cursor == "" {
+		cursor = "0"
+	}
+
+	r, err := db.client.XRead(context.Background(), &redis.XReadArgs{
+		Streams: []string{db.keygen.GetEntityEventChannelKey(), cursor},
+		Count:   1000,
+		Block:   -1,
+	}).Result()
+
+	if err != nil && err != redis.Nil {
+		Error("[RedisDatabase::ReadEntityEvents] Failed to read stream %v: %v", db.keygen.GetEntityEventChannelKey(), err)
+		return nil, cursor
+	}
+
+	events := []*DatabaseEntityEvent{}
+	for _, x := range r {
+		for _, m := range x.Messages {
+			cursor = m.ID
+
+			data, ok := m.Values["data"].(string)
+			if !ok {
+				Error("[RedisDatabase::ReadEntityEvents] Failed to cast value: %v", m.Values["data"])
+				continue
+			}
+
+			b, err := base64.StdEncoding.DecodeString(data)
+			if err != nil {
+				Error("[RedisDatabase::ReadEntityEvents] Failed to decode entity event: %v", err)
+				continue
+			}
+
+			event := &DatabaseEntityEvent{}
+			if err := proto.Unmarshal(b, event); err != nil {
+				Error("[RedisDatabase::ReadEntityEvents] Failed to unmarshal entity event: %v", err)
+				continue
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	return events, cursor
+}
@@ -0,0 +1,128 @@
+package qdb
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// EntityPathSeparator separates entity names in a path such as "Root/Building1/Floor2/Sensor7"
+const EntityPathSeparator = "/"
+
+// updateNameIndex moves an entity to the name index entry matching its new name and parent
+func (db *RedisDatabase) updateNameIndex(entityId string, oldValue, newValue *DatabaseEntity) {
+	if oldValue != nil {
+		if oldValue.Name == newValue.Name && oldValue.Parent.GetRaw() == newValue.Parent.GetRaw() {
+			return
+		}
+
+		db.client.SRem(context.Background(), db.keygen.GetEntityNameKey(oldValue.Parent.GetRaw(), oldValue.Name), entityId)
+	}
+
+	db.client.SAdd(context.Background(), db.keygen.GetEntityNameKey(newValue.Parent.GetRaw(), newValue.Name), entityId)
+}
+
+// ResolveEntityPath returns the id of the entity at a path of entity names starting from a
+// top-level entity, for example "Root/Building1/Floor2/Sensor7". It returns an empty string
+// if no entity matches. If siblings share a name, the one with the lowest id is used.
+func (db *RedisDatabase) ResolveEntityPath(path string) string {
+	entityId := ""
+
+	for _, name := range strings.Split(strings.Trim(path, EntityPathSeparator), EntityPathSeparator) {
+		var members *redis.StringSliceCmd
+		var indexed *redis.BoolCmd
+		db.client.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			members = pipe.SMembers(context.Background(), db.keygen.GetEntityNameKey(entityId, name))
+			indexed = pipe.SIsMember(context.Background(), db.keygen.GetNameIndexedParentsKey(), entityId)
+			return nil
+		})
+
+		// The index of a parent can be incomplete until its children were scanned once
+		entityIds := members.Val()
+		if !indexed.Val() {
+			entityIds = db.findEntitiesByName(entityId, name)
+		}
+
+		if len(entityIds) == 0 {
+			Debug("[RedisDatabase::ResolveEntityPath] No entity named '%s' found in path '%s'", name, path)
+			return ""
+		}
+
+		if len(entityIds) > 1 {
+			Warn("[RedisDatabase::ResolveEntityPath] Multiple entities named '%s' found in path '%s'", name, path)
+			slices.Sort(entityIds)
+		}
+
+		entityId = entityIds[0]
+	}
+
+	return entityId
+}
+
+// findEntitiesByName looks up children by name without the name index, so that entities
+// created before the index existed can be found. Every child of the parent is added to the index,
+// which is then known to be complete for the parent. Top-level entities are those without a parent.
+func (db *RedisDatabase) findEntitiesByName(parentId, name string) []string {
+	candidates := []string{}
+	if parentId == "" {
+		for _, entityType := range db.GetEntityTypes() {
+			candidates = append(candidates, db.FindEntities(entityType)...)
+		}
+	} else if parent := db.GetEntity(parentId); parent != nil {
+		for _, child := range parent.Children {
+			candidates = append(candidates, child.Raw)
+		}
+	} else {
+		return []string{}
+	}
+
+	entityIds := []string{}
+	_, err := db.client.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for _, entity := range db.GetEntities(candidates) {
+			if entity == nil || entity.Parent.GetRaw() != parentId {
+				continue
+			}
+
+			pipe.SAdd(context.Background(), db.keygen.GetEntityNameKey(parentId, entity.Name), entity.Id)
+			if entity.Name == name {
+				entityIds = append(entityIds, entity.Id)
+			}
+		}
+		pipe.SAdd(context.Background(), db.keygen.GetNameIndexedParentsKey(), parentId)
+
+		return nil
+	})
+
+	if err != nil {
+		Error("[RedisDatabase::findEntitiesByName] Failed to index the children of '%s': %v", parentId, err)
+	}
+
+	return entityIds
+}
+
+// GetEntityPath returns the path of entity names from the top-level ancestor down to the entity
+func (db *RedisDatabase) GetEntityPath(entityId string) string {
+	names := []string{}
+	visited := map[string]bool{}
+
+	for entityId != "" {
+		if visited[entityId] {
+			Error("[RedisDatabase::GetEntityPath] Cycle detected in the parents of entity %s", entityId)
+			return ""
+		}
+		visited[entityId] = true
+
+		entity := db.GetEntity(entityId)
+		if entity == nil {
+			return ""
+		}
+
+		names = append(names, entity.Name)
+		entityId = entity.Parent.GetRaw()
+	}
+
+	slices.Reverse(names)
+	return strings.Join(names, EntityPathSeparator)
+}
@@ -0,0 +1,192 @@
+package qdb
+
+import "slices"
+
+type TraversalOrder int
+
+const (
+	BreadthFirst TraversalOrder = iota
+	DepthFirst
+)
+
+// TraversalOptions controls which descendants GetDescendants returns and in what order.
+// A MaxDepth of 0 means unlimited; direct children are at depth 1. When EntityTypes is not
+// empty, only descendants of those types are returned, but the whole tree is still walked.
+type TraversalOptions struct {
+	Order       TraversalOrder
+	MaxDepth    int
+	EntityTypes []string
+}
+
+func (o TraversalOptions) matches(entity *DatabaseEntity) bool {
+	return len(o.EntityTypes) == 0 || slices.Contains(o.EntityTypes, entity.Type)
+}
+
+// newEntityFromProto wraps an entity that was already fetched
+func newEntityFromProto(db IDatabase, entity *DatabaseEntity) *Entity {
+	return &Entity{
+		db:     db,
+		entity: entity,
+	}
+}
+
+// GetAncestors returns the parent, grandparent and so on up to the top-level entity
+func (e *Entity) GetAncestors() []IEntity {
+	ancestors := []IEntity{}
+	visited := map[string]bool{e.GetId(): true}
+
+	for parentId := e.GetParent().GetRaw(); parentId != "" && !visited[parentId]; {
+		visited[parentId] = true
+
+		parent := e.db.GetEntity(parentId)
+		if parent == nil {
+			break
+		}
+
+		ancestors = append(ancestors, newEntityFromProto(e.db, parent))
+		parentId = parent.Parent.GetRaw()
+	}
+
+	return ancestors
+}
+
+// FindAncestorOfType returns the nearest ancestor of a type, or nil if there is none
+func (e *Entity) FindAncestorOfType(entityType string) IEntity {
+	for _, ancestor := range e.GetAncestors() {
+		if ancestor.GetType() == entityType {
+			return ancestor
+		}
+	}
+
+	return nil
+}
+
+// GetSiblings returns the other children of the entity's parent
+func (e *Entity) GetSiblings() []IEntity {
+	siblings := []IEntity{}
+
+	parentId := e.GetParent().GetRaw()
+	if parentId == "" {
+		return siblings
+	}
+
+	parent := e.db.GetEntity(parentId)
+	if parent == nil {
+		return siblings
+	}
+
+	siblingIds := []string{}
+	for _, child := range parent.Children {
+		if child.Raw != e.GetId() {
+			siblingIds = append(siblingIds, child.Raw)
+		}
+	}
+
+	for _, sibling := range e.db.GetEntities(siblingIds) {
+		if sibling != nil {
+			siblings = append(siblings, newEntityFromProto(e.db, sibling))
+		}
+	}
+
+	return siblings
+}
+
+// GetDescendants returns the entities below this one. The tree is fetched one level at a time,
+// with a single round trip per level.
+func (e *Entity) GetDescendants(options TraversalOptions) []IEntity {
+	children := map[string][]*DatabaseEntity{}
+	listedBy := map[string]string{}
+	visited := map[string]bool{e.GetId(): true}
+	levels := [][]*DatabaseEntity{}
+
+	level := []*DatabaseEntity{e.entity}
+	for depth := 1; len(level) > 0 && (options.MaxDepth <= 0 || depth <= options.MaxDepth); depth++ {
+		ids := []string{}
+		for _, entity := range level {
+			for _, child := range entity.Children {
+				if !visited[child.Raw] {
+					visited[child.Raw] = true
+					listedBy[child.Raw] = entity.Id
+					ids = append(ids, child.Raw)
+				}
+			}
+		}
+
+		next := []*DatabaseEntity{}
+		for _, child := range e.db.GetEntities(ids) {
+			if child != nil {
+				children[listedBy[child.Id]] = append(children[listedBy[child.Id]], child)
+				next = append(next, child)
+			}
+		}
+
+		levels = append(levels, next)
+		level = next
+	}
+
+	descendants := []IEntity{}
+
+	if options.Order == BreadthFirst {
+		for _, level := range levels {
+			for _, entity := range level {
+				if options.matches(entity) {
+					descendants = append(descendants, newEntityFromProto(e.db, entity))
+				}
+			}
+		}
+
+		return descendants
+	}
+
+	var walk func(entityId string)
+	walk = func(entityId string) {
+		for _, child := range children[entityId] {
+			if options.matches(child) {
+				descendants = append(descendants, newEntityFromProto(e.db, child))
+			}
+			walk(child.Id)
+		}
+	}
+	walk(e.GetId())
+
+	return descendants
+}
+
+// TraverseEntity answers a web traversal request
+func TraverseEntity(db IDatabase, request *WebConfigTraverseEntityRequest) []*DatabaseEntity {
+	entity := db.GetEntity(request.Id)
+	if entity == nil {
+		return nil
+	}
+
+	e := newEntityFromProto(db, entity)
+	options := TraversalOptions{
+		MaxDepth:    int(request.MaxDepth),
+		EntityTypes: request.EntityTypes,
+	}
+
+	if request.DepthFirst {
+		options.Order = DepthFirst
+	}
+
+	var relatives []IEntity
+	switch request.Direction {
+	case WebConfigTraverseEntityRequest_ANCESTORS:
+		relatives = e.GetAncestors()
+	case WebConfigTraverseEntityRequest_DESCENDANTS:
+		relatives = e.GetDescendants(options)
+	case WebConfigTraverseEntityRequest_SIBLINGS:
+		relatives = e.GetSiblings()
+	}
+
+	entities := []*DatabaseEntity{}
+	for _, relative := range relatives {
+		if request.Direction != WebConfigTraverseEntityRequest_DESCENDANTS && !options.matches(relative.(*Entity).entity) {
+			continue
+		}
+
+		entities = append(entities, relative.(*Entity).entity)
+	}
+
+	return entities
+}
@@ -0,0 +1,242 @@
+package qdb
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// indexValue returns the string under which a value is stored in a hash index.
+// Values compare equal in the index exactly when the matching FieldCondition considers them equal.
+func indexValue(value *anypb.Any) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		return "", false
+	}
+
+	switch v := m.(type) {
+	case *Int:
+		return strconv.FormatInt(v.Raw, 10), true
+	case *Enum:
+		return strconv.FormatInt(v.Raw, 10), true
+	case *Float:
+		// Adding zero normalizes -0 to 0
+		return strconv.FormatFloat(v.Raw+0, 'g', -1, 64), true
+	case *String:
+		return v.Raw, true
+	case *EntityReference:
+		return v.Raw, true
+	case *Bool:
+		return strconv.FormatBool(v.Raw), true
+	case *Timestamp:
+		return strconv.FormatInt(v.Raw.AsTime().UnixMilli(), 10), true
+	}
+
+	return "", false
+}
+
+// indexScore returns the score under which a value is stored in a sorted index
+func indexScore(value *anypb.Any) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		return 0, false
+	}
+
+	switch v := m.(type) {
+	case *Int:
+		return float64(v.Raw), true
+	case *Enum:
+		return float64(v.Raw), true
+	case *Float:
+		return v.Raw, !math.IsNaN(v.Raw)
+	case *Timestamp:
+		return float64(v.Raw.AsTime().UnixMilli()), true
+	}
+
+	return 0, false
+}
+
+func indexScoreBound(score float64) string {
+	switch {
+	case math.IsInf(score, -1):
+		return "-inf"
+	case math.IsInf(score, 1):
+		return "+inf"
+	}
+
+	return strconv.FormatFloat(score, 'g', -1, 64)
+}
+
+func (db *RedisDatabase) addToIndex(schema *DatabaseFieldSchema, entityId string, value *anypb.Any) {
+	switch schema.Index {
+	case DatabaseFieldSchema_HASH:
+		if v, ok := indexValue(value); ok {
+			db.client.SAdd(context.Background(), db.keygen.GetFieldHashIndexKey(schema.Name, v), entityId)
+		}
+	case DatabaseFieldSchema_SORTED:
+		if score, ok := indexScore(value); ok {
+			db.client.ZAdd(context.Background(), db.keygen.GetFieldSortedIndexKey(schema.Name), redis.Z{Score: score, Member: entityId})
+		}
+	}
+}
+
+func (db *RedisDatabase) removeFromIndex(schema *DatabaseFieldSchema, entityId string, value *anypb.Any) {
+	switch schema.Index {
+	case DatabaseFieldSchema_HASH:
+		if v, ok := indexValue(value); ok {
+			db.client.SRem(context.Background(), db.keygen.GetFieldHashIndexKey(schema.Name, v), entityId)
+		}
+	case DatabaseFieldSchema_SORTED:
+		db.client.ZRem(context.Background(), db.keygen.GetFieldSortedIndexKey(schema.Name), entityId)
+	}
+}
+
+// updateIndex moves an entity from the index entry of its previous value to the one of its new value
+func (db *RedisDatabase) updateIndex(schema *DatabaseFieldSchema, request *DatabaseRequest, oldRequest *DatabaseRequest, entityId string) {
+	if schema.Index == DatabaseFieldSchema_UNSPECIFIED || schema.Computed.IsEvaluatedOnRead() {
+		return
+	}
+
+	if oldRequest.Success {
+		db.removeFromIndex(schema, entityId, oldRequest.Value)
+	}
+
+	db.addToIndex(schema, entityId, request.Value)
+}
+
+// removeFieldIndex drops an entity from the index of a field before the field is deleted
+func (db *RedisDatabase) removeFieldIndex(fieldName, entityId string) {
+	schema := db.GetFieldSchema(fieldName)
+	if schema == nil || schema.Index == DatabaseFieldSchema_UNSPECIFIED || schema.Computed.IsEvaluatedOnRead() {
+		return
+	}
+
+	request := &DatabaseRequest{
+		Id:    entityId,
+		Field: fieldName,
+	}
+	db.Read([]*DatabaseRequest{request})
+
+	if request.Success {
+		db.removeFromIndex(schema, entityId, request.Value)
+	}
+}
+
+// rebuildIndex drops the existing index of a field and rebuilds it from the current field values
+func (db *RedisDatabase) rebuildIndex(schema *DatabaseFieldSchema) {
+	it := db.scanner().Scan(context.Background(), 0, db.keygen.GetFieldHashIndexKey(schema.Name, "*"), 0).Iterator()
+	for it.Next(context.Background()) {
+		db.client.Del(context.Background(), it.Val())
+	}
+	db.client.Del(context.Background(), db.keygen.GetFieldSortedIndexKey(schema.Name))
+
+	if schema.Index == DatabaseFieldSchema_UNSPECIFIED || schema.Computed.IsEvaluatedOnRead() {
+		return
+	}
+
+	for _, entityType := range db.GetEntityTypes() {
+		entitySchema := db.GetEntitySchema(entityType)
+		if entitySchema == nil || !slices.Contains(entitySchema.Fields, schema.Name) {
+			continue
+		}
+
+		for _, entityId := range db.FindEntities(entityType) {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: schema.Name,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if request.Success {
+				db.addToIndex(schema, entityId, request.Value)
+			}
+		}
+	}
+}
+
+// FindEntitiesByIndex returns the entities of a type whose field is equal to any of the values.
+// It returns false if the field has no index able to answer the query.
+func (db *RedisDatabase) FindEntitiesByIndex(entityType, fieldName string, values []*anypb.Any) ([]string, bool) {
+	schema := db.GetFieldSchema(fieldName)
+	if schema == nil || schema.Index == DatabaseFieldSchema_UNSPECIFIED || schema.Computed.IsEvaluatedOnRead() {
+		return nil, false
+	}
+
+	entities := []string{}
+	for _, value := range values {
+		var matches []string
+
+		switch schema.Index {
+		case DatabaseFieldSchema_HASH:
+			v, ok := indexValue(value)
+			if !ok {
+				return nil, false
+			}
+
+			matches = db.client.SInter(context.Background(), db.keygen.GetFieldHashIndexKey(fieldName, v), db.keygen.GetEntityTypeKey(entityType)).Val()
+		case DatabaseFieldSchema_SORTED:
+			score, ok := indexScore(value)
+			if !ok {
+				return nil, false
+			}
+
+			matches, ok = db.FindEntitiesByRange(entityType, fieldName, score, score)
+			if !ok {
+				return nil, false
+			}
+		}
+
+		for _, entityId := range matches {
+			if !slices.Contains(entities, entityId) {
+				entities = append(entities, entityId)
+			}
+		}
+	}
+
+	return entities, true
+}
+
+// FindEntitiesByRange returns the entities of a type whose field has a score within [min, max].
+// It returns false if the field has no sorted index.
+func (db *RedisDatabase) FindEntitiesByRange(entityType, fieldName string, min, max float64) ([]string, bool) {
+	schema := db.GetFieldSchema(fieldName)
+	if schema == nil || schema.Index != DatabaseFieldSchema_SORTED || schema.Computed.IsEvaluatedOnRead() {
+		return nil, false
+	}
+
+	matches := db.client.ZRangeByScore(context.Background(), db.keygen.GetFieldSortedIndexKey(fieldName), &redis.ZRangeBy{
+		Min: indexScoreBound(min),
+		Max: indexScoreBound(max),
+	}).Val()
+
+	if len(matches) == 0 {
+		return []string{}, true
+	}
+
+	members := make([]interface{}, len(matches))
+	for i, entityId := range matches {
+		members[i] = entityId
+	}
+
+	isOfType := db.client.SMIsMember(context.Background(), db.keygen.GetEntityTypeKey(entityType), members...).Val()
+	entities := []string{}
+	for i, entityId := range matches {
+		if i < len(isOfType) && isOfType[i] {
+			entities = append(entities, entityId)
+		}
+	}
+
+	return entities, true
+}
@@ -0,0 +1,293 @@
+package qdb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Each hop of an indirect field such as "Parent->[Sensor][0]->Value" is one of:
+//   - ".." for the parent entity, whatever its name
+//   - one or more selectors applied in turn to the children, keeping the first match:
+//     "[Sensor]" keeps the children of a type, "[2]" the child at an index (negative
+//     indexes count from the end) and "[Location=North]" the children whose field has a value
+//   - a field holding an EntityReference, otherwise the name of the parent or of a child
+//
+// indirectionResolver caches entities and resolved hops, so requests of a batch sharing a
+// prefix only resolve it once.
+type indirectionResolver struct {
+	db       *RedisDatabase
+	entities map[string]*DatabaseEntity
+	hops     map[string]string
+}
+
+func (db *RedisDatabase) newIndirectionResolver() *indirectionResolver {
+	return &indirectionResolver{
+		db:       db,
+		entities: map[string]*DatabaseEntity{},
+		hops:     map[string]string{},
+	}
+}
+
+// resolve returns the field and entity an indirect field points to. The error names the hop that failed.
+func (r *indirectionResolver) resolve(indirectField, entityId string) (string, string, error) {
+	fields := strings.Split(indirectField, "->")
+
+	if len(fields) == 1 {
+		return indirectField, entityId, nil
+	}
+
+	for i, hop := range fields[:len(fields)-1] {
+		next, err := r.hop(entityId, hop)
+		if err != nil {
+			return "", "", fmt.Errorf("hop %d '%s' of '%s' from entity %s: %v", i+1, hop, indirectField, entityId, err)
+		}
+
+		entityId = next
+	}
+
+	return fields[len(fields)-1], entityId, nil
+}
+
+func (r *indirectionResolver) hop(entityId, hop string) (string, error) {
+	key := entityId + "->" + hop
+	if next, ok := r.hops[key]; ok {
+		return next, nil
+	}
+
+	var next string
+	var err error
+
+	switch {
+	case hop == "..":
+		next, err = r.parent(entityId)
+	case strings.HasPrefix(hop, "["):
+		next, err = r.selectChild(entityId, hop)
+	default:
+		next, err = r.named(entityId, hop)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	r.hops[key] = next
+	return next, nil
+}
+
+func (r *indirectionResolver) entity(entityId string) *DatabaseEntity {
+	if entity, ok := r.entities[entityId]; ok {
+		return entity
+	}
+
+	entity := r.db.GetEntities([]string{entityId})[0]
+	r.entities[entityId] = entity
+	return entity
+}
+
+// children fetches the children of an entity that are not cached yet in a single round trip
+func (r *indirectionResolver) children(entity *DatabaseEntity) []*DatabaseEntity {
+	missing := []string{}
+	for _, child := range entity.Children {
+		if _, ok := r.entities[child.Raw]; !ok {
+			missing = append(missing, child.Raw)
+		}
+	}
+
+	for i, child := range r.db.GetEntities(missing) {
+		r.entities[missing[i]] = child
+	}
+
+	children := []*DatabaseEntity{}
+	for _, child := range entity.Children {
+		if c := r.entities[child.Raw]; c != nil {
+			children = append(children, c)
+		}
+	}
+
+	return children
+}
+
+func (r *indirectionResolver) parent(entityId string) (string, error) {
+	entity := r.entity(entityId)
+	if entity == nil {
+		return "", errEntityNotFound
+	}
+
+	if entity.Parent.GetRaw() == "" {
+		return "", errors.New("entity has no parent")
+	}
+
+	return entity.Parent.Raw, nil
+}
+
+// named follows a reference field, falling back to the parent or a child by name
+func (r *indirectionResolver) named(entityId, hop string) (string, error) {
+	request := &DatabaseRequest{
+		Id:    entityId,
+		Field: hop,
+	}
+	r.db.Read([]*DatabaseRequest{request})
+
+	if request.Success {
+		entityReference := &EntityReference{}
+		if !request.Value.MessageIs(entityReference) {
+			return "", fmt.Errorf("field is a %s, not an entity reference", request.Value.TypeUrl)
+		}
+
+		if err := request.Value.UnmarshalTo(entityReference); err != nil {
+			return "", err
+		}
+
+		return entityReference.Raw, nil
+	}
+
+	entity := r.entity(entityId)
+	if entity == nil {
+		return "", errEntityNotFound
+	}
+
+	if parentId := entity.Parent.GetRaw(); parentId != "" {
+		if parent := r.entity(parentId); parent != nil && parent.Name == hop {
+			return parentId, nil
+		}
+	}
+
+	for _, child := range r.children(entity) {
+		if child.Name == hop {
+			return child.Id, nil
+		}
+	}
+
+	return "", errors.New("no reference field, parent or child has that name")
+}
+
+type hopSelector struct {
+	index     *int
+	typeName  string
+	field     string
+	value     string
+	predicate bool
+}
+
+func parseHopSelectors(hop string) ([]hopSelector, error) {
+	selectors := []hopSelector{}
+
+	for rest := hop; rest != ""; {
+		if !strings.HasPrefix(rest, "[") {
+			return nil, fmt.Errorf("expected '[' at '%s'", rest)
+		}
+
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return nil, errors.New("unterminated selector")
+		}
+
+		content := strings.TrimSpace(rest[1:end])
+		rest = rest[end+1:]
+
+		if content == "" {
+			return nil, errors.New("empty selector")
+		}
+
+		if index, err := strconv.Atoi(content); err == nil {
+			selectors = append(selectors, hopSelector{index: &index})
+		} else if field, value, ok := strings.Cut(content, "="); ok {
+			value = strings.TrimSpace(value)
+			if unquoted, err := strconv.Unquote(value); err == nil {
+				value = unquoted
+			}
+			selectors = append(selectors, hopSelector{field: strings.TrimSpace(field), value: value, predicate: true})
+		} else {
+			selectors = append(selectors, hopSelector{typeName: content})
+		}
+	}
+
+	return selectors, nil
+}
+
+func (r *indirectionResolver) selectChild(entityId, hop string) (string, error) {
+	selectors, err := parseHopSelectors(hop)
+	if err != nil {
+		return "", err
+	}
+
+	entity := r.entity(entityId)
+	if entity == nil {
+		return "", errEntityNotFound
+	}
+
+	candidates := r.children(entity)
+	for _, selector := range selectors {
+		switch {
+		case selector.index != nil:
+			index := *selector.index
+			if index < 0 {
+				index += len(candidates)
+			}
+
+			if index < 0 || index >= len(candidates) {
+				return "", fmt.Errorf("index %d is out of range for %d children", *selector.index, len(candidates))
+			}
+
+			candidates = candidates[index : index+1]
+		case selector.predicate:
+			candidates = r.filterByField(candidates, selector.field, selector.value)
+		default:
+			candidates = slices.DeleteFunc(candidates, func(child *DatabaseEntity) bool {
+				return child.Type != selector.typeName
+			})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", errors.New("no child matches")
+	}
+
+	return candidates[0].Id, nil
+}
+
+// filterByField keeps the entities whose field has the value, in the same text form as a hash index.
+// The Id, Name and Type pseudo-fields can be used when the entity has no such field.
+func (r *indirectionResolver) filterByField(candidates []*DatabaseEntity, field, value string) []*DatabaseEntity {
+	requests := make([]*DatabaseRequest, len(candidates))
+	for i, candidate := range candidates {
+		requests[i] = &DatabaseRequest{
+			Id:    candidate.Id,
+			Field: field,
+		}
+	}
+	r.db.Read(requests)
+
+	matches := []*DatabaseEntity{}
+	for i, candidate := range candidates {
+		actual, ok := "", false
+
+		if requests[i].Success {
+			actual, ok = indexValue(requests[i].Value)
+
+			if schema := r.db.GetFieldSchema(field); ok && schema != nil && requests[i].Value.MessageIs(&Enum{}) {
+				if name, found := schema.EnumName(ValueCast[*Enum](requests[i].Value).GetRaw()); found && name == value {
+					actual = value
+				}
+			}
+		} else {
+			switch field {
+			case "Id":
+				actual, ok = candidate.Id, true
+			case "Name":
+				actual, ok = candidate.Name, true
+			case "Type":
+				actual, ok = candidate.Type, true
+			}
+		}
+
+		if ok && actual == value {
+			matches = append(matches, candidate)
+		}
+	}
+
+	return matches
+}
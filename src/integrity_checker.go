@@ -0,0 +1,486 @@
+package qdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type IntegrityIssueCategory string
+
+const (
+	// An entity's parent does not list it among its children
+	IntegrityChildNotInParent IntegrityIssueCategory = "child-not-in-parent"
+	// An entity's parent does not exist
+	IntegrityMissingParent IntegrityIssueCategory = "missing-parent"
+	// An entity lists a child that does not exist or has another parent
+	IntegrityDanglingChild IntegrityIssueCategory = "dangling-child"
+	// An instance:type set holds an id without an entity, or of an entity of another type
+	IntegrityDanglingTypeMember IntegrityIssueCategory = "dangling-type-member"
+	// An entity is missing from the instance:type set of its type
+	IntegrityMissingTypeMember IntegrityIssueCategory = "missing-type-member"
+	// A field value exists for an entity that does not exist or whose schema lacks the field
+	IntegrityOrphanedField IntegrityIssueCategory = "orphaned-field"
+	// A notification config targets an entity, entity type or field that does not exist
+	IntegrityOrphanedNotificationConfig IntegrityIssueCategory = "orphaned-notification-config"
+	// The name index holds a stale entry or lacks an entity
+	IntegrityNameIndex IntegrityIssueCategory = "name-index"
+	// The reverse-reference index holds a stale entry or lacks a reference
+	IntegrityReferrerIndex IntegrityIssueCategory = "referrer-index"
+)
+
+type IntegrityIssue struct {
+	Category    IntegrityIssueCategory
+	Key         string
+	Description string
+	repair      func()
+}
+
+func (i *IntegrityIssue) String() string {
+	return fmt.Sprintf("[%s] %s: %s", i.Category, i.Key, i.Description)
+}
+
+// IsRepairable returns true if the checker knows a safe repair for the issue
+func (i *IntegrityIssue) IsRepairable() bool {
+	return i.repair != nil
+}
+
+type IntegrityReport struct {
+	Issues []*IntegrityIssue
+}
+
+// ByCategory groups the issues of the report by category
+func (r *IntegrityReport) ByCategory() map[IntegrityIssueCategory][]*IntegrityIssue {
+	categories := map[IntegrityIssueCategory][]*IntegrityIssue{}
+	for _, issue := range r.Issues {
+		categories[issue.Category] = append(categories[issue.Category], issue)
+	}
+
+	return categories
+}
+
+func (r *IntegrityReport) IsClean() bool {
+	return len(r.Issues) == 0
+}
+
+type IIntegrityChecker interface {
+	Check() *IntegrityReport
+	Repair(report *IntegrityReport, dryRun bool) []*IntegrityIssue
+}
+
+// IntegrityChecker walks the key layout of RedisDatabaseKeyGenerator looking for structures that
+// disagree with each other, such as parents and children or entities and their type sets
+type IntegrityChecker struct {
+	db *RedisDatabase
+}
+
+func NewIntegrityChecker(db *RedisDatabase) IIntegrityChecker {
+	return &IntegrityChecker{db: db}
+}
+
+// getEntity returns nil without logging an error if the entity does not exist
+func (c *IntegrityChecker) getEntity(entityId string) *DatabaseEntity {
+	if !c.db.EntityExists(entityId) {
+		return nil
+	}
+
+	return c.db.GetEntity(entityId)
+}
+
+func (c *IntegrityChecker) scanKeys(pattern string) []string {
+	keys := []string{}
+
+	it := c.db.scanner().Scan(context.Background(), 0, pattern, 0).Iterator()
+	for it.Next(context.Background()) {
+		keys = append(keys, it.Val())
+	}
+
+	if err := it.Err(); err != nil {
+		Error("[IntegrityChecker::scanKeys] Failed to scan keys matching %s: %v", pattern, err)
+	}
+
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// Check reports every inconsistency found without changing anything
+func (c *IntegrityChecker) Check() *IntegrityReport {
+	report := &IntegrityReport{}
+
+	entityPrefix := c.db.keygen.GetEntityKey("")
+	entities := map[string]*DatabaseEntity{}
+	for _, key := range c.scanKeys(entityPrefix + "*") {
+		entityId := strings.TrimPrefix(key, entityPrefix)
+		if entity := c.db.GetEntity(entityId); entity != nil {
+			entities[entityId] = entity
+		}
+	}
+
+	c.checkTree(report, entities)
+	c.checkTypes(report, entities)
+	c.checkNames(report, entities)
+	c.checkFields(report, entities)
+	c.checkNotificationConfigs(report, entities)
+
+	Info("[IntegrityChecker::Check] Found %d issues", len(report.Issues))
+	return report
+}
+
+// Repair applies the repairs of the repairable issues in the report and returns them.
+// With dryRun set the issues that would be repaired are returned and nothing is changed.
+func (c *IntegrityChecker) Repair(report *IntegrityReport, dryRun bool) []*IntegrityIssue {
+	repaired := []*IntegrityIssue{}
+
+	for _, issue := range report.Issues {
+		if !issue.IsRepairable() {
+			continue
+		}
+
+		if dryRun {
+			Info("[IntegrityChecker::Repair] Would repair %v", issue)
+		} else {
+			Info("[IntegrityChecker::Repair] Repairing %v", issue)
+			issue.repair()
+		}
+
+		repaired = append(repaired, issue)
+	}
+
+	return repaired
+}
+
+func (c *IntegrityChecker) checkTree(report *IntegrityReport, entities map[string]*DatabaseEntity) {
+	db := c.db
+
+	for _, entityId := range sortedKeys(entities) {
+		entity := entities[entityId]
+		parentId := entity.Parent.GetRaw()
+
+		if parentId != "" {
+			parent, ok := entities[parentId]
+			if !ok {
+				report.Issues = append(report.Issues, &IntegrityIssue{
+					Category:    IntegrityMissingParent,
+					Key:         db.keygen.GetEntityKey(entityId),
+					Description: fmt.Sprintf("parent %s does not exist", parentId),
+					repair: func() {
+						// Detach the entity rather than lose it
+						if e := c.getEntity(entityId); e != nil && e.Parent.GetRaw() == parentId && !db.EntityExists(parentId) {
+							e.Parent = &EntityReference{Raw: ""}
+							db.SetEntity(entityId, e)
+						}
+					},
+				})
+			} else if !slices.ContainsFunc(parent.Children, func(child *EntityReference) bool { return child.Raw == entityId }) {
+				report.Issues = append(report.Issues, &IntegrityIssue{
+					Category:    IntegrityChildNotInParent,
+					Key:         db.keygen.GetEntityKey(entityId),
+					Description: fmt.Sprintf("parent %s does not list the entity as a child", parentId),
+					repair: func() {
+						p := c.getEntity(parentId)
+						if p != nil && !slices.ContainsFunc(p.Children, func(child *EntityReference) bool { return child.Raw == entityId }) {
+							p.Children = append(p.Children, &EntityReference{Raw: entityId})
+							db.SetEntity(parentId, p)
+						}
+					},
+				})
+			}
+		}
+
+		for _, child := range entity.Children {
+			childId := child.Raw
+
+			description := ""
+			if childEntity, ok := entities[childId]; !ok {
+				description = fmt.Sprintf("child %s does not exist", childId)
+			} else if childEntity.Parent.GetRaw() != entityId {
+				description = fmt.Sprintf("child %s has parent %s", childId, childEntity.Parent.GetRaw())
+			} else {
+				continue
+			}
+
+			report.Issues = append(report.Issues, &IntegrityIssue{
+				Category:    IntegrityDanglingChild,
+				Key:         db.keygen.GetEntityKey(entityId),
+				Description: description,
+				repair: func() {
+					e := c.getEntity(entityId)
+					if e == nil {
+						return
+					}
+
+					if childEntity := c.getEntity(childId); childEntity != nil && childEntity.Parent.GetRaw() == entityId {
+						return
+					}
+
+					e.Children = slices.DeleteFunc(e.Children, func(child *EntityReference) bool { return child.Raw == childId })
+					db.SetEntity(entityId, e)
+				},
+			})
+		}
+	}
+}
+
+func (c *IntegrityChecker) checkTypes(report *IntegrityReport, entities map[string]*DatabaseEntity) {
+	db := c.db
+	typePrefix := db.keygen.GetEntityTypeKey("")
+
+	members := map[string]bool{}
+	for _, key := range c.scanKeys(typePrefix + "*") {
+		entityType := strings.TrimPrefix(key, typePrefix)
+
+		ids := db.client.SMembers(context.Background(), key).Val()
+		slices.Sort(ids)
+
+		for _, entityId := range ids {
+			entity, ok := entities[entityId]
+			if ok && entity.Type == entityType {
+				members[entityId] = true
+				continue
+			}
+
+			description := "entity does not exist"
+			if ok {
+				description = fmt.Sprintf("entity %s is of type %s", entityId, entity.Type)
+			}
+
+			report.Issues = append(report.Issues, &IntegrityIssue{
+				Category:    IntegrityDanglingTypeMember,
+				Key:         key,
+				Description: fmt.Sprintf("%s: %s", entityId, description),
+				repair: func() {
+					if e := c.getEntity(entityId); e == nil || e.Type != entityType {
+						db.client.SRem(context.Background(), key, entityId)
+					}
+				},
+			})
+		}
+	}
+
+	for _, entityId := range sortedKeys(entities) {
+		if members[entityId] {
+			continue
+		}
+
+		key := db.keygen.GetEntityTypeKey(entities[entityId].Type)
+		report.Issues = append(report.Issues, &IntegrityIssue{
+			Category:    IntegrityMissingTypeMember,
+			Key:         key,
+			Description: fmt.Sprintf("%s is missing", entityId),
+			repair: func() {
+				if db.EntityExists(entityId) {
+					db.client.SAdd(context.Background(), key, entityId)
+				}
+			},
+		})
+	}
+}
+
+func (c *IntegrityChecker) checkNames(report *IntegrityReport, entities map[string]*DatabaseEntity) {
+	db := c.db
+
+	indexed := map[string]bool{}
+	for _, key := range c.scanKeys(db.keygen.GetEntityNameKey("*", "*")) {
+		ids := db.client.SMembers(context.Background(), key).Val()
+		slices.Sort(ids)
+
+		for _, entityId := range ids {
+			entity, ok := entities[entityId]
+			if ok && db.keygen.GetEntityNameKey(entity.Parent.GetRaw(), entity.Name) == key {
+				indexed[entityId] = true
+				continue
+			}
+
+			report.Issues = append(report.Issues, &IntegrityIssue{
+				Category:    IntegrityNameIndex,
+				Key:         key,
+				Description: fmt.Sprintf("stale entry %s", entityId),
+				repair: func() {
+					e := c.getEntity(entityId)
+					if e == nil || db.keygen.GetEntityNameKey(e.Parent.GetRaw(), e.Name) != key {
+						db.client.SRem(context.Background(), key, entityId)
+					}
+				},
+			})
+		}
+	}
+
+	for _, entityId := range sortedKeys(entities) {
+		if indexed[entityId] {
+			continue
+		}
+
+		entity := entities[entityId]
+		key := db.keygen.GetEntityNameKey(entity.Parent.GetRaw(), entity.Name)
+		report.Issues = append(report.Issues, &IntegrityIssue{
+			Category:    IntegrityNameIndex,
+			Key:         key,
+			Description: fmt.Sprintf("missing entry %s", entityId),
+			repair: func() {
+				if e := c.getEntity(entityId); e != nil && db.keygen.GetEntityNameKey(e.Parent.GetRaw(), e.Name) == key {
+					db.client.SAdd(context.Background(), key, entityId)
+				}
+			},
+		})
+	}
+}
+
+func (c *IntegrityChecker) checkFields(report *IntegrityReport, entities map[string]*DatabaseEntity) {
+	db := c.db
+	fieldPrefix := strings.TrimSuffix(db.keygen.GetFieldKey("", ""), ":")
+
+	// The referrer entries the index should hold, keyed by referenced entity
+	expectedReferrers := map[string][]string{}
+
+	for _, key := range c.scanKeys(fieldPrefix + "*") {
+		rest := strings.TrimPrefix(key, fieldPrefix)
+		separator := strings.LastIndex(rest, ":")
+		if separator < 0 {
+			continue
+		}
+		fieldName, entityId := rest[:separator], rest[separator+1:]
+
+		description := ""
+		if entity, ok := entities[entityId]; !ok {
+			description = fmt.Sprintf("entity %s does not exist", entityId)
+		} else if schema := db.GetEntitySchema(entity.Type); schema == nil || !slices.Contains(schema.Fields, fieldName) {
+			description = fmt.Sprintf("field %s is not in the schema of %s", fieldName, entity.Type)
+		} else {
+			request := &DatabaseRequest{Id: entityId, Field: fieldName}
+			db.Read([]*DatabaseRequest{request})
+			if request.Success {
+				for _, id := range referencedEntities(request.Value) {
+					expectedReferrers[id] = append(expectedReferrers[id], entityId+":"+fieldName)
+				}
+			}
+			continue
+		}
+
+		report.Issues = append(report.Issues, &IntegrityIssue{
+			Category:    IntegrityOrphanedField,
+			Key:         key,
+			Description: description,
+			repair: func() {
+				e := c.getEntity(entityId)
+				if e != nil {
+					if schema := db.GetEntitySchema(e.Type); schema == nil || slices.Contains(schema.Fields, fieldName) {
+						return
+					}
+				}
+
+				db.deleteField(fieldName, entityId)
+			},
+		})
+	}
+
+	// Referrer entries are repaired by rebuilding the index of their field, once per field
+	rebuilt := map[string]bool{}
+	rebuild := func(referrer string) func() {
+		_, fieldName, _ := strings.Cut(referrer, ":")
+		return func() {
+			if !rebuilt[fieldName] {
+				rebuilt[fieldName] = true
+				db.rebuildReferrers(fieldName)
+			}
+		}
+	}
+
+	referrerPrefix := db.keygen.GetEntityReferrersKey("")
+	checked := map[string]bool{}
+	for _, key := range c.scanKeys(referrerPrefix + "*") {
+		entityId := strings.TrimPrefix(key, referrerPrefix)
+		checked[entityId] = true
+
+		referrers := db.client.SMembers(context.Background(), key).Val()
+		slices.Sort(referrers)
+
+		for _, referrer := range referrers {
+			if slices.Contains(expectedReferrers[entityId], referrer) {
+				continue
+			}
+
+			report.Issues = append(report.Issues, &IntegrityIssue{
+				Category:    IntegrityReferrerIndex,
+				Key:         key,
+				Description: fmt.Sprintf("stale entry %s", referrer),
+				repair:      rebuild(referrer),
+			})
+		}
+
+		for _, referrer := range expectedReferrers[entityId] {
+			if !slices.Contains(referrers, referrer) {
+				report.Issues = append(report.Issues, c.missingReferrer(key, referrer, rebuild(referrer)))
+			}
+		}
+	}
+
+	for _, entityId := range sortedKeys(expectedReferrers) {
+		if checked[entityId] {
+			continue
+		}
+
+		for _, referrer := range expectedReferrers[entityId] {
+			report.Issues = append(report.Issues, c.missingReferrer(db.keygen.GetEntityReferrersKey(entityId), referrer, rebuild(referrer)))
+		}
+	}
+}
+
+func (c *IntegrityChecker) missingReferrer(key, referrer string, repair func()) *IntegrityIssue {
+	return &IntegrityIssue{
+		Category:    IntegrityReferrerIndex,
+		Key:         key,
+		Description: fmt.Sprintf("missing entry %s", referrer),
+		repair:      repair,
+	}
+}
+
+func (c *IntegrityChecker) checkNotificationConfigs(report *IntegrityReport, entities map[string]*DatabaseEntity) {
+	db := c.db
+	configPrefix := strings.TrimSuffix(db.keygen.GetEntityIdNotificationConfigKey("", ""), ":")
+
+	for _, key := range c.scanKeys(configPrefix + "*") {
+		target, fieldName, ok := strings.Cut(strings.TrimPrefix(key, configPrefix), ":")
+		if !ok {
+			continue
+		}
+
+		// Configs are keyed by entity id or entity type, as in Notify
+		entityType := ""
+		if entity, ok := entities[target]; ok {
+			entityType = entity.Type
+		} else if db.GetEntitySchema(target) != nil {
+			entityType = target
+		}
+
+		description := ""
+		if entityType == "" {
+			description = fmt.Sprintf("no entity or entity type %s exists", target)
+		} else if schema := db.GetEntitySchema(entityType); schema == nil {
+			description = fmt.Sprintf("entity type %s does not exist", entityType)
+		} else if !slices.Contains(schema.Fields, fieldName) {
+			description = fmt.Sprintf("field %s is not in the schema of %s", fieldName, entityType)
+		} else {
+			continue
+		}
+
+		report.Issues = append(report.Issues, &IntegrityIssue{
+			Category:    IntegrityOrphanedNotificationConfig,
+			Key:         key,
+			Description: description,
+			repair: func() {
+				db.client.Del(context.Background(), key)
+			},
+		})
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
@@ -19,6 +19,7 @@ const (
 
 // leader:<appName>:current
 // leader:<appName>:candidates
+// The database prefixes these keys with its namespace, like every temp key.
 type LeaderElectionKeyGenerator struct{}
 
 func (g *LeaderElectionKeyGenerator) GetLeaderKey(app string) string {
@@ -355,7 +356,7 @@ func (w *LeaderElectionWorker) onBecameUnavailable() {
 func (w *LeaderElectionWorker) setLeaderAndCandidateFields() {
 	services := NewEntityFinder(w.db).Find(SearchCriteria{
 		EntityType: "Service",
-		Conditions: []FieldConditionEval{
+		Where: []*Condition{
 			NewStringCondition().Where("ApplicationName").IsEqualTo(&String{Raw: w.applicationName}),
 		},
 	})
@@ -371,7 +372,7 @@ func (w *LeaderElectionWorker) setLeaderAndCandidateFields() {
 func (w *LeaderElectionWorker) clearLeaderAndCandidateFields() {
 	services := NewEntityFinder(w.db).Find(SearchCriteria{
 		EntityType: "Service",
-		Conditions: []FieldConditionEval{
+		Where: []*Condition{
 			NewStringCondition().Where("ApplicationName").IsEqualTo(&String{Raw: w.applicationName}),
 		},
 	})
@@ -0,0 +1,128 @@
+package qdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// clampPageSize applies the default and maximum page sizes
+func clampPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultPageSize
+	}
+
+	return min(pageSize, MaxPageSize)
+}
+
+// scanPage collects one page of results from a Redis SCAN-family command.
+//
+// Redis cursors do not map to a fixed number of elements, so the cursor handed out is
+// "<redis cursor>:<skip>": the Redis cursor of the batch the page stopped in and how many
+// elements of that batch, in sorted order, were already returned. An empty cursor starts a new
+// scan and an empty next cursor means the scan is complete.
+//
+// A batch can change between requests, so under concurrent writes pages may repeat or miss
+// elements, even ones present for the whole scan. Without concurrent writes every element is
+// returned exactly once.
+func scanPage(scan func(cursor uint64, count int64) ([]string, uint64, error), cursor string, pageSize int) ([]string, string, error) {
+	pageSize = clampPageSize(pageSize)
+
+	redisCursor, skip := uint64(0), 0
+	if cursor != "" {
+		if _, err := fmt.Sscanf(cursor, "%d:%d", &redisCursor, &skip); err != nil || skip < 0 {
+			return nil, "", fmt.Errorf("invalid cursor '%s'", cursor)
+		}
+	}
+
+	page := []string{}
+	for {
+		batch, next, err := scan(redisCursor, int64(pageSize))
+		if err != nil {
+			return nil, "", err
+		}
+
+		slices.Sort(batch)
+		batch = slices.Compact(batch)
+		batch = batch[min(skip, len(batch)):]
+
+		if remaining := pageSize - len(page); len(batch) > remaining {
+			page = append(page, batch[:remaining]...)
+			return page, fmt.Sprintf("%d:%d", redisCursor, skip+remaining), nil
+		}
+
+		page = append(page, batch...)
+		redisCursor, skip = next, 0
+
+		if redisCursor == 0 {
+			return page, "", nil
+		}
+
+		if len(page) == pageSize {
+			return page, fmt.Sprintf("%d:%d", redisCursor, 0), nil
+		}
+	}
+}
+
+// FindEntitiesPage returns up to pageSize entity ids of a type, starting at cursor. Pages may
+// repeat or miss ids under concurrent writes, see scanPage.
+func (db *RedisDatabase) FindEntitiesPage(entityType string, cursor string, pageSize int) ([]string, string) {
+	key := db.keygen.GetEntityTypeKey(entityType)
+
+	page, next, err := scanPage(func(cursor uint64, count int64) ([]string, uint64, error) {
+		return db.client.SScan(context.Background(), key, cursor, "", count).Result()
+	}, cursor, pageSize)
+	if err != nil {
+		Error("[RedisDatabase::FindEntitiesPage] Failed to scan entities of type %s: %v", entityType, err)
+		return nil, ""
+	}
+
+	return page, next
+}
+
+// GetEntityTypesPage returns up to pageSize entity types, starting at cursor
+func (db *RedisDatabase) GetEntityTypesPage(cursor string, pageSize int) ([]string, string) {
+	prefix := db.keygen.GetEntitySchemaKey("")
+
+	page, next, err := scanPage(func(cursor uint64, count int64) ([]string, uint64, error) {
+		return db.scanner().Scan(context.Background(), cursor, prefix+"*", count).Result()
+	}, cursor, pageSize)
+	if err != nil {
+		Error("[RedisDatabase::GetEntityTypesPage] Failed to scan entity types: %v", err)
+		return nil, ""
+	}
+
+	for i, key := range page {
+		page[i] = strings.TrimPrefix(key, prefix)
+	}
+
+	return page, next
+}
+
+// GetFieldSchemasPage returns up to pageSize field schemas, starting at cursor
+func (db *RedisDatabase) GetFieldSchemasPage(cursor string, pageSize int) ([]*DatabaseFieldSchema, string) {
+	prefix := db.keygen.GetFieldSchemaKey("")
+
+	page, next, err := scanPage(func(cursor uint64, count int64) ([]string, uint64, error) {
+		return db.scanner().Scan(context.Background(), cursor, prefix+"*", count).Result()
+	}, cursor, pageSize)
+	if err != nil {
+		Error("[RedisDatabase::GetFieldSchemasPage] Failed to scan field schemas: %v", err)
+		return nil, ""
+	}
+
+	schemas := []*DatabaseFieldSchema{}
+	for _, key := range page {
+		if schema := db.GetFieldSchema(strings.TrimPrefix(key, prefix)); schema != nil {
+			schemas = append(schemas, schema)
+		}
+	}
+
+	return schemas, next
+}
@@ -218,6 +218,202 @@ func (WebConfigGetEntityResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{9, 0}
 }
 
+type WebConfigGetEntityByPathResponse_StatusEnum int32
+
+const (
+	WebConfigGetEntityByPathResponse_UNSPECIFIED WebConfigGetEntityByPathResponse_StatusEnum = 0
+	WebConfigGetEntityByPathResponse_SUCCESS     WebConfigGetEntityByPathResponse_StatusEnum = 1
+	WebConfigGetEntityByPathResponse_FAILURE     WebConfigGetEntityByPathResponse_StatusEnum = 2
+)
+
+// Enum value maps for WebConfigGetEntityByPathResponse_StatusEnum.
+var (
+	WebConfigGetEntityByPathResponse_StatusEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SUCCESS",
+		2: "FAILURE",
+	}
+	WebConfigGetEntityByPathResponse_StatusEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SUCCESS":     1,
+		"FAILURE":     2,
+	}
+)
+
+func (x WebConfigGetEntityByPathResponse_StatusEnum) Enum() *WebConfigGetEntityByPathResponse_StatusEnum {
+	p := new(WebConfigGetEntityByPathResponse_StatusEnum)
+	*p = x
+	return p
+}
+
+func (x WebConfigGetEntityByPathResponse_StatusEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebConfigGetEntityByPathResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[4].Descriptor()
+}
+
+func (WebConfigGetEntityByPathResponse_StatusEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[4]
+}
+
+func (x WebConfigGetEntityByPathResponse_StatusEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebConfigGetEntityByPathResponse_StatusEnum.Descriptor instead.
+func (WebConfigGetEntityByPathResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type WebConfigGetEntityPathResponse_StatusEnum int32
+
+const (
+	WebConfigGetEntityPathResponse_UNSPECIFIED WebConfigGetEntityPathResponse_StatusEnum = 0
+	WebConfigGetEntityPathResponse_SUCCESS     WebConfigGetEntityPathResponse_StatusEnum = 1
+	WebConfigGetEntityPathResponse_FAILURE     WebConfigGetEntityPathResponse_StatusEnum = 2
+)
+
+// Enum value maps for WebConfigGetEntityPathResponse_StatusEnum.
+var (
+	WebConfigGetEntityPathResponse_StatusEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SUCCESS",
+		2: "FAILURE",
+	}
+	WebConfigGetEntityPathResponse_StatusEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SUCCESS":     1,
+		"FAILURE":     2,
+	}
+)
+
+func (x WebConfigGetEntityPathResponse_StatusEnum) Enum() *WebConfigGetEntityPathResponse_StatusEnum {
+	p := new(WebConfigGetEntityPathResponse_StatusEnum)
+	*p = x
+	return p
+}
+
+func (x WebConfigGetEntityPathResponse_StatusEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebConfigGetEntityPathResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[5].Descriptor()
+}
+
+func (WebConfigGetEntityPathResponse_StatusEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[5]
+}
+
+func (x WebConfigGetEntityPathResponse_StatusEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebConfigGetEntityPathResponse_StatusEnum.Descriptor instead.
+func (WebConfigGetEntityPathResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type WebConfigTraverseEntityRequest_DirectionEnum int32
+
+const (
+	WebConfigTraverseEntityRequest_ANCESTORS   WebConfigTraverseEntityRequest_DirectionEnum = 0
+	WebConfigTraverseEntityRequest_DESCENDANTS WebConfigTraverseEntityRequest_DirectionEnum = 1
+	WebConfigTraverseEntityRequest_SIBLINGS    WebConfigTraverseEntityRequest_DirectionEnum = 2
+)
+
+// Enum value maps for WebConfigTraverseEntityRequest_DirectionEnum.
+var (
+	WebConfigTraverseEntityRequest_DirectionEnum_name = map[int32]string{
+		0: "ANCESTORS",
+		1: "DESCENDANTS",
+		2: "SIBLINGS",
+	}
+	WebConfigTraverseEntityRequest_DirectionEnum_value = map[string]int32{
+		"ANCESTORS":   0,
+		"DESCENDANTS": 1,
+		"SIBLINGS":    2,
+	}
+)
+
+func (x WebConfigTraverseEntityRequest_DirectionEnum) Enum() *WebConfigTraverseEntityRequest_DirectionEnum {
+	p := new(WebConfigTraverseEntityRequest_DirectionEnum)
+	*p = x
+	return p
+}
+
+func (x WebConfigTraverseEntityRequest_DirectionEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebConfigTraverseEntityRequest_DirectionEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[6].Descriptor()
+}
+
+func (WebConfigTraverseEntityRequest_DirectionEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[6]
+}
+
+func (x WebConfigTraverseEntityRequest_DirectionEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebConfigTraverseEntityRequest_DirectionEnum.Descriptor instead.
+func (WebConfigTraverseEntityRequest_DirectionEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type WebConfigTraverseEntityResponse_StatusEnum int32
+
+const (
+	WebConfigTraverseEntityResponse_UNSPECIFIED WebConfigTraverseEntityResponse_StatusEnum = 0
+	WebConfigTraverseEntityResponse_SUCCESS     WebConfigTraverseEntityResponse_StatusEnum = 1
+	WebConfigTraverseEntityResponse_FAILURE     WebConfigTraverseEntityResponse_StatusEnum = 2
+)
+
+// Enum value maps for WebConfigTraverseEntityResponse_StatusEnum.
+var (
+	WebConfigTraverseEntityResponse_StatusEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SUCCESS",
+		2: "FAILURE",
+	}
+	WebConfigTraverseEntityResponse_StatusEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SUCCESS":     1,
+		"FAILURE":     2,
+	}
+)
+
+func (x WebConfigTraverseEntityResponse_StatusEnum) Enum() *WebConfigTraverseEntityResponse_StatusEnum {
+	p := new(WebConfigTraverseEntityResponse_StatusEnum)
+	*p = x
+	return p
+}
+
+func (x WebConfigTraverseEntityResponse_StatusEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebConfigTraverseEntityResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[7].Descriptor()
+}
+
+func (WebConfigTraverseEntityResponse_StatusEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[7]
+}
+
+func (x WebConfigTraverseEntityResponse_StatusEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebConfigTraverseEntityResponse_StatusEnum.Descriptor instead.
+func (WebConfigTraverseEntityResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{15, 0}
+}
+
 type WebConfigGetFieldSchemaResponse_StatusEnum int32
 
 const (
@@ -251,11 +447,11 @@ func (x WebConfigGetFieldSchemaResponse_StatusEnum) String() string {
 }
 
 func (WebConfigGetFieldSchemaResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[4].Descriptor()
+	return file_src_protobufs_proto_enumTypes[8].Descriptor()
 }
 
 func (WebConfigGetFieldSchemaResponse_StatusEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[4]
+	return &file_src_protobufs_proto_enumTypes[8]
 }
 
 func (x WebConfigGetFieldSchemaResponse_StatusEnum) Number() protoreflect.EnumNumber {
@@ -264,7 +460,7 @@ func (x WebConfigGetFieldSchemaResponse_StatusEnum) Number() protoreflect.EnumNu
 
 // Deprecated: Use WebConfigGetFieldSchemaResponse_StatusEnum.Descriptor instead.
 func (WebConfigGetFieldSchemaResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{11, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type WebConfigSetFieldSchemaResponse_StatusEnum int32
@@ -300,11 +496,11 @@ func (x WebConfigSetFieldSchemaResponse_StatusEnum) String() string {
 }
 
 func (WebConfigSetFieldSchemaResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[5].Descriptor()
+	return file_src_protobufs_proto_enumTypes[9].Descriptor()
 }
 
 func (WebConfigSetFieldSchemaResponse_StatusEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[5]
+	return &file_src_protobufs_proto_enumTypes[9]
 }
 
 func (x WebConfigSetFieldSchemaResponse_StatusEnum) Number() protoreflect.EnumNumber {
@@ -313,7 +509,7 @@ func (x WebConfigSetFieldSchemaResponse_StatusEnum) Number() protoreflect.EnumNu
 
 // Deprecated: Use WebConfigSetFieldSchemaResponse_StatusEnum.Descriptor instead.
 func (WebConfigSetFieldSchemaResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{13, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type WebConfigGetEntitySchemaResponse_StatusEnum int32
@@ -349,11 +545,11 @@ func (x WebConfigGetEntitySchemaResponse_StatusEnum) String() string {
 }
 
 func (WebConfigGetEntitySchemaResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[6].Descriptor()
+	return file_src_protobufs_proto_enumTypes[10].Descriptor()
 }
 
 func (WebConfigGetEntitySchemaResponse_StatusEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[6]
+	return &file_src_protobufs_proto_enumTypes[10]
 }
 
 func (x WebConfigGetEntitySchemaResponse_StatusEnum) Number() protoreflect.EnumNumber {
@@ -362,7 +558,7 @@ func (x WebConfigGetEntitySchemaResponse_StatusEnum) Number() protoreflect.EnumN
 
 // Deprecated: Use WebConfigGetEntitySchemaResponse_StatusEnum.Descriptor instead.
 func (WebConfigGetEntitySchemaResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{15, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type WebConfigSetEntitySchemaResponse_StatusEnum int32
@@ -398,11 +594,11 @@ func (x WebConfigSetEntitySchemaResponse_StatusEnum) String() string {
 }
 
 func (WebConfigSetEntitySchemaResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[7].Descriptor()
+	return file_src_protobufs_proto_enumTypes[11].Descriptor()
 }
 
 func (WebConfigSetEntitySchemaResponse_StatusEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[7]
+	return &file_src_protobufs_proto_enumTypes[11]
 }
 
 func (x WebConfigSetEntitySchemaResponse_StatusEnum) Number() protoreflect.EnumNumber {
@@ -411,7 +607,7 @@ func (x WebConfigSetEntitySchemaResponse_StatusEnum) Number() protoreflect.EnumN
 
 // Deprecated: Use WebConfigSetEntitySchemaResponse_StatusEnum.Descriptor instead.
 func (WebConfigSetEntitySchemaResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{17, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type WebConfigCreateSnapshotResponse_StatusEnum int32
@@ -447,11 +643,11 @@ func (x WebConfigCreateSnapshotResponse_StatusEnum) String() string {
 }
 
 func (WebConfigCreateSnapshotResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[8].Descriptor()
+	return file_src_protobufs_proto_enumTypes[12].Descriptor()
 }
 
 func (WebConfigCreateSnapshotResponse_StatusEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[8]
+	return &file_src_protobufs_proto_enumTypes[12]
 }
 
 func (x WebConfigCreateSnapshotResponse_StatusEnum) Number() protoreflect.EnumNumber {
@@ -460,7 +656,7 @@ func (x WebConfigCreateSnapshotResponse_StatusEnum) Number() protoreflect.EnumNu
 
 // Deprecated: Use WebConfigCreateSnapshotResponse_StatusEnum.Descriptor instead.
 func (WebConfigCreateSnapshotResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{19, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type WebConfigRestoreSnapshotResponse_StatusEnum int32
@@ -496,11 +692,11 @@ func (x WebConfigRestoreSnapshotResponse_StatusEnum) String() string {
 }
 
 func (WebConfigRestoreSnapshotResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[9].Descriptor()
+	return file_src_protobufs_proto_enumTypes[13].Descriptor()
 }
 
 func (WebConfigRestoreSnapshotResponse_StatusEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[9]
+	return &file_src_protobufs_proto_enumTypes[13]
 }
 
 func (x WebConfigRestoreSnapshotResponse_StatusEnum) Number() protoreflect.EnumNumber {
@@ -509,7 +705,56 @@ func (x WebConfigRestoreSnapshotResponse_StatusEnum) Number() protoreflect.EnumN
 
 // Deprecated: Use WebConfigRestoreSnapshotResponse_StatusEnum.Descriptor instead.
 func (WebConfigRestoreSnapshotResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{21, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type WebConfigDiffSnapshotResponse_StatusEnum int32
+
+const (
+	WebConfigDiffSnapshotResponse_UNSPECIFIED WebConfigDiffSnapshotResponse_StatusEnum = 0
+	WebConfigDiffSnapshotResponse_SUCCESS     WebConfigDiffSnapshotResponse_StatusEnum = 1
+	WebConfigDiffSnapshotResponse_FAILURE     WebConfigDiffSnapshotResponse_StatusEnum = 2
+)
+
+// Enum value maps for WebConfigDiffSnapshotResponse_StatusEnum.
+var (
+	WebConfigDiffSnapshotResponse_StatusEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SUCCESS",
+		2: "FAILURE",
+	}
+	WebConfigDiffSnapshotResponse_StatusEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SUCCESS":     1,
+		"FAILURE":     2,
+	}
+)
+
+func (x WebConfigDiffSnapshotResponse_StatusEnum) Enum() *WebConfigDiffSnapshotResponse_StatusEnum {
+	p := new(WebConfigDiffSnapshotResponse_StatusEnum)
+	*p = x
+	return p
+}
+
+func (x WebConfigDiffSnapshotResponse_StatusEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebConfigDiffSnapshotResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[14].Descriptor()
+}
+
+func (WebConfigDiffSnapshotResponse_StatusEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[14]
+}
+
+func (x WebConfigDiffSnapshotResponse_StatusEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebConfigDiffSnapshotResponse_StatusEnum.Descriptor instead.
+func (WebConfigDiffSnapshotResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type WebRuntimeDatabaseRequest_RequestTypeEnum int32
@@ -545,11 +790,11 @@ func (x WebRuntimeDatabaseRequest_RequestTypeEnum) String() string {
 }
 
 func (WebRuntimeDatabaseRequest_RequestTypeEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[10].Descriptor()
+	return file_src_protobufs_proto_enumTypes[15].Descriptor()
 }
 
 func (WebRuntimeDatabaseRequest_RequestTypeEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[10]
+	return &file_src_protobufs_proto_enumTypes[15]
 }
 
 func (x WebRuntimeDatabaseRequest_RequestTypeEnum) Number() protoreflect.EnumNumber {
@@ -558,7 +803,7 @@ func (x WebRuntimeDatabaseRequest_RequestTypeEnum) Number() protoreflect.EnumNum
 
 // Deprecated: Use WebRuntimeDatabaseRequest_RequestTypeEnum.Descriptor instead.
 func (WebRuntimeDatabaseRequest_RequestTypeEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{26, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type WebRuntimeUnregisterNotificationResponse_StatusEnum int32
@@ -594,11 +839,11 @@ func (x WebRuntimeUnregisterNotificationResponse_StatusEnum) String() string {
 }
 
 func (WebRuntimeUnregisterNotificationResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[11].Descriptor()
+	return file_src_protobufs_proto_enumTypes[16].Descriptor()
 }
 
 func (WebRuntimeUnregisterNotificationResponse_StatusEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[11]
+	return &file_src_protobufs_proto_enumTypes[16]
 }
 
 func (x WebRuntimeUnregisterNotificationResponse_StatusEnum) Number() protoreflect.EnumNumber {
@@ -607,108 +852,464 @@ func (x WebRuntimeUnregisterNotificationResponse_StatusEnum) Number() protorefle
 
 // Deprecated: Use WebRuntimeUnregisterNotificationResponse_StatusEnum.Descriptor instead.
 func (WebRuntimeUnregisterNotificationResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{33, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{41, 0}
 }
 
-type LogMessage_LogLevelEnum int32
+type WebRuntimeQueryResponse_StatusEnum int32
 
 const (
-	LogMessage_UNSPECIFIED LogMessage_LogLevelEnum = 0
-	LogMessage_TRACE       LogMessage_LogLevelEnum = 1
-	LogMessage_DEBUG       LogMessage_LogLevelEnum = 2
-	LogMessage_INFO        LogMessage_LogLevelEnum = 3
-	LogMessage_WARN        LogMessage_LogLevelEnum = 4
-	LogMessage_ERROR       LogMessage_LogLevelEnum = 5
-	LogMessage_PANIC       LogMessage_LogLevelEnum = 6
+	WebRuntimeQueryResponse_UNSPECIFIED WebRuntimeQueryResponse_StatusEnum = 0
+	WebRuntimeQueryResponse_SUCCESS     WebRuntimeQueryResponse_StatusEnum = 1
+	WebRuntimeQueryResponse_FAILURE     WebRuntimeQueryResponse_StatusEnum = 2
 )
 
-// Enum value maps for LogMessage_LogLevelEnum.
+// Enum value maps for WebRuntimeQueryResponse_StatusEnum.
 var (
-	LogMessage_LogLevelEnum_name = map[int32]string{
+	WebRuntimeQueryResponse_StatusEnum_name = map[int32]string{
 		0: "UNSPECIFIED",
-		1: "TRACE",
-		2: "DEBUG",
-		3: "INFO",
-		4: "WARN",
-		5: "ERROR",
-		6: "PANIC",
+		1: "SUCCESS",
+		2: "FAILURE",
 	}
-	LogMessage_LogLevelEnum_value = map[string]int32{
+	WebRuntimeQueryResponse_StatusEnum_value = map[string]int32{
 		"UNSPECIFIED": 0,
-		"TRACE":       1,
-		"DEBUG":       2,
-		"INFO":        3,
-		"WARN":        4,
-		"ERROR":       5,
-		"PANIC":       6,
+		"SUCCESS":     1,
+		"FAILURE":     2,
 	}
 )
 
-func (x LogMessage_LogLevelEnum) Enum() *LogMessage_LogLevelEnum {
-	p := new(LogMessage_LogLevelEnum)
+func (x WebRuntimeQueryResponse_StatusEnum) Enum() *WebRuntimeQueryResponse_StatusEnum {
+	p := new(WebRuntimeQueryResponse_StatusEnum)
 	*p = x
 	return p
 }
 
-func (x LogMessage_LogLevelEnum) String() string {
+func (x WebRuntimeQueryResponse_StatusEnum) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (LogMessage_LogLevelEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[12].Descriptor()
+func (WebRuntimeQueryResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[17].Descriptor()
 }
 
-func (LogMessage_LogLevelEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[12]
+func (WebRuntimeQueryResponse_StatusEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[17]
 }
 
-func (x LogMessage_LogLevelEnum) Number() protoreflect.EnumNumber {
+func (x WebRuntimeQueryResponse_StatusEnum) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use LogMessage_LogLevelEnum.Descriptor instead.
-func (LogMessage_LogLevelEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{54, 0}
+// Deprecated: Use WebRuntimeQueryResponse_StatusEnum.Descriptor instead.
+func (WebRuntimeQueryResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{47, 0}
 }
 
-type ConnectionState_ConnectionStateEnum int32
+type WebRuntimeGetReferrersResponse_StatusEnum int32
 
 const (
-	ConnectionState_UNSPECIFIED  ConnectionState_ConnectionStateEnum = 0
-	ConnectionState_CONNECTED    ConnectionState_ConnectionStateEnum = 1
-	ConnectionState_DISCONNECTED ConnectionState_ConnectionStateEnum = 2
+	WebRuntimeGetReferrersResponse_UNSPECIFIED WebRuntimeGetReferrersResponse_StatusEnum = 0
+	WebRuntimeGetReferrersResponse_SUCCESS     WebRuntimeGetReferrersResponse_StatusEnum = 1
+	WebRuntimeGetReferrersResponse_FAILURE     WebRuntimeGetReferrersResponse_StatusEnum = 2
 )
 
-// Enum value maps for ConnectionState_ConnectionStateEnum.
+// Enum value maps for WebRuntimeGetReferrersResponse_StatusEnum.
 var (
-	ConnectionState_ConnectionStateEnum_name = map[int32]string{
+	WebRuntimeGetReferrersResponse_StatusEnum_name = map[int32]string{
 		0: "UNSPECIFIED",
-		1: "CONNECTED",
-		2: "DISCONNECTED",
+		1: "SUCCESS",
+		2: "FAILURE",
 	}
-	ConnectionState_ConnectionStateEnum_value = map[string]int32{
-		"UNSPECIFIED":  0,
-		"CONNECTED":    1,
-		"DISCONNECTED": 2,
+	WebRuntimeGetReferrersResponse_StatusEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SUCCESS":     1,
+		"FAILURE":     2,
 	}
 )
 
-func (x ConnectionState_ConnectionStateEnum) Enum() *ConnectionState_ConnectionStateEnum {
-	p := new(ConnectionState_ConnectionStateEnum)
+func (x WebRuntimeGetReferrersResponse_StatusEnum) Enum() *WebRuntimeGetReferrersResponse_StatusEnum {
+	p := new(WebRuntimeGetReferrersResponse_StatusEnum)
 	*p = x
 	return p
 }
 
-func (x ConnectionState_ConnectionStateEnum) String() string {
+func (x WebRuntimeGetReferrersResponse_StatusEnum) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ConnectionState_ConnectionStateEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protobufs_proto_enumTypes[13].Descriptor()
+func (WebRuntimeGetReferrersResponse_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[18].Descriptor()
+}
+
+func (WebRuntimeGetReferrersResponse_StatusEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[18]
+}
+
+func (x WebRuntimeGetReferrersResponse_StatusEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebRuntimeGetReferrersResponse_StatusEnum.Descriptor instead.
+func (WebRuntimeGetReferrersResponse_StatusEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{49, 0}
+}
+
+type DatabaseEntityEvent_TypeEnum int32
+
+const (
+	DatabaseEntityEvent_UNSPECIFIED DatabaseEntityEvent_TypeEnum = 0
+	DatabaseEntityEvent_CREATED     DatabaseEntityEvent_TypeEnum = 1
+	DatabaseEntityEvent_DELETED     DatabaseEntityEvent_TypeEnum = 2
+	DatabaseEntityEvent_MOVED       DatabaseEntityEvent_TypeEnum = 3
+	DatabaseEntityEvent_RENAMED     DatabaseEntityEvent_TypeEnum = 4
+)
+
+// Enum value maps for DatabaseEntityEvent_TypeEnum.
+var (
+	DatabaseEntityEvent_TypeEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "CREATED",
+		2: "DELETED",
+		3: "MOVED",
+		4: "RENAMED",
+	}
+	DatabaseEntityEvent_TypeEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"CREATED":     1,
+		"DELETED":     2,
+		"MOVED":       3,
+		"RENAMED":     4,
+	}
+)
+
+func (x DatabaseEntityEvent_TypeEnum) Enum() *DatabaseEntityEvent_TypeEnum {
+	p := new(DatabaseEntityEvent_TypeEnum)
+	*p = x
+	return p
+}
+
+func (x DatabaseEntityEvent_TypeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseEntityEvent_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[19].Descriptor()
+}
+
+func (DatabaseEntityEvent_TypeEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[19]
+}
+
+func (x DatabaseEntityEvent_TypeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseEntityEvent_TypeEnum.Descriptor instead.
+func (DatabaseEntityEvent_TypeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{54, 0}
+}
+
+type DatabaseFieldSchema_IndexEnum int32
+
+const (
+	DatabaseFieldSchema_UNSPECIFIED DatabaseFieldSchema_IndexEnum = 0
+	DatabaseFieldSchema_HASH        DatabaseFieldSchema_IndexEnum = 1
+	DatabaseFieldSchema_SORTED      DatabaseFieldSchema_IndexEnum = 2
+)
+
+// Enum value maps for DatabaseFieldSchema_IndexEnum.
+var (
+	DatabaseFieldSchema_IndexEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "HASH",
+		2: "SORTED",
+	}
+	DatabaseFieldSchema_IndexEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"HASH":        1,
+		"SORTED":      2,
+	}
+)
+
+func (x DatabaseFieldSchema_IndexEnum) Enum() *DatabaseFieldSchema_IndexEnum {
+	p := new(DatabaseFieldSchema_IndexEnum)
+	*p = x
+	return p
+}
+
+func (x DatabaseFieldSchema_IndexEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseFieldSchema_IndexEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[20].Descriptor()
+}
+
+func (DatabaseFieldSchema_IndexEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[20]
+}
+
+func (x DatabaseFieldSchema_IndexEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseFieldSchema_IndexEnum.Descriptor instead.
+func (DatabaseFieldSchema_IndexEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{59, 0}
+}
+
+// What happens to a reference held by this field when the referenced entity is deleted
+type DatabaseFieldSchema_ReferencePolicyEnum int32
+
+const (
+	DatabaseFieldSchema_SET_NULL DatabaseFieldSchema_ReferencePolicyEnum = 0
+	DatabaseFieldSchema_RESTRICT DatabaseFieldSchema_ReferencePolicyEnum = 1
+	DatabaseFieldSchema_CASCADE  DatabaseFieldSchema_ReferencePolicyEnum = 2
+)
+
+// Enum value maps for DatabaseFieldSchema_ReferencePolicyEnum.
+var (
+	DatabaseFieldSchema_ReferencePolicyEnum_name = map[int32]string{
+		0: "SET_NULL",
+		1: "RESTRICT",
+		2: "CASCADE",
+	}
+	DatabaseFieldSchema_ReferencePolicyEnum_value = map[string]int32{
+		"SET_NULL": 0,
+		"RESTRICT": 1,
+		"CASCADE":  2,
+	}
+)
+
+func (x DatabaseFieldSchema_ReferencePolicyEnum) Enum() *DatabaseFieldSchema_ReferencePolicyEnum {
+	p := new(DatabaseFieldSchema_ReferencePolicyEnum)
+	*p = x
+	return p
+}
+
+func (x DatabaseFieldSchema_ReferencePolicyEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseFieldSchema_ReferencePolicyEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[21].Descriptor()
+}
+
+func (DatabaseFieldSchema_ReferencePolicyEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[21]
+}
+
+func (x DatabaseFieldSchema_ReferencePolicyEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseFieldSchema_ReferencePolicyEnum.Descriptor instead.
+func (DatabaseFieldSchema_ReferencePolicyEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{59, 1}
+}
+
+type DatabaseAlarmLimit_LevelEnum int32
+
+const (
+	DatabaseAlarmLimit_UNSPECIFIED DatabaseAlarmLimit_LevelEnum = 0
+	DatabaseAlarmLimit_LOW_LOW     DatabaseAlarmLimit_LevelEnum = 1
+	DatabaseAlarmLimit_LOW         DatabaseAlarmLimit_LevelEnum = 2
+	DatabaseAlarmLimit_HIGH        DatabaseAlarmLimit_LevelEnum = 3
+	DatabaseAlarmLimit_HIGH_HIGH   DatabaseAlarmLimit_LevelEnum = 4
+)
+
+// Enum value maps for DatabaseAlarmLimit_LevelEnum.
+var (
+	DatabaseAlarmLimit_LevelEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "LOW_LOW",
+		2: "LOW",
+		3: "HIGH",
+		4: "HIGH_HIGH",
+	}
+	DatabaseAlarmLimit_LevelEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"LOW_LOW":     1,
+		"LOW":         2,
+		"HIGH":        3,
+		"HIGH_HIGH":   4,
+	}
+)
+
+func (x DatabaseAlarmLimit_LevelEnum) Enum() *DatabaseAlarmLimit_LevelEnum {
+	p := new(DatabaseAlarmLimit_LevelEnum)
+	*p = x
+	return p
+}
+
+func (x DatabaseAlarmLimit_LevelEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseAlarmLimit_LevelEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[22].Descriptor()
+}
+
+func (DatabaseAlarmLimit_LevelEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[22]
+}
+
+func (x DatabaseAlarmLimit_LevelEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseAlarmLimit_LevelEnum.Descriptor instead.
+func (DatabaseAlarmLimit_LevelEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{61, 0}
+}
+
+type DatabaseComputedField_EvaluationEnum int32
+
+const (
+	DatabaseComputedField_UNSPECIFIED DatabaseComputedField_EvaluationEnum = 0
+	DatabaseComputedField_ON_READ     DatabaseComputedField_EvaluationEnum = 1
+	DatabaseComputedField_ON_CHANGE   DatabaseComputedField_EvaluationEnum = 2
+)
+
+// Enum value maps for DatabaseComputedField_EvaluationEnum.
+var (
+	DatabaseComputedField_EvaluationEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ON_READ",
+		2: "ON_CHANGE",
+	}
+	DatabaseComputedField_EvaluationEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"ON_READ":     1,
+		"ON_CHANGE":   2,
+	}
+)
+
+func (x DatabaseComputedField_EvaluationEnum) Enum() *DatabaseComputedField_EvaluationEnum {
+	p := new(DatabaseComputedField_EvaluationEnum)
+	*p = x
+	return p
+}
+
+func (x DatabaseComputedField_EvaluationEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseComputedField_EvaluationEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[23].Descriptor()
+}
+
+func (DatabaseComputedField_EvaluationEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[23]
+}
+
+func (x DatabaseComputedField_EvaluationEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseComputedField_EvaluationEnum.Descriptor instead.
+func (DatabaseComputedField_EvaluationEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{62, 0}
+}
+
+type LogMessage_LogLevelEnum int32
+
+const (
+	LogMessage_UNSPECIFIED LogMessage_LogLevelEnum = 0
+	LogMessage_TRACE       LogMessage_LogLevelEnum = 1
+	LogMessage_DEBUG       LogMessage_LogLevelEnum = 2
+	LogMessage_INFO        LogMessage_LogLevelEnum = 3
+	LogMessage_WARN        LogMessage_LogLevelEnum = 4
+	LogMessage_ERROR       LogMessage_LogLevelEnum = 5
+	LogMessage_PANIC       LogMessage_LogLevelEnum = 6
+)
+
+// Enum value maps for LogMessage_LogLevelEnum.
+var (
+	LogMessage_LogLevelEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "TRACE",
+		2: "DEBUG",
+		3: "INFO",
+		4: "WARN",
+		5: "ERROR",
+		6: "PANIC",
+	}
+	LogMessage_LogLevelEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"TRACE":       1,
+		"DEBUG":       2,
+		"INFO":        3,
+		"WARN":        4,
+		"ERROR":       5,
+		"PANIC":       6,
+	}
+)
+
+func (x LogMessage_LogLevelEnum) Enum() *LogMessage_LogLevelEnum {
+	p := new(LogMessage_LogLevelEnum)
+	*p = x
+	return p
+}
+
+func (x LogMessage_LogLevelEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogMessage_LogLevelEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[24].Descriptor()
+}
+
+func (LogMessage_LogLevelEnum) Type() protoreflect.EnumType {
+	return &file_src_protobufs_proto_enumTypes[24]
+}
+
+func (x LogMessage_LogLevelEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogMessage_LogLevelEnum.Descriptor instead.
+func (LogMessage_LogLevelEnum) EnumDescriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{80, 0}
+}
+
+type ConnectionState_ConnectionStateEnum int32
+
+const (
+	ConnectionState_UNSPECIFIED  ConnectionState_ConnectionStateEnum = 0
+	ConnectionState_CONNECTED    ConnectionState_ConnectionStateEnum = 1
+	ConnectionState_DISCONNECTED ConnectionState_ConnectionStateEnum = 2
+)
+
+// Enum value maps for ConnectionState_ConnectionStateEnum.
+var (
+	ConnectionState_ConnectionStateEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "CONNECTED",
+		2: "DISCONNECTED",
+	}
+	ConnectionState_ConnectionStateEnum_value = map[string]int32{
+		"UNSPECIFIED":  0,
+		"CONNECTED":    1,
+		"DISCONNECTED": 2,
+	}
+)
+
+func (x ConnectionState_ConnectionStateEnum) Enum() *ConnectionState_ConnectionStateEnum {
+	p := new(ConnectionState_ConnectionStateEnum)
+	*p = x
+	return p
+}
+
+func (x ConnectionState_ConnectionStateEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectionState_ConnectionStateEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protobufs_proto_enumTypes[25].Descriptor()
 }
 
 func (ConnectionState_ConnectionStateEnum) Type() protoreflect.EnumType {
-	return &file_src_protobufs_proto_enumTypes[13]
+	return &file_src_protobufs_proto_enumTypes[25]
 }
 
 func (x ConnectionState_ConnectionStateEnum) Number() protoreflect.EnumNumber {
@@ -717,7 +1318,7 @@ func (x ConnectionState_ConnectionStateEnum) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConnectionState_ConnectionStateEnum.Descriptor instead.
 func (ConnectionState_ConnectionStateEnum) EnumDescriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{55, 0}
+	return file_src_protobufs_proto_rawDescGZIP(), []int{81, 0}
 }
 
 type WebHeader struct {
@@ -1034,6 +1635,8 @@ func (x *WebConfigDeleteEntityResponse) GetStatus() WebConfigDeleteEntityRespons
 
 type WebConfigGetEntityTypesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1068,9 +1671,24 @@ func (*WebConfigGetEntityTypesRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *WebConfigGetEntityTypesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WebConfigGetEntityTypesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type WebConfigGetEntityTypesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Types         []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1112,6 +1730,13 @@ func (x *WebConfigGetEntityTypesResponse) GetTypes() []string {
 	return nil
 }
 
+func (x *WebConfigGetEntityTypesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type WebConfigGetEntityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1208,27 +1833,27 @@ func (x *WebConfigGetEntityResponse) GetEntity() *DatabaseEntity {
 	return nil
 }
 
-type WebConfigGetFieldSchemaRequest struct {
+type WebConfigGetEntityByPathRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetFieldSchemaRequest) Reset() {
-	*x = WebConfigGetFieldSchemaRequest{}
+func (x *WebConfigGetEntityByPathRequest) Reset() {
+	*x = WebConfigGetEntityByPathRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetFieldSchemaRequest) String() string {
+func (x *WebConfigGetEntityByPathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetFieldSchemaRequest) ProtoMessage() {}
+func (*WebConfigGetEntityByPathRequest) ProtoMessage() {}
 
-func (x *WebConfigGetFieldSchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetEntityByPathRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1240,40 +1865,40 @@ func (x *WebConfigGetFieldSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetFieldSchemaRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigGetFieldSchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetEntityByPathRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigGetEntityByPathRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *WebConfigGetFieldSchemaRequest) GetField() string {
+func (x *WebConfigGetEntityByPathRequest) GetPath() string {
 	if x != nil {
-		return x.Field
+		return x.Path
 	}
 	return ""
 }
 
-type WebConfigGetFieldSchemaResponse struct {
-	state         protoimpl.MessageState                     `protogen:"open.v1"`
-	Status        WebConfigGetFieldSchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigGetFieldSchemaResponse_StatusEnum" json:"status,omitempty"`
-	Schema        *DatabaseFieldSchema                       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+type WebConfigGetEntityByPathResponse struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Status        WebConfigGetEntityByPathResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigGetEntityByPathResponse_StatusEnum" json:"status,omitempty"`
+	Entity        *DatabaseEntity                             `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetFieldSchemaResponse) Reset() {
-	*x = WebConfigGetFieldSchemaResponse{}
+func (x *WebConfigGetEntityByPathResponse) Reset() {
+	*x = WebConfigGetEntityByPathResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetFieldSchemaResponse) String() string {
+func (x *WebConfigGetEntityByPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetFieldSchemaResponse) ProtoMessage() {}
+func (*WebConfigGetEntityByPathResponse) ProtoMessage() {}
 
-func (x *WebConfigGetFieldSchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetEntityByPathResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1285,47 +1910,46 @@ func (x *WebConfigGetFieldSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetFieldSchemaResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigGetFieldSchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetEntityByPathResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigGetEntityByPathResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *WebConfigGetFieldSchemaResponse) GetStatus() WebConfigGetFieldSchemaResponse_StatusEnum {
+func (x *WebConfigGetEntityByPathResponse) GetStatus() WebConfigGetEntityByPathResponse_StatusEnum {
 	if x != nil {
 		return x.Status
 	}
-	return WebConfigGetFieldSchemaResponse_UNSPECIFIED
+	return WebConfigGetEntityByPathResponse_UNSPECIFIED
 }
 
-func (x *WebConfigGetFieldSchemaResponse) GetSchema() *DatabaseFieldSchema {
+func (x *WebConfigGetEntityByPathResponse) GetEntity() *DatabaseEntity {
 	if x != nil {
-		return x.Schema
+		return x.Entity
 	}
 	return nil
 }
 
-type WebConfigSetFieldSchemaRequest struct {
+type WebConfigGetEntityPathRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Schema        *DatabaseFieldSchema   `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigSetFieldSchemaRequest) Reset() {
-	*x = WebConfigSetFieldSchemaRequest{}
+func (x *WebConfigGetEntityPathRequest) Reset() {
+	*x = WebConfigGetEntityPathRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigSetFieldSchemaRequest) String() string {
+func (x *WebConfigGetEntityPathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigSetFieldSchemaRequest) ProtoMessage() {}
+func (*WebConfigGetEntityPathRequest) ProtoMessage() {}
 
-func (x *WebConfigSetFieldSchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetEntityPathRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1337,46 +1961,40 @@ func (x *WebConfigSetFieldSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigSetFieldSchemaRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigSetFieldSchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetEntityPathRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigGetEntityPathRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *WebConfigSetFieldSchemaRequest) GetField() string {
+func (x *WebConfigGetEntityPathRequest) GetId() string {
 	if x != nil {
-		return x.Field
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WebConfigSetFieldSchemaRequest) GetSchema() *DatabaseFieldSchema {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
-}
-
-type WebConfigSetFieldSchemaResponse struct {
-	state         protoimpl.MessageState                     `protogen:"open.v1"`
-	Status        WebConfigSetFieldSchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigSetFieldSchemaResponse_StatusEnum" json:"status,omitempty"`
+type WebConfigGetEntityPathResponse struct {
+	state         protoimpl.MessageState                    `protogen:"open.v1"`
+	Status        WebConfigGetEntityPathResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigGetEntityPathResponse_StatusEnum" json:"status,omitempty"`
+	Path          string                                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigSetFieldSchemaResponse) Reset() {
-	*x = WebConfigSetFieldSchemaResponse{}
+func (x *WebConfigGetEntityPathResponse) Reset() {
+	*x = WebConfigGetEntityPathResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigSetFieldSchemaResponse) String() string {
+func (x *WebConfigGetEntityPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigSetFieldSchemaResponse) ProtoMessage() {}
+func (*WebConfigGetEntityPathResponse) ProtoMessage() {}
 
-func (x *WebConfigSetFieldSchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetEntityPathResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1388,39 +2006,51 @@ func (x *WebConfigSetFieldSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigSetFieldSchemaResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigSetFieldSchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetEntityPathResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigGetEntityPathResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *WebConfigSetFieldSchemaResponse) GetStatus() WebConfigSetFieldSchemaResponse_StatusEnum {
+func (x *WebConfigGetEntityPathResponse) GetStatus() WebConfigGetEntityPathResponse_StatusEnum {
 	if x != nil {
 		return x.Status
 	}
-	return WebConfigSetFieldSchemaResponse_UNSPECIFIED
+	return WebConfigGetEntityPathResponse_UNSPECIFIED
 }
 
-type WebConfigGetEntitySchemaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+func (x *WebConfigGetEntityPathResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type WebConfigTraverseEntityRequest struct {
+	state      protoimpl.MessageState                       `protogen:"open.v1"`
+	Id         string                                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction  WebConfigTraverseEntityRequest_DirectionEnum `protobuf:"varint,2,opt,name=direction,proto3,enum=qdb.WebConfigTraverseEntityRequest_DirectionEnum" json:"direction,omitempty"`
+	DepthFirst bool                                         `protobuf:"varint,3,opt,name=depthFirst,proto3" json:"depthFirst,omitempty"`
+	MaxDepth   int32                                        `protobuf:"varint,4,opt,name=maxDepth,proto3" json:"maxDepth,omitempty"`
+	// Only entities of these types are returned, if any are given
+	EntityTypes   []string `protobuf:"bytes,5,rep,name=entityTypes,proto3" json:"entityTypes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetEntitySchemaRequest) Reset() {
-	*x = WebConfigGetEntitySchemaRequest{}
+func (x *WebConfigTraverseEntityRequest) Reset() {
+	*x = WebConfigTraverseEntityRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetEntitySchemaRequest) String() string {
+func (x *WebConfigTraverseEntityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetEntitySchemaRequest) ProtoMessage() {}
+func (*WebConfigTraverseEntityRequest) ProtoMessage() {}
 
-func (x *WebConfigGetEntitySchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigTraverseEntityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1432,40 +2062,68 @@ func (x *WebConfigGetEntitySchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetEntitySchemaRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigGetEntitySchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigTraverseEntityRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigTraverseEntityRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WebConfigGetEntitySchemaRequest) GetType() string {
+func (x *WebConfigTraverseEntityRequest) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
 	return ""
 }
 
-type WebConfigGetEntitySchemaResponse struct {
-	state         protoimpl.MessageState                      `protogen:"open.v1"`
-	Status        WebConfigGetEntitySchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigGetEntitySchemaResponse_StatusEnum" json:"status,omitempty"`
-	Schema        *DatabaseEntitySchema                       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+func (x *WebConfigTraverseEntityRequest) GetDirection() WebConfigTraverseEntityRequest_DirectionEnum {
+	if x != nil {
+		return x.Direction
+	}
+	return WebConfigTraverseEntityRequest_ANCESTORS
+}
+
+func (x *WebConfigTraverseEntityRequest) GetDepthFirst() bool {
+	if x != nil {
+		return x.DepthFirst
+	}
+	return false
+}
+
+func (x *WebConfigTraverseEntityRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *WebConfigTraverseEntityRequest) GetEntityTypes() []string {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+type WebConfigTraverseEntityResponse struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Status        WebConfigTraverseEntityResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigTraverseEntityResponse_StatusEnum" json:"status,omitempty"`
+	Entities      []*DatabaseEntity                          `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetEntitySchemaResponse) Reset() {
-	*x = WebConfigGetEntitySchemaResponse{}
+func (x *WebConfigTraverseEntityResponse) Reset() {
+	*x = WebConfigTraverseEntityResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetEntitySchemaResponse) String() string {
+func (x *WebConfigTraverseEntityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetEntitySchemaResponse) ProtoMessage() {}
+func (*WebConfigTraverseEntityResponse) ProtoMessage() {}
 
-func (x *WebConfigGetEntitySchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigTraverseEntityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1477,47 +2135,46 @@ func (x *WebConfigGetEntitySchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetEntitySchemaResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigGetEntitySchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigTraverseEntityResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigTraverseEntityResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *WebConfigGetEntitySchemaResponse) GetStatus() WebConfigGetEntitySchemaResponse_StatusEnum {
+func (x *WebConfigTraverseEntityResponse) GetStatus() WebConfigTraverseEntityResponse_StatusEnum {
 	if x != nil {
 		return x.Status
 	}
-	return WebConfigGetEntitySchemaResponse_UNSPECIFIED
+	return WebConfigTraverseEntityResponse_UNSPECIFIED
 }
 
-func (x *WebConfigGetEntitySchemaResponse) GetSchema() *DatabaseEntitySchema {
+func (x *WebConfigTraverseEntityResponse) GetEntities() []*DatabaseEntity {
 	if x != nil {
-		return x.Schema
+		return x.Entities
 	}
 	return nil
 }
 
-type WebConfigSetEntitySchemaRequest struct {
+type WebConfigGetFieldSchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigSetEntitySchemaRequest) Reset() {
-	*x = WebConfigSetEntitySchemaRequest{}
+func (x *WebConfigGetFieldSchemaRequest) Reset() {
+	*x = WebConfigGetFieldSchemaRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigSetEntitySchemaRequest) String() string {
+func (x *WebConfigGetFieldSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigSetEntitySchemaRequest) ProtoMessage() {}
+func (*WebConfigGetFieldSchemaRequest) ProtoMessage() {}
 
-func (x *WebConfigSetEntitySchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetFieldSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1529,46 +2186,40 @@ func (x *WebConfigSetEntitySchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigSetEntitySchemaRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigSetEntitySchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetFieldSchemaRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigGetFieldSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WebConfigSetEntitySchemaRequest) GetName() string {
+func (x *WebConfigGetFieldSchemaRequest) GetField() string {
 	if x != nil {
-		return x.Name
+		return x.Field
 	}
 	return ""
 }
 
-func (x *WebConfigSetEntitySchemaRequest) GetFields() []string {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-type WebConfigSetEntitySchemaResponse struct {
-	state         protoimpl.MessageState                      `protogen:"open.v1"`
-	Status        WebConfigSetEntitySchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigSetEntitySchemaResponse_StatusEnum" json:"status,omitempty"`
+type WebConfigGetFieldSchemaResponse struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Status        WebConfigGetFieldSchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigGetFieldSchemaResponse_StatusEnum" json:"status,omitempty"`
+	Schema        *DatabaseFieldSchema                       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigSetEntitySchemaResponse) Reset() {
-	*x = WebConfigSetEntitySchemaResponse{}
+func (x *WebConfigGetFieldSchemaResponse) Reset() {
+	*x = WebConfigGetFieldSchemaResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigSetEntitySchemaResponse) String() string {
+func (x *WebConfigGetFieldSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigSetEntitySchemaResponse) ProtoMessage() {}
+func (*WebConfigGetFieldSchemaResponse) ProtoMessage() {}
 
-func (x *WebConfigSetEntitySchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetFieldSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1580,38 +2231,47 @@ func (x *WebConfigSetEntitySchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigSetEntitySchemaResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigSetEntitySchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetFieldSchemaResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigGetFieldSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WebConfigSetEntitySchemaResponse) GetStatus() WebConfigSetEntitySchemaResponse_StatusEnum {
+func (x *WebConfigGetFieldSchemaResponse) GetStatus() WebConfigGetFieldSchemaResponse_StatusEnum {
 	if x != nil {
 		return x.Status
 	}
-	return WebConfigSetEntitySchemaResponse_UNSPECIFIED
+	return WebConfigGetFieldSchemaResponse_UNSPECIFIED
 }
 
-type WebConfigCreateSnapshotRequest struct {
+func (x *WebConfigGetFieldSchemaResponse) GetSchema() *DatabaseFieldSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type WebConfigSetFieldSchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Schema        *DatabaseFieldSchema   `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigCreateSnapshotRequest) Reset() {
-	*x = WebConfigCreateSnapshotRequest{}
+func (x *WebConfigSetFieldSchemaRequest) Reset() {
+	*x = WebConfigSetFieldSchemaRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigCreateSnapshotRequest) String() string {
+func (x *WebConfigSetFieldSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigCreateSnapshotRequest) ProtoMessage() {}
+func (*WebConfigSetFieldSchemaRequest) ProtoMessage() {}
 
-func (x *WebConfigCreateSnapshotRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigSetFieldSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1623,33 +2283,46 @@ func (x *WebConfigCreateSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigCreateSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigCreateSnapshotRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigSetFieldSchemaRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigSetFieldSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{18}
 }
 
-type WebConfigCreateSnapshotResponse struct {
+func (x *WebConfigSetFieldSchemaRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *WebConfigSetFieldSchemaRequest) GetSchema() *DatabaseFieldSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type WebConfigSetFieldSchemaResponse struct {
 	state         protoimpl.MessageState                     `protogen:"open.v1"`
-	Status        WebConfigCreateSnapshotResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigCreateSnapshotResponse_StatusEnum" json:"status,omitempty"`
-	Snapshot      *DatabaseSnapshot                          `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Status        WebConfigSetFieldSchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigSetFieldSchemaResponse_StatusEnum" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigCreateSnapshotResponse) Reset() {
-	*x = WebConfigCreateSnapshotResponse{}
+func (x *WebConfigSetFieldSchemaResponse) Reset() {
+	*x = WebConfigSetFieldSchemaResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigCreateSnapshotResponse) String() string {
+func (x *WebConfigSetFieldSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigCreateSnapshotResponse) ProtoMessage() {}
+func (*WebConfigSetFieldSchemaResponse) ProtoMessage() {}
 
-func (x *WebConfigCreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigSetFieldSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1661,46 +2334,39 @@ func (x *WebConfigCreateSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigCreateSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigCreateSnapshotResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigSetFieldSchemaResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigSetFieldSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *WebConfigCreateSnapshotResponse) GetStatus() WebConfigCreateSnapshotResponse_StatusEnum {
+func (x *WebConfigSetFieldSchemaResponse) GetStatus() WebConfigSetFieldSchemaResponse_StatusEnum {
 	if x != nil {
 		return x.Status
 	}
-	return WebConfigCreateSnapshotResponse_UNSPECIFIED
-}
-
-func (x *WebConfigCreateSnapshotResponse) GetSnapshot() *DatabaseSnapshot {
-	if x != nil {
-		return x.Snapshot
-	}
-	return nil
+	return WebConfigSetFieldSchemaResponse_UNSPECIFIED
 }
 
-type WebConfigRestoreSnapshotRequest struct {
+type WebConfigGetEntitySchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Snapshot      *DatabaseSnapshot      `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigRestoreSnapshotRequest) Reset() {
-	*x = WebConfigRestoreSnapshotRequest{}
+func (x *WebConfigGetEntitySchemaRequest) Reset() {
+	*x = WebConfigGetEntitySchemaRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigRestoreSnapshotRequest) String() string {
+func (x *WebConfigGetEntitySchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigRestoreSnapshotRequest) ProtoMessage() {}
+func (*WebConfigGetEntitySchemaRequest) ProtoMessage() {}
 
-func (x *WebConfigRestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetEntitySchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1712,39 +2378,40 @@ func (x *WebConfigRestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigRestoreSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigRestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetEntitySchemaRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigGetEntitySchemaRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *WebConfigRestoreSnapshotRequest) GetSnapshot() *DatabaseSnapshot {
+func (x *WebConfigGetEntitySchemaRequest) GetType() string {
 	if x != nil {
-		return x.Snapshot
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type WebConfigRestoreSnapshotResponse struct {
+type WebConfigGetEntitySchemaResponse struct {
 	state         protoimpl.MessageState                      `protogen:"open.v1"`
-	Status        WebConfigRestoreSnapshotResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigRestoreSnapshotResponse_StatusEnum" json:"status,omitempty"`
+	Status        WebConfigGetEntitySchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigGetEntitySchemaResponse_StatusEnum" json:"status,omitempty"`
+	Schema        *DatabaseEntitySchema                       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigRestoreSnapshotResponse) Reset() {
-	*x = WebConfigRestoreSnapshotResponse{}
+func (x *WebConfigGetEntitySchemaResponse) Reset() {
+	*x = WebConfigGetEntitySchemaResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigRestoreSnapshotResponse) String() string {
+func (x *WebConfigGetEntitySchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigRestoreSnapshotResponse) ProtoMessage() {}
+func (*WebConfigGetEntitySchemaResponse) ProtoMessage() {}
 
-func (x *WebConfigRestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetEntitySchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1756,38 +2423,47 @@ func (x *WebConfigRestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigRestoreSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigRestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetEntitySchemaResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigGetEntitySchemaResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *WebConfigRestoreSnapshotResponse) GetStatus() WebConfigRestoreSnapshotResponse_StatusEnum {
+func (x *WebConfigGetEntitySchemaResponse) GetStatus() WebConfigGetEntitySchemaResponse_StatusEnum {
 	if x != nil {
 		return x.Status
 	}
-	return WebConfigRestoreSnapshotResponse_UNSPECIFIED
+	return WebConfigGetEntitySchemaResponse_UNSPECIFIED
 }
 
-type WebConfigGetRootRequest struct {
+func (x *WebConfigGetEntitySchemaResponse) GetSchema() *DatabaseEntitySchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type WebConfigSetEntitySchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetRootRequest) Reset() {
-	*x = WebConfigGetRootRequest{}
+func (x *WebConfigSetEntitySchemaRequest) Reset() {
+	*x = WebConfigSetEntitySchemaRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetRootRequest) String() string {
+func (x *WebConfigSetEntitySchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetRootRequest) ProtoMessage() {}
+func (*WebConfigSetEntitySchemaRequest) ProtoMessage() {}
 
-func (x *WebConfigGetRootRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigSetEntitySchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1799,32 +2475,46 @@ func (x *WebConfigGetRootRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetRootRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigGetRootRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigSetEntitySchemaRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigSetEntitySchemaRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{22}
 }
 
-type WebConfigGetRootResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RootId        string                 `protobuf:"bytes,1,opt,name=rootId,proto3" json:"rootId,omitempty"`
+func (x *WebConfigSetEntitySchemaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebConfigSetEntitySchemaRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type WebConfigSetEntitySchemaResponse struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Status        WebConfigSetEntitySchemaResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigSetEntitySchemaResponse_StatusEnum" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetRootResponse) Reset() {
-	*x = WebConfigGetRootResponse{}
+func (x *WebConfigSetEntitySchemaResponse) Reset() {
+	*x = WebConfigSetEntitySchemaResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetRootResponse) String() string {
+func (x *WebConfigSetEntitySchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetRootResponse) ProtoMessage() {}
+func (*WebConfigSetEntitySchemaResponse) ProtoMessage() {}
 
-func (x *WebConfigGetRootResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigSetEntitySchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1836,38 +2526,38 @@ func (x *WebConfigGetRootResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetRootResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigGetRootResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigSetEntitySchemaResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigSetEntitySchemaResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *WebConfigGetRootResponse) GetRootId() string {
+func (x *WebConfigSetEntitySchemaResponse) GetStatus() WebConfigSetEntitySchemaResponse_StatusEnum {
 	if x != nil {
-		return x.RootId
+		return x.Status
 	}
-	return ""
+	return WebConfigSetEntitySchemaResponse_UNSPECIFIED
 }
 
-type WebConfigGetAllFieldsRequest struct {
+type WebConfigCreateSnapshotRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetAllFieldsRequest) Reset() {
-	*x = WebConfigGetAllFieldsRequest{}
+func (x *WebConfigCreateSnapshotRequest) Reset() {
+	*x = WebConfigCreateSnapshotRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetAllFieldsRequest) String() string {
+func (x *WebConfigCreateSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetAllFieldsRequest) ProtoMessage() {}
+func (*WebConfigCreateSnapshotRequest) ProtoMessage() {}
 
-func (x *WebConfigGetAllFieldsRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigCreateSnapshotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1879,32 +2569,33 @@ func (x *WebConfigGetAllFieldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetAllFieldsRequest.ProtoReflect.Descriptor instead.
-func (*WebConfigGetAllFieldsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigCreateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigCreateSnapshotRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{24}
 }
 
-type WebConfigGetAllFieldsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fields        []string               `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+type WebConfigCreateSnapshotResponse struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Status        WebConfigCreateSnapshotResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigCreateSnapshotResponse_StatusEnum" json:"status,omitempty"`
+	Snapshot      *DatabaseSnapshot                          `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebConfigGetAllFieldsResponse) Reset() {
-	*x = WebConfigGetAllFieldsResponse{}
+func (x *WebConfigCreateSnapshotResponse) Reset() {
+	*x = WebConfigCreateSnapshotResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebConfigGetAllFieldsResponse) String() string {
+func (x *WebConfigCreateSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebConfigGetAllFieldsResponse) ProtoMessage() {}
+func (*WebConfigCreateSnapshotResponse) ProtoMessage() {}
 
-func (x *WebConfigGetAllFieldsResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigCreateSnapshotResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1916,40 +2607,53 @@ func (x *WebConfigGetAllFieldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebConfigGetAllFieldsResponse.ProtoReflect.Descriptor instead.
-func (*WebConfigGetAllFieldsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigCreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigCreateSnapshotResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *WebConfigGetAllFieldsResponse) GetFields() []string {
+func (x *WebConfigCreateSnapshotResponse) GetStatus() WebConfigCreateSnapshotResponse_StatusEnum {
 	if x != nil {
-		return x.Fields
+		return x.Status
+	}
+	return WebConfigCreateSnapshotResponse_UNSPECIFIED
+}
+
+func (x *WebConfigCreateSnapshotResponse) GetSnapshot() *DatabaseSnapshot {
+	if x != nil {
+		return x.Snapshot
 	}
 	return nil
 }
 
-type WebRuntimeDatabaseRequest struct {
-	state         protoimpl.MessageState                    `protogen:"open.v1"`
-	RequestType   WebRuntimeDatabaseRequest_RequestTypeEnum `protobuf:"varint,1,opt,name=requestType,proto3,enum=qdb.WebRuntimeDatabaseRequest_RequestTypeEnum" json:"requestType,omitempty"`
-	Requests      []*DatabaseRequest                        `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WebConfigRestoreSnapshotRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot *DatabaseSnapshot      `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Upsert the snapshot into the database instead of replacing its content
+	Merge bool `protobuf:"varint,2,opt,name=merge,proto3" json:"merge,omitempty"`
+	// The options below only apply when merging
+	Prune                   bool `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+	SuppressNotifications   bool `protobuf:"varint,4,opt,name=suppressNotifications,proto3" json:"suppressNotifications,omitempty"`
+	SuppressTransformations bool `protobuf:"varint,5,opt,name=suppressTransformations,proto3" json:"suppressTransformations,omitempty"`
+	DryRun                  bool `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *WebRuntimeDatabaseRequest) Reset() {
-	*x = WebRuntimeDatabaseRequest{}
+func (x *WebConfigRestoreSnapshotRequest) Reset() {
+	*x = WebConfigRestoreSnapshotRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeDatabaseRequest) String() string {
+func (x *WebConfigRestoreSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeDatabaseRequest) ProtoMessage() {}
+func (*WebConfigRestoreSnapshotRequest) ProtoMessage() {}
 
-func (x *WebRuntimeDatabaseRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigRestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1961,46 +2665,75 @@ func (x *WebRuntimeDatabaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeDatabaseRequest.ProtoReflect.Descriptor instead.
-func (*WebRuntimeDatabaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigRestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigRestoreSnapshotRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *WebRuntimeDatabaseRequest) GetRequestType() WebRuntimeDatabaseRequest_RequestTypeEnum {
+func (x *WebConfigRestoreSnapshotRequest) GetSnapshot() *DatabaseSnapshot {
 	if x != nil {
-		return x.RequestType
+		return x.Snapshot
 	}
-	return WebRuntimeDatabaseRequest_UNSPECIFIED
+	return nil
 }
 
-func (x *WebRuntimeDatabaseRequest) GetRequests() []*DatabaseRequest {
+func (x *WebConfigRestoreSnapshotRequest) GetMerge() bool {
 	if x != nil {
-		return x.Requests
+		return x.Merge
 	}
-	return nil
+	return false
 }
 
-type WebRuntimeDatabaseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      []*DatabaseRequest     `protobuf:"bytes,2,rep,name=response,proto3" json:"response,omitempty"`
+func (x *WebConfigRestoreSnapshotRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *WebConfigRestoreSnapshotRequest) GetSuppressNotifications() bool {
+	if x != nil {
+		return x.SuppressNotifications
+	}
+	return false
+}
+
+func (x *WebConfigRestoreSnapshotRequest) GetSuppressTransformations() bool {
+	if x != nil {
+		return x.SuppressTransformations
+	}
+	return false
+}
+
+func (x *WebConfigRestoreSnapshotRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type WebConfigRestoreSnapshotResponse struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Status        WebConfigRestoreSnapshotResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigRestoreSnapshotResponse_StatusEnum" json:"status,omitempty"`
+	Summary       *DatabaseSnapshotMergeSummary               `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeDatabaseResponse) Reset() {
-	*x = WebRuntimeDatabaseResponse{}
+func (x *WebConfigRestoreSnapshotResponse) Reset() {
+	*x = WebConfigRestoreSnapshotResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeDatabaseResponse) String() string {
+func (x *WebConfigRestoreSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeDatabaseResponse) ProtoMessage() {}
+func (*WebConfigRestoreSnapshotResponse) ProtoMessage() {}
 
-func (x *WebRuntimeDatabaseResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigRestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2012,39 +2745,48 @@ func (x *WebRuntimeDatabaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeDatabaseResponse.ProtoReflect.Descriptor instead.
-func (*WebRuntimeDatabaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigRestoreSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigRestoreSnapshotResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *WebRuntimeDatabaseResponse) GetResponse() []*DatabaseRequest {
+func (x *WebConfigRestoreSnapshotResponse) GetStatus() WebConfigRestoreSnapshotResponse_StatusEnum {
 	if x != nil {
-		return x.Response
+		return x.Status
+	}
+	return WebConfigRestoreSnapshotResponse_UNSPECIFIED
+}
+
+func (x *WebConfigRestoreSnapshotResponse) GetSummary() *DatabaseSnapshotMergeSummary {
+	if x != nil {
+		return x.Summary
 	}
 	return nil
 }
 
-type WebRuntimeRegisterNotificationRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Requests      []*DatabaseNotificationConfig `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+type WebConfigDiffSnapshotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The live database is compared with the new snapshot when there is no old snapshot
+	Old           *DatabaseSnapshot `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New           *DatabaseSnapshot `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeRegisterNotificationRequest) Reset() {
-	*x = WebRuntimeRegisterNotificationRequest{}
+func (x *WebConfigDiffSnapshotRequest) Reset() {
+	*x = WebConfigDiffSnapshotRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeRegisterNotificationRequest) String() string {
+func (x *WebConfigDiffSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeRegisterNotificationRequest) ProtoMessage() {}
+func (*WebConfigDiffSnapshotRequest) ProtoMessage() {}
 
-func (x *WebRuntimeRegisterNotificationRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigDiffSnapshotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2056,39 +2798,48 @@ func (x *WebRuntimeRegisterNotificationRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeRegisterNotificationRequest.ProtoReflect.Descriptor instead.
-func (*WebRuntimeRegisterNotificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigDiffSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigDiffSnapshotRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *WebRuntimeRegisterNotificationRequest) GetRequests() []*DatabaseNotificationConfig {
+func (x *WebConfigDiffSnapshotRequest) GetOld() *DatabaseSnapshot {
 	if x != nil {
-		return x.Requests
+		return x.Old
 	}
 	return nil
 }
 
-type WebRuntimeRegisterNotificationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tokens        []string               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+func (x *WebConfigDiffSnapshotRequest) GetNew() *DatabaseSnapshot {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+type WebConfigDiffSnapshotResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Status        WebConfigDiffSnapshotResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebConfigDiffSnapshotResponse_StatusEnum" json:"status,omitempty"`
+	Diff          *DatabaseSnapshotDiff                    `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	Text          string                                   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeRegisterNotificationResponse) Reset() {
-	*x = WebRuntimeRegisterNotificationResponse{}
+func (x *WebConfigDiffSnapshotResponse) Reset() {
+	*x = WebConfigDiffSnapshotResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeRegisterNotificationResponse) String() string {
+func (x *WebConfigDiffSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeRegisterNotificationResponse) ProtoMessage() {}
+func (*WebConfigDiffSnapshotResponse) ProtoMessage() {}
 
-func (x *WebRuntimeRegisterNotificationResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigDiffSnapshotResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2100,38 +2851,52 @@ func (x *WebRuntimeRegisterNotificationResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeRegisterNotificationResponse.ProtoReflect.Descriptor instead.
-func (*WebRuntimeRegisterNotificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigDiffSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigDiffSnapshotResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *WebRuntimeRegisterNotificationResponse) GetTokens() []string {
+func (x *WebConfigDiffSnapshotResponse) GetStatus() WebConfigDiffSnapshotResponse_StatusEnum {
 	if x != nil {
-		return x.Tokens
+		return x.Status
+	}
+	return WebConfigDiffSnapshotResponse_UNSPECIFIED
+}
+
+func (x *WebConfigDiffSnapshotResponse) GetDiff() *DatabaseSnapshotDiff {
+	if x != nil {
+		return x.Diff
 	}
 	return nil
 }
 
-type WebRuntimeGetNotificationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
+func (x *WebConfigDiffSnapshotResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WebConfigGetRootRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeGetNotificationsRequest) Reset() {
-	*x = WebRuntimeGetNotificationsRequest{}
+func (x *WebConfigGetRootRequest) Reset() {
+	*x = WebConfigGetRootRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeGetNotificationsRequest) String() string {
+func (x *WebConfigGetRootRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeGetNotificationsRequest) ProtoMessage() {}
+func (*WebConfigGetRootRequest) ProtoMessage() {}
 
-func (x *WebRuntimeGetNotificationsRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetRootRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2143,32 +2908,32 @@ func (x *WebRuntimeGetNotificationsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeGetNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*WebRuntimeGetNotificationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetRootRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigGetRootRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{30}
 }
 
-type WebRuntimeGetNotificationsResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Notifications []*DatabaseNotification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+type WebConfigGetRootResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootId        string                 `protobuf:"bytes,1,opt,name=rootId,proto3" json:"rootId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeGetNotificationsResponse) Reset() {
-	*x = WebRuntimeGetNotificationsResponse{}
+func (x *WebConfigGetRootResponse) Reset() {
+	*x = WebConfigGetRootResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeGetNotificationsResponse) String() string {
+func (x *WebConfigGetRootResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeGetNotificationsResponse) ProtoMessage() {}
+func (*WebConfigGetRootResponse) ProtoMessage() {}
 
-func (x *WebRuntimeGetNotificationsResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetRootResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2180,39 +2945,40 @@ func (x *WebRuntimeGetNotificationsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeGetNotificationsResponse.ProtoReflect.Descriptor instead.
-func (*WebRuntimeGetNotificationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetRootResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigGetRootResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *WebRuntimeGetNotificationsResponse) GetNotifications() []*DatabaseNotification {
+func (x *WebConfigGetRootResponse) GetRootId() string {
 	if x != nil {
-		return x.Notifications
+		return x.RootId
 	}
-	return nil
+	return ""
 }
 
-type WebRuntimeUnregisterNotificationRequest struct {
+type WebConfigGetAllFieldsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tokens        []string               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeUnregisterNotificationRequest) Reset() {
-	*x = WebRuntimeUnregisterNotificationRequest{}
+func (x *WebConfigGetAllFieldsRequest) Reset() {
+	*x = WebConfigGetAllFieldsRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeUnregisterNotificationRequest) String() string {
+func (x *WebConfigGetAllFieldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeUnregisterNotificationRequest) ProtoMessage() {}
+func (*WebConfigGetAllFieldsRequest) ProtoMessage() {}
 
-func (x *WebRuntimeUnregisterNotificationRequest) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetAllFieldsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2224,39 +2990,47 @@ func (x *WebRuntimeUnregisterNotificationRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeUnregisterNotificationRequest.ProtoReflect.Descriptor instead.
-func (*WebRuntimeUnregisterNotificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetAllFieldsRequest.ProtoReflect.Descriptor instead.
+func (*WebConfigGetAllFieldsRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *WebRuntimeUnregisterNotificationRequest) GetTokens() []string {
+func (x *WebConfigGetAllFieldsRequest) GetCursor() string {
 	if x != nil {
-		return x.Tokens
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-type WebRuntimeUnregisterNotificationResponse struct {
-	state         protoimpl.MessageState                              `protogen:"open.v1"`
-	Status        WebRuntimeUnregisterNotificationResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebRuntimeUnregisterNotificationResponse_StatusEnum" json:"status,omitempty"`
+func (x *WebConfigGetAllFieldsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type WebConfigGetAllFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []string               `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeUnregisterNotificationResponse) Reset() {
-	*x = WebRuntimeUnregisterNotificationResponse{}
+func (x *WebConfigGetAllFieldsResponse) Reset() {
+	*x = WebConfigGetAllFieldsResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeUnregisterNotificationResponse) String() string {
+func (x *WebConfigGetAllFieldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeUnregisterNotificationResponse) ProtoMessage() {}
+func (*WebConfigGetAllFieldsResponse) ProtoMessage() {}
 
-func (x *WebRuntimeUnregisterNotificationResponse) ProtoReflect() protoreflect.Message {
+func (x *WebConfigGetAllFieldsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2268,38 +3042,47 @@ func (x *WebRuntimeUnregisterNotificationResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeUnregisterNotificationResponse.ProtoReflect.Descriptor instead.
-func (*WebRuntimeUnregisterNotificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebConfigGetAllFieldsResponse.ProtoReflect.Descriptor instead.
+func (*WebConfigGetAllFieldsResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *WebRuntimeUnregisterNotificationResponse) GetStatus() WebRuntimeUnregisterNotificationResponse_StatusEnum {
+func (x *WebConfigGetAllFieldsResponse) GetFields() []string {
 	if x != nil {
-		return x.Status
+		return x.Fields
 	}
-	return WebRuntimeUnregisterNotificationResponse_UNSPECIFIED
+	return nil
 }
 
-type WebRuntimeGetDatabaseConnectionStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *WebConfigGetAllFieldsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type WebRuntimeDatabaseRequest struct {
+	state         protoimpl.MessageState                    `protogen:"open.v1"`
+	RequestType   WebRuntimeDatabaseRequest_RequestTypeEnum `protobuf:"varint,1,opt,name=requestType,proto3,enum=qdb.WebRuntimeDatabaseRequest_RequestTypeEnum" json:"requestType,omitempty"`
+	Requests      []*DatabaseRequest                        `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeGetDatabaseConnectionStatusRequest) Reset() {
-	*x = WebRuntimeGetDatabaseConnectionStatusRequest{}
+func (x *WebRuntimeDatabaseRequest) Reset() {
+	*x = WebRuntimeDatabaseRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeGetDatabaseConnectionStatusRequest) String() string {
+func (x *WebRuntimeDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeGetDatabaseConnectionStatusRequest) ProtoMessage() {}
+func (*WebRuntimeDatabaseRequest) ProtoMessage() {}
 
-func (x *WebRuntimeGetDatabaseConnectionStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *WebRuntimeDatabaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2311,32 +3094,46 @@ func (x *WebRuntimeGetDatabaseConnectionStatusRequest) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeGetDatabaseConnectionStatusRequest.ProtoReflect.Descriptor instead.
-func (*WebRuntimeGetDatabaseConnectionStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebRuntimeDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeDatabaseRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{34}
 }
 
-type WebRuntimeGetDatabaseConnectionStatusResponse struct {
+func (x *WebRuntimeDatabaseRequest) GetRequestType() WebRuntimeDatabaseRequest_RequestTypeEnum {
+	if x != nil {
+		return x.RequestType
+	}
+	return WebRuntimeDatabaseRequest_UNSPECIFIED
+}
+
+func (x *WebRuntimeDatabaseRequest) GetRequests() []*DatabaseRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type WebRuntimeDatabaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        *ConnectionState       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Response      []*DatabaseRequest     `protobuf:"bytes,2,rep,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeGetDatabaseConnectionStatusResponse) Reset() {
-	*x = WebRuntimeGetDatabaseConnectionStatusResponse{}
+func (x *WebRuntimeDatabaseResponse) Reset() {
+	*x = WebRuntimeDatabaseResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeGetDatabaseConnectionStatusResponse) String() string {
+func (x *WebRuntimeDatabaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeGetDatabaseConnectionStatusResponse) ProtoMessage() {}
+func (*WebRuntimeDatabaseResponse) ProtoMessage() {}
 
-func (x *WebRuntimeGetDatabaseConnectionStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *WebRuntimeDatabaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2348,39 +3145,39 @@ func (x *WebRuntimeGetDatabaseConnectionStatusResponse) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeGetDatabaseConnectionStatusResponse.ProtoReflect.Descriptor instead.
-func (*WebRuntimeGetDatabaseConnectionStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebRuntimeDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeDatabaseResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *WebRuntimeGetDatabaseConnectionStatusResponse) GetStatus() *ConnectionState {
+func (x *WebRuntimeDatabaseResponse) GetResponse() []*DatabaseRequest {
 	if x != nil {
-		return x.Status
+		return x.Response
 	}
 	return nil
 }
 
-type WebRuntimeGetEntitiesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityType    string                 `protobuf:"bytes,1,opt,name=entityType,proto3" json:"entityType,omitempty"`
+type WebRuntimeRegisterNotificationRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Requests      []*DatabaseNotificationConfig `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeGetEntitiesRequest) Reset() {
-	*x = WebRuntimeGetEntitiesRequest{}
+func (x *WebRuntimeRegisterNotificationRequest) Reset() {
+	*x = WebRuntimeRegisterNotificationRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeGetEntitiesRequest) String() string {
+func (x *WebRuntimeRegisterNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeGetEntitiesRequest) ProtoMessage() {}
+func (*WebRuntimeRegisterNotificationRequest) ProtoMessage() {}
 
-func (x *WebRuntimeGetEntitiesRequest) ProtoReflect() protoreflect.Message {
+func (x *WebRuntimeRegisterNotificationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2392,39 +3189,39 @@ func (x *WebRuntimeGetEntitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeGetEntitiesRequest.ProtoReflect.Descriptor instead.
-func (*WebRuntimeGetEntitiesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebRuntimeRegisterNotificationRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeRegisterNotificationRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *WebRuntimeGetEntitiesRequest) GetEntityType() string {
+func (x *WebRuntimeRegisterNotificationRequest) GetRequests() []*DatabaseNotificationConfig {
 	if x != nil {
-		return x.EntityType
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-type WebRuntimeGetEntitiesResponse struct {
+type WebRuntimeRegisterNotificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entities      []*DatabaseEntity      `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	Tokens        []string               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebRuntimeGetEntitiesResponse) Reset() {
-	*x = WebRuntimeGetEntitiesResponse{}
+func (x *WebRuntimeRegisterNotificationResponse) Reset() {
+	*x = WebRuntimeRegisterNotificationResponse{}
 	mi := &file_src_protobufs_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebRuntimeGetEntitiesResponse) String() string {
+func (x *WebRuntimeRegisterNotificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebRuntimeGetEntitiesResponse) ProtoMessage() {}
+func (*WebRuntimeRegisterNotificationResponse) ProtoMessage() {}
 
-func (x *WebRuntimeGetEntitiesResponse) ProtoReflect() protoreflect.Message {
+func (x *WebRuntimeRegisterNotificationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2436,43 +3233,38 @@ func (x *WebRuntimeGetEntitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebRuntimeGetEntitiesResponse.ProtoReflect.Descriptor instead.
-func (*WebRuntimeGetEntitiesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebRuntimeRegisterNotificationResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeRegisterNotificationResponse) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *WebRuntimeGetEntitiesResponse) GetEntities() []*DatabaseEntity {
+func (x *WebRuntimeRegisterNotificationResponse) GetTokens() []string {
 	if x != nil {
-		return x.Entities
+		return x.Tokens
 	}
 	return nil
 }
 
-type DatabaseEntity struct {
+type WebRuntimeGetNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Parent        *EntityReference       `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
-	Children      []*EntityReference     `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseEntity) Reset() {
-	*x = DatabaseEntity{}
+func (x *WebRuntimeGetNotificationsRequest) Reset() {
+	*x = WebRuntimeGetNotificationsRequest{}
 	mi := &file_src_protobufs_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseEntity) String() string {
+func (x *WebRuntimeGetNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseEntity) ProtoMessage() {}
+func (*WebRuntimeGetNotificationsRequest) ProtoMessage() {}
 
-func (x *DatabaseEntity) ProtoReflect() protoreflect.Message {
+func (x *WebRuntimeGetNotificationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protobufs_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2484,72 +3276,1804 @@ func (x *DatabaseEntity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseEntity.ProtoReflect.Descriptor instead.
-func (*DatabaseEntity) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebRuntimeGetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetNotificationsRequest) Descriptor() ([]byte, []int) {
 	return file_src_protobufs_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DatabaseEntity) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type WebRuntimeGetNotificationsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Notifications []*DatabaseNotification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseEntity) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+func (x *WebRuntimeGetNotificationsResponse) Reset() {
+	*x = WebRuntimeGetNotificationsResponse{}
+	mi := &file_src_protobufs_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseEntity) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
+func (x *WebRuntimeGetNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeGetNotificationsResponse) ProtoMessage() {}
+
+func (x *WebRuntimeGetNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeGetNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WebRuntimeGetNotificationsResponse) GetNotifications() []*DatabaseNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type WebRuntimeUnregisterNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []string               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeUnregisterNotificationRequest) Reset() {
+	*x = WebRuntimeUnregisterNotificationRequest{}
+	mi := &file_src_protobufs_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeUnregisterNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeUnregisterNotificationRequest) ProtoMessage() {}
+
+func (x *WebRuntimeUnregisterNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeUnregisterNotificationRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeUnregisterNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WebRuntimeUnregisterNotificationRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type WebRuntimeUnregisterNotificationResponse struct {
+	state         protoimpl.MessageState                              `protogen:"open.v1"`
+	Status        WebRuntimeUnregisterNotificationResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebRuntimeUnregisterNotificationResponse_StatusEnum" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeUnregisterNotificationResponse) Reset() {
+	*x = WebRuntimeUnregisterNotificationResponse{}
+	mi := &file_src_protobufs_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeUnregisterNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeUnregisterNotificationResponse) ProtoMessage() {}
+
+func (x *WebRuntimeUnregisterNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeUnregisterNotificationResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeUnregisterNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WebRuntimeUnregisterNotificationResponse) GetStatus() WebRuntimeUnregisterNotificationResponse_StatusEnum {
+	if x != nil {
+		return x.Status
+	}
+	return WebRuntimeUnregisterNotificationResponse_UNSPECIFIED
+}
+
+type WebRuntimeGetDatabaseConnectionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeGetDatabaseConnectionStatusRequest) Reset() {
+	*x = WebRuntimeGetDatabaseConnectionStatusRequest{}
+	mi := &file_src_protobufs_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeGetDatabaseConnectionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeGetDatabaseConnectionStatusRequest) ProtoMessage() {}
+
+func (x *WebRuntimeGetDatabaseConnectionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeGetDatabaseConnectionStatusRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetDatabaseConnectionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{42}
+}
+
+type WebRuntimeGetDatabaseConnectionStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *ConnectionState       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeGetDatabaseConnectionStatusResponse) Reset() {
+	*x = WebRuntimeGetDatabaseConnectionStatusResponse{}
+	mi := &file_src_protobufs_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeGetDatabaseConnectionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeGetDatabaseConnectionStatusResponse) ProtoMessage() {}
+
+func (x *WebRuntimeGetDatabaseConnectionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeGetDatabaseConnectionStatusResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetDatabaseConnectionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WebRuntimeGetDatabaseConnectionStatusResponse) GetStatus() *ConnectionState {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type WebRuntimeGetEntitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeGetEntitiesRequest) Reset() {
+	*x = WebRuntimeGetEntitiesRequest{}
+	mi := &file_src_protobufs_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeGetEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeGetEntitiesRequest) ProtoMessage() {}
+
+func (x *WebRuntimeGetEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeGetEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WebRuntimeGetEntitiesRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *WebRuntimeGetEntitiesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WebRuntimeGetEntitiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type WebRuntimeGetEntitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entities      []*DatabaseEntity      `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeGetEntitiesResponse) Reset() {
+	*x = WebRuntimeGetEntitiesResponse{}
+	mi := &file_src_protobufs_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeGetEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeGetEntitiesResponse) ProtoMessage() {}
+
+func (x *WebRuntimeGetEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeGetEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WebRuntimeGetEntitiesResponse) GetEntities() []*DatabaseEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *WebRuntimeGetEntitiesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type WebRuntimeQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeQueryRequest) Reset() {
+	*x = WebRuntimeQueryRequest{}
+	mi := &file_src_protobufs_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeQueryRequest) ProtoMessage() {}
+
+func (x *WebRuntimeQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeQueryRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeQueryRequest) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WebRuntimeQueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type WebRuntimeQueryResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Status        WebRuntimeQueryResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebRuntimeQueryResponse_StatusEnum" json:"status,omitempty"`
+	Error         string                             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Rows          []*DatabaseQueryRow                `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeQueryResponse) Reset() {
+	*x = WebRuntimeQueryResponse{}
+	mi := &file_src_protobufs_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeQueryResponse) ProtoMessage() {}
+
+func (x *WebRuntimeQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeQueryResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeQueryResponse) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WebRuntimeQueryResponse) GetStatus() WebRuntimeQueryResponse_StatusEnum {
+	if x != nil {
+		return x.Status
+	}
+	return WebRuntimeQueryResponse_UNSPECIFIED
+}
+
+func (x *WebRuntimeQueryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebRuntimeQueryResponse) GetRows() []*DatabaseQueryRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type WebRuntimeGetReferrersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeGetReferrersRequest) Reset() {
+	*x = WebRuntimeGetReferrersRequest{}
+	mi := &file_src_protobufs_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeGetReferrersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeGetReferrersRequest) ProtoMessage() {}
+
+func (x *WebRuntimeGetReferrersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeGetReferrersRequest.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetReferrersRequest) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WebRuntimeGetReferrersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WebRuntimeGetReferrersResponse struct {
+	state         protoimpl.MessageState                    `protogen:"open.v1"`
+	Status        WebRuntimeGetReferrersResponse_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=qdb.WebRuntimeGetReferrersResponse_StatusEnum" json:"status,omitempty"`
+	Referrers     []*DatabaseReference                      `protobuf:"bytes,2,rep,name=referrers,proto3" json:"referrers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebRuntimeGetReferrersResponse) Reset() {
+	*x = WebRuntimeGetReferrersResponse{}
+	mi := &file_src_protobufs_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebRuntimeGetReferrersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebRuntimeGetReferrersResponse) ProtoMessage() {}
+
+func (x *WebRuntimeGetReferrersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebRuntimeGetReferrersResponse.ProtoReflect.Descriptor instead.
+func (*WebRuntimeGetReferrersResponse) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WebRuntimeGetReferrersResponse) GetStatus() WebRuntimeGetReferrersResponse_StatusEnum {
+	if x != nil {
+		return x.Status
+	}
+	return WebRuntimeGetReferrersResponse_UNSPECIFIED
+}
+
+func (x *WebRuntimeGetReferrersResponse) GetReferrers() []*DatabaseReference {
+	if x != nil {
+		return x.Referrers
+	}
+	return nil
+}
+
+type DatabaseReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseReference) Reset() {
+	*x = DatabaseReference{}
+	mi := &file_src_protobufs_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseReference) ProtoMessage() {}
+
+func (x *DatabaseReference) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseReference.ProtoReflect.Descriptor instead.
+func (*DatabaseReference) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DatabaseReference) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *DatabaseReference) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type DatabaseEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Parent        *EntityReference       `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	Children      []*EntityReference     `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseEntity) Reset() {
+	*x = DatabaseEntity{}
+	mi := &file_src_protobufs_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseEntity) ProtoMessage() {}
+
+func (x *DatabaseEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseEntity.ProtoReflect.Descriptor instead.
+func (*DatabaseEntity) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DatabaseEntity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DatabaseEntity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DatabaseEntity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseEntity) GetParent() *EntityReference {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *DatabaseEntity) GetChildren() []*EntityReference {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type DatabaseSnapshotMergeSummary struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CreatedEntitySchemas []string               `protobuf:"bytes,1,rep,name=createdEntitySchemas,proto3" json:"createdEntitySchemas,omitempty"`
+	UpdatedEntitySchemas []string               `protobuf:"bytes,2,rep,name=updatedEntitySchemas,proto3" json:"updatedEntitySchemas,omitempty"`
+	CreatedFieldSchemas  []string               `protobuf:"bytes,3,rep,name=createdFieldSchemas,proto3" json:"createdFieldSchemas,omitempty"`
+	UpdatedFieldSchemas  []string               `protobuf:"bytes,4,rep,name=updatedFieldSchemas,proto3" json:"updatedFieldSchemas,omitempty"`
+	CreatedEntities      []string               `protobuf:"bytes,5,rep,name=createdEntities,proto3" json:"createdEntities,omitempty"`
+	UpdatedEntities      []string               `protobuf:"bytes,6,rep,name=updatedEntities,proto3" json:"updatedEntities,omitempty"`
+	PrunedEntities       []string               `protobuf:"bytes,7,rep,name=prunedEntities,proto3" json:"prunedEntities,omitempty"`
+	WrittenFields        []*DatabaseField       `protobuf:"bytes,8,rep,name=writtenFields,proto3" json:"writtenFields,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DatabaseSnapshotMergeSummary) Reset() {
+	*x = DatabaseSnapshotMergeSummary{}
+	mi := &file_src_protobufs_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseSnapshotMergeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSnapshotMergeSummary) ProtoMessage() {}
+
+func (x *DatabaseSnapshotMergeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSnapshotMergeSummary.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotMergeSummary) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetCreatedEntitySchemas() []string {
+	if x != nil {
+		return x.CreatedEntitySchemas
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetUpdatedEntitySchemas() []string {
+	if x != nil {
+		return x.UpdatedEntitySchemas
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetCreatedFieldSchemas() []string {
+	if x != nil {
+		return x.CreatedFieldSchemas
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetUpdatedFieldSchemas() []string {
+	if x != nil {
+		return x.UpdatedFieldSchemas
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetCreatedEntities() []string {
+	if x != nil {
+		return x.CreatedEntities
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetUpdatedEntities() []string {
+	if x != nil {
+		return x.UpdatedEntities
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetPrunedEntities() []string {
+	if x != nil {
+		return x.PrunedEntities
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotMergeSummary) GetWrittenFields() []*DatabaseField {
+	if x != nil {
+		return x.WrittenFields
+	}
+	return nil
+}
+
+type DatabaseSnapshotDiff struct {
+	state           protoimpl.MessageState               `protogen:"open.v1"`
+	AddedEntities   []*DatabaseSnapshotDiff_EntityChange `protobuf:"bytes,1,rep,name=addedEntities,proto3" json:"addedEntities,omitempty"`
+	RemovedEntities []*DatabaseSnapshotDiff_EntityChange `protobuf:"bytes,2,rep,name=removedEntities,proto3" json:"removedEntities,omitempty"`
+	// Entities whose parent or name changed
+	MovedEntities       []*DatabaseSnapshotDiff_EntityChange       `protobuf:"bytes,3,rep,name=movedEntities,proto3" json:"movedEntities,omitempty"`
+	ChangedFields       []*DatabaseSnapshotDiff_FieldChange        `protobuf:"bytes,4,rep,name=changedFields,proto3" json:"changedFields,omitempty"`
+	EntitySchemaChanges []*DatabaseSnapshotDiff_EntitySchemaChange `protobuf:"bytes,5,rep,name=entitySchemaChanges,proto3" json:"entitySchemaChanges,omitempty"`
+	FieldSchemaChanges  []*DatabaseSnapshotDiff_FieldSchemaChange  `protobuf:"bytes,6,rep,name=fieldSchemaChanges,proto3" json:"fieldSchemaChanges,omitempty"`
+	// Entities whose children that exist on both sides are in a different order
+	ReorderedEntities []*DatabaseSnapshotDiff_EntityChange `protobuf:"bytes,7,rep,name=reorderedEntities,proto3" json:"reorderedEntities,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DatabaseSnapshotDiff) Reset() {
+	*x = DatabaseSnapshotDiff{}
+	mi := &file_src_protobufs_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseSnapshotDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSnapshotDiff) ProtoMessage() {}
+
+func (x *DatabaseSnapshotDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSnapshotDiff.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotDiff) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DatabaseSnapshotDiff) GetAddedEntities() []*DatabaseSnapshotDiff_EntityChange {
+	if x != nil {
+		return x.AddedEntities
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff) GetRemovedEntities() []*DatabaseSnapshotDiff_EntityChange {
+	if x != nil {
+		return x.RemovedEntities
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff) GetMovedEntities() []*DatabaseSnapshotDiff_EntityChange {
+	if x != nil {
+		return x.MovedEntities
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff) GetChangedFields() []*DatabaseSnapshotDiff_FieldChange {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff) GetEntitySchemaChanges() []*DatabaseSnapshotDiff_EntitySchemaChange {
+	if x != nil {
+		return x.EntitySchemaChanges
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff) GetFieldSchemaChanges() []*DatabaseSnapshotDiff_FieldSchemaChange {
+	if x != nil {
+		return x.FieldSchemaChanges
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff) GetReorderedEntities() []*DatabaseSnapshotDiff_EntityChange {
+	if x != nil {
+		return x.ReorderedEntities
+	}
+	return nil
+}
+
+type DatabaseEntityEvent struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Type          DatabaseEntityEvent_TypeEnum `protobuf:"varint,1,opt,name=type,proto3,enum=qdb.DatabaseEntityEvent_TypeEnum" json:"type,omitempty"`
+	Current       *DatabaseEntity              `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Previous      *DatabaseEntity              `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseEntityEvent) Reset() {
+	*x = DatabaseEntityEvent{}
+	mi := &file_src_protobufs_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseEntityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseEntityEvent) ProtoMessage() {}
+
+func (x *DatabaseEntityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseEntityEvent.ProtoReflect.Descriptor instead.
+func (*DatabaseEntityEvent) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DatabaseEntityEvent) GetType() DatabaseEntityEvent_TypeEnum {
+	if x != nil {
+		return x.Type
+	}
+	return DatabaseEntityEvent_UNSPECIFIED
+}
+
+func (x *DatabaseEntityEvent) GetCurrent() *DatabaseEntity {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *DatabaseEntityEvent) GetPrevious() *DatabaseEntity {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+type DatabaseField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         *anypb.Any             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	WriteTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=writeTime,proto3" json:"writeTime,omitempty"`
+	WriterId      string                 `protobuf:"bytes,5,opt,name=writerId,proto3" json:"writerId,omitempty"`
+	Computed      bool                   `protobuf:"varint,6,opt,name=computed,proto3" json:"computed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseField) Reset() {
+	*x = DatabaseField{}
+	mi := &file_src_protobufs_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseField) ProtoMessage() {}
+
+func (x *DatabaseField) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseField.ProtoReflect.Descriptor instead.
+func (*DatabaseField) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DatabaseField) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DatabaseField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseField) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *DatabaseField) GetWriteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WriteTime
+	}
+	return nil
+}
+
+func (x *DatabaseField) GetWriterId() string {
+	if x != nil {
+		return x.WriterId
+	}
+	return ""
+}
+
+func (x *DatabaseField) GetComputed() bool {
+	if x != nil {
+		return x.Computed
+	}
+	return false
+}
+
+type DatabaseNotificationConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Field          string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	ContextFields  []string               `protobuf:"bytes,4,rep,name=contextFields,proto3" json:"contextFields,omitempty"`
+	NotifyOnChange bool                   `protobuf:"varint,5,opt,name=notifyOnChange,proto3" json:"notifyOnChange,omitempty"`
+	ServiceId      string                 `protobuf:"bytes,6,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DatabaseNotificationConfig) Reset() {
+	*x = DatabaseNotificationConfig{}
+	mi := &file_src_protobufs_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseNotificationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseNotificationConfig) ProtoMessage() {}
+
+func (x *DatabaseNotificationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseNotificationConfig.ProtoReflect.Descriptor instead.
+func (*DatabaseNotificationConfig) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DatabaseNotificationConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DatabaseNotificationConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DatabaseNotificationConfig) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DatabaseNotificationConfig) GetContextFields() []string {
+	if x != nil {
+		return x.ContextFields
+	}
+	return nil
+}
+
+func (x *DatabaseNotificationConfig) GetNotifyOnChange() bool {
+	if x != nil {
+		return x.NotifyOnChange
+	}
+	return false
+}
+
+func (x *DatabaseNotificationConfig) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type DatabaseNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Current       *DatabaseField         `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Previous      *DatabaseField         `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	Context       []*DatabaseField       `protobuf:"bytes,4,rep,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseNotification) Reset() {
+	*x = DatabaseNotification{}
+	mi := &file_src_protobufs_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseNotification) ProtoMessage() {}
+
+func (x *DatabaseNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseNotification.ProtoReflect.Descriptor instead.
+func (*DatabaseNotification) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DatabaseNotification) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DatabaseNotification) GetCurrent() *DatabaseField {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *DatabaseNotification) GetPrevious() *DatabaseField {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *DatabaseNotification) GetContext() []*DatabaseField {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DatabaseEntitySchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseEntitySchema) Reset() {
+	*x = DatabaseEntitySchema{}
+	mi := &file_src_protobufs_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseEntitySchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseEntitySchema) ProtoMessage() {}
+
+func (x *DatabaseEntitySchema) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseEntitySchema.ProtoReflect.Descriptor instead.
+func (*DatabaseEntitySchema) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DatabaseEntitySchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseEntitySchema) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DatabaseFieldSchema struct {
+	state            protoimpl.MessageState                  `protogen:"open.v1"`
+	Name             string                                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type             string                                  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	EnumValues       []*DatabaseEnumValue                    `protobuf:"bytes,3,rep,name=enumValues,proto3" json:"enumValues,omitempty"`
+	Computed         *DatabaseComputedField                  `protobuf:"bytes,4,opt,name=computed,proto3" json:"computed,omitempty"`
+	Unit             string                                  `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	DisplayPrecision int32                                   `protobuf:"varint,6,opt,name=displayPrecision,proto3" json:"displayPrecision,omitempty"`
+	Scale            float64                                 `protobuf:"fixed64,7,opt,name=scale,proto3" json:"scale,omitempty"`
+	Offset           float64                                 `protobuf:"fixed64,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	AlarmLimits      []*DatabaseAlarmLimit                   `protobuf:"bytes,9,rep,name=alarmLimits,proto3" json:"alarmLimits,omitempty"`
+	Index            DatabaseFieldSchema_IndexEnum           `protobuf:"varint,10,opt,name=index,proto3,enum=qdb.DatabaseFieldSchema_IndexEnum" json:"index,omitempty"`
+	OnDelete         DatabaseFieldSchema_ReferencePolicyEnum `protobuf:"varint,11,opt,name=onDelete,proto3,enum=qdb.DatabaseFieldSchema_ReferencePolicyEnum" json:"onDelete,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DatabaseFieldSchema) Reset() {
+	*x = DatabaseFieldSchema{}
+	mi := &file_src_protobufs_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseFieldSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseFieldSchema) ProtoMessage() {}
+
+func (x *DatabaseFieldSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseFieldSchema.ProtoReflect.Descriptor instead.
+func (*DatabaseFieldSchema) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DatabaseFieldSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseFieldSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DatabaseFieldSchema) GetEnumValues() []*DatabaseEnumValue {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *DatabaseFieldSchema) GetComputed() *DatabaseComputedField {
+	if x != nil {
+		return x.Computed
+	}
+	return nil
+}
+
+func (x *DatabaseFieldSchema) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *DatabaseFieldSchema) GetDisplayPrecision() int32 {
+	if x != nil {
+		return x.DisplayPrecision
+	}
+	return 0
+}
+
+func (x *DatabaseFieldSchema) GetScale() float64 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *DatabaseFieldSchema) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DatabaseFieldSchema) GetAlarmLimits() []*DatabaseAlarmLimit {
+	if x != nil {
+		return x.AlarmLimits
+	}
+	return nil
+}
+
+func (x *DatabaseFieldSchema) GetIndex() DatabaseFieldSchema_IndexEnum {
+	if x != nil {
+		return x.Index
+	}
+	return DatabaseFieldSchema_UNSPECIFIED
+}
+
+func (x *DatabaseFieldSchema) GetOnDelete() DatabaseFieldSchema_ReferencePolicyEnum {
+	if x != nil {
+		return x.OnDelete
+	}
+	return DatabaseFieldSchema_SET_NULL
+}
+
+type DatabaseEnumValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseEnumValue) Reset() {
+	*x = DatabaseEnumValue{}
+	mi := &file_src_protobufs_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseEnumValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseEnumValue) ProtoMessage() {}
+
+func (x *DatabaseEnumValue) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseEnumValue.ProtoReflect.Descriptor instead.
+func (*DatabaseEnumValue) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DatabaseEnumValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseEnumValue) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DatabaseAlarmLimit struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Level         DatabaseAlarmLimit_LevelEnum `protobuf:"varint,1,opt,name=level,proto3,enum=qdb.DatabaseAlarmLimit_LevelEnum" json:"level,omitempty"`
+	Value         float64                      `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseAlarmLimit) Reset() {
+	*x = DatabaseAlarmLimit{}
+	mi := &file_src_protobufs_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseAlarmLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseAlarmLimit) ProtoMessage() {}
+
+func (x *DatabaseAlarmLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseAlarmLimit.ProtoReflect.Descriptor instead.
+func (*DatabaseAlarmLimit) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DatabaseAlarmLimit) GetLevel() DatabaseAlarmLimit_LevelEnum {
+	if x != nil {
+		return x.Level
+	}
+	return DatabaseAlarmLimit_UNSPECIFIED
+}
+
+func (x *DatabaseAlarmLimit) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DatabaseComputedField struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Script        string                               `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Inputs        []string                             `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Evaluation    DatabaseComputedField_EvaluationEnum `protobuf:"varint,3,opt,name=evaluation,proto3,enum=qdb.DatabaseComputedField_EvaluationEnum" json:"evaluation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseComputedField) Reset() {
+	*x = DatabaseComputedField{}
+	mi := &file_src_protobufs_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseComputedField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseComputedField) ProtoMessage() {}
+
+func (x *DatabaseComputedField) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseComputedField.ProtoReflect.Descriptor instead.
+func (*DatabaseComputedField) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DatabaseComputedField) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *DatabaseComputedField) GetInputs() []string {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *DatabaseComputedField) GetEvaluation() DatabaseComputedField_EvaluationEnum {
+	if x != nil {
+		return x.Evaluation
+	}
+	return DatabaseComputedField_UNSPECIFIED
+}
+
+type DatabaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         *anypb.Any             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	WriteTime     *Timestamp             `protobuf:"bytes,4,opt,name=writeTime,proto3" json:"writeTime,omitempty"`
+	WriterId      *String                `protobuf:"bytes,5,opt,name=writerId,proto3" json:"writerId,omitempty"`
+	Success       bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseRequest) Reset() {
+	*x = DatabaseRequest{}
+	mi := &file_src_protobufs_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseRequest) ProtoMessage() {}
+
+func (x *DatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DatabaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DatabaseRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DatabaseRequest) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *DatabaseRequest) GetWriteTime() *Timestamp {
+	if x != nil {
+		return x.WriteTime
+	}
+	return nil
+}
+
+func (x *DatabaseRequest) GetWriterId() *String {
+	if x != nil {
+		return x.WriterId
+	}
+	return nil
+}
+
+func (x *DatabaseRequest) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DatabaseQueryRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Fields        []*DatabaseField       `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseQueryRow) Reset() {
+	*x = DatabaseQueryRow{}
+	mi := &file_src_protobufs_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseQueryRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseQueryRow) ProtoMessage() {}
+
+func (x *DatabaseQueryRow) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseQueryRow.ProtoReflect.Descriptor instead.
+func (*DatabaseQueryRow) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DatabaseQueryRow) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *DatabaseQueryRow) GetFields() []*DatabaseField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DatabaseSnapshot struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entities      []*DatabaseEntity       `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	Fields        []*DatabaseField        `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	EntitySchemas []*DatabaseEntitySchema `protobuf:"bytes,3,rep,name=entitySchemas,proto3" json:"entitySchemas,omitempty"`
+	FieldSchemas  []*DatabaseFieldSchema  `protobuf:"bytes,4,rep,name=fieldSchemas,proto3" json:"fieldSchemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseSnapshot) Reset() {
+	*x = DatabaseSnapshot{}
+	mi := &file_src_protobufs_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSnapshot) ProtoMessage() {}
+
+func (x *DatabaseSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSnapshot.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshot) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DatabaseSnapshot) GetEntities() []*DatabaseEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshot) GetFields() []*DatabaseField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshot) GetEntitySchemas() []*DatabaseEntitySchema {
+	if x != nil {
+		return x.EntitySchemas
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshot) GetFieldSchemas() []*DatabaseFieldSchema {
+	if x != nil {
+		return x.FieldSchemas
+	}
+	return nil
+}
+
+// A record of a streamed snapshot: exactly one field is set. The header comes first and the trailer last.
+type DatabaseSnapshotRecord struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Header        *DatabaseSnapshotRecord_Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	EntitySchema  *DatabaseEntitySchema           `protobuf:"bytes,2,opt,name=entitySchema,proto3" json:"entitySchema,omitempty"`
+	FieldSchema   *DatabaseFieldSchema            `protobuf:"bytes,3,opt,name=fieldSchema,proto3" json:"fieldSchema,omitempty"`
+	Entity        *DatabaseEntity                 `protobuf:"bytes,4,opt,name=entity,proto3" json:"entity,omitempty"`
+	Field         *DatabaseField                  `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Trailer       *DatabaseSnapshotRecord_Trailer `protobuf:"bytes,6,opt,name=trailer,proto3" json:"trailer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseSnapshotRecord) Reset() {
+	*x = DatabaseSnapshotRecord{}
+	mi := &file_src_protobufs_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseSnapshotRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSnapshotRecord) ProtoMessage() {}
+
+func (x *DatabaseSnapshotRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSnapshotRecord.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotRecord) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DatabaseSnapshotRecord) GetHeader() *DatabaseSnapshotRecord_Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotRecord) GetEntitySchema() *DatabaseEntitySchema {
+	if x != nil {
+		return x.EntitySchema
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotRecord) GetFieldSchema() *DatabaseFieldSchema {
+	if x != nil {
+		return x.FieldSchema
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotRecord) GetEntity() *DatabaseEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
 
-func (x *DatabaseEntity) GetParent() *EntityReference {
+func (x *DatabaseSnapshotRecord) GetField() *DatabaseField {
 	if x != nil {
-		return x.Parent
+		return x.Field
 	}
 	return nil
 }
 
-func (x *DatabaseEntity) GetChildren() []*EntityReference {
+func (x *DatabaseSnapshotRecord) GetTrailer() *DatabaseSnapshotRecord_Trailer {
 	if x != nil {
-		return x.Children
+		return x.Trailer
 	}
 	return nil
 }
 
-type DatabaseField struct {
+type Int struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value         *anypb.Any             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	WriteTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=writeTime,proto3" json:"writeTime,omitempty"`
-	WriterId      string                 `protobuf:"bytes,5,opt,name=writerId,proto3" json:"writerId,omitempty"`
+	Raw           int64                  `protobuf:"varint,1,opt,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseField) Reset() {
-	*x = DatabaseField{}
-	mi := &file_src_protobufs_proto_msgTypes[39]
+func (x *Int) Reset() {
+	*x = Int{}
+	mi := &file_src_protobufs_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseField) String() string {
+func (x *Int) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseField) ProtoMessage() {}
+func (*Int) ProtoMessage() {}
 
-func (x *DatabaseField) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[39]
+func (x *Int) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2560,73 +5084,84 @@ func (x *DatabaseField) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseField.ProtoReflect.Descriptor instead.
-func (*DatabaseField) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use Int.ProtoReflect.Descriptor instead.
+func (*Int) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DatabaseField) GetId() string {
+func (x *Int) GetRaw() int64 {
 	if x != nil {
-		return x.Id
+		return x.Raw
 	}
-	return ""
+	return 0
 }
 
-func (x *DatabaseField) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type String struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseField) GetValue() *anypb.Any {
-	if x != nil {
-		return x.Value
-	}
-	return nil
+func (x *String) Reset() {
+	*x = String{}
+	mi := &file_src_protobufs_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseField) GetWriteTime() *timestamppb.Timestamp {
+func (x *String) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*String) ProtoMessage() {}
+
+func (x *String) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[68]
 	if x != nil {
-		return x.WriteTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DatabaseField) GetWriterId() string {
+// Deprecated: Use String.ProtoReflect.Descriptor instead.
+func (*String) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *String) GetRaw() string {
 	if x != nil {
-		return x.WriterId
+		return x.Raw
 	}
 	return ""
 }
 
-type DatabaseNotificationConfig struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Field          string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
-	ContextFields  []string               `protobuf:"bytes,4,rep,name=contextFields,proto3" json:"contextFields,omitempty"`
-	NotifyOnChange bool                   `protobuf:"varint,5,opt,name=notifyOnChange,proto3" json:"notifyOnChange,omitempty"`
-	ServiceId      string                 `protobuf:"bytes,6,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type Timestamp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raw           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseNotificationConfig) Reset() {
-	*x = DatabaseNotificationConfig{}
-	mi := &file_src_protobufs_proto_msgTypes[40]
+func (x *Timestamp) Reset() {
+	*x = Timestamp{}
+	mi := &file_src_protobufs_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseNotificationConfig) String() string {
+func (x *Timestamp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseNotificationConfig) ProtoMessage() {}
+func (*Timestamp) ProtoMessage() {}
 
-func (x *DatabaseNotificationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[40]
+func (x *Timestamp) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,78 +5172,175 @@ func (x *DatabaseNotificationConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseNotificationConfig.ProtoReflect.Descriptor instead.
-func (*DatabaseNotificationConfig) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Timestamp.ProtoReflect.Descriptor instead.
+func (*Timestamp) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *DatabaseNotificationConfig) GetId() string {
+func (x *Timestamp) GetRaw() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Raw
 	}
-	return ""
+	return nil
 }
 
-func (x *DatabaseNotificationConfig) GetType() string {
+type Float struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raw           float64                `protobuf:"fixed64,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Float) Reset() {
+	*x = Float{}
+	mi := &file_src_protobufs_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Float) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Float) ProtoMessage() {}
+
+func (x *Float) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[70]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DatabaseNotificationConfig) GetField() string {
+// Deprecated: Use Float.ProtoReflect.Descriptor instead.
+func (*Float) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Float) GetRaw() float64 {
 	if x != nil {
-		return x.Field
+		return x.Raw
 	}
-	return ""
+	return 0
 }
 
-func (x *DatabaseNotificationConfig) GetContextFields() []string {
+type Bool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raw           bool                   `protobuf:"varint,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bool) Reset() {
+	*x = Bool{}
+	mi := &file_src_protobufs_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bool) ProtoMessage() {}
+
+func (x *Bool) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[71]
 	if x != nil {
-		return x.ContextFields
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DatabaseNotificationConfig) GetNotifyOnChange() bool {
+// Deprecated: Use Bool.ProtoReflect.Descriptor instead.
+func (*Bool) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Bool) GetRaw() bool {
 	if x != nil {
-		return x.NotifyOnChange
+		return x.Raw
 	}
 	return false
 }
 
-func (x *DatabaseNotificationConfig) GetServiceId() string {
+type EntityReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityReference) Reset() {
+	*x = EntityReference{}
+	mi := &file_src_protobufs_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityReference) ProtoMessage() {}
+
+func (x *EntityReference) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[72]
 	if x != nil {
-		return x.ServiceId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityReference.ProtoReflect.Descriptor instead.
+func (*EntityReference) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *EntityReference) GetRaw() string {
+	if x != nil {
+		return x.Raw
 	}
 	return ""
 }
 
-type DatabaseNotification struct {
+type BinaryFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Current       *DatabaseField         `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
-	Previous      *DatabaseField         `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
-	Context       []*DatabaseField       `protobuf:"bytes,4,rep,name=context,proto3" json:"context,omitempty"`
+	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseNotification) Reset() {
-	*x = DatabaseNotification{}
-	mi := &file_src_protobufs_proto_msgTypes[41]
+func (x *BinaryFile) Reset() {
+	*x = BinaryFile{}
+	mi := &file_src_protobufs_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseNotification) String() string {
+func (x *BinaryFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseNotification) ProtoMessage() {}
+func (*BinaryFile) ProtoMessage() {}
 
-func (x *DatabaseNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[41]
+func (x *BinaryFile) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2719,62 +5351,61 @@ func (x *DatabaseNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseNotification.ProtoReflect.Descriptor instead.
-func (*DatabaseNotification) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use BinaryFile.ProtoReflect.Descriptor instead.
+func (*BinaryFile) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DatabaseNotification) GetToken() string {
+func (x *BinaryFile) GetRaw() string {
 	if x != nil {
-		return x.Token
+		return x.Raw
 	}
 	return ""
 }
 
-func (x *DatabaseNotification) GetCurrent() *DatabaseField {
+func (x *BinaryFile) GetHash() string {
 	if x != nil {
-		return x.Current
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *DatabaseNotification) GetPrevious() *DatabaseField {
+func (x *BinaryFile) GetSize() int64 {
 	if x != nil {
-		return x.Previous
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-func (x *DatabaseNotification) GetContext() []*DatabaseField {
+func (x *BinaryFile) GetMimeType() string {
 	if x != nil {
-		return x.Context
+		return x.MimeType
 	}
-	return nil
+	return ""
 }
 
-type DatabaseEntitySchema struct {
+type Transformation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseEntitySchema) Reset() {
-	*x = DatabaseEntitySchema{}
-	mi := &file_src_protobufs_proto_msgTypes[42]
+func (x *Transformation) Reset() {
+	*x = Transformation{}
+	mi := &file_src_protobufs_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseEntitySchema) String() string {
+func (x *Transformation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseEntitySchema) ProtoMessage() {}
+func (*Transformation) ProtoMessage() {}
 
-func (x *DatabaseEntitySchema) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[42]
+func (x *Transformation) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2785,48 +5416,40 @@ func (x *DatabaseEntitySchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseEntitySchema.ProtoReflect.Descriptor instead.
-func (*DatabaseEntitySchema) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use Transformation.ProtoReflect.Descriptor instead.
+func (*Transformation) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DatabaseEntitySchema) GetName() string {
+func (x *Transformation) GetRaw() string {
 	if x != nil {
-		return x.Name
+		return x.Raw
 	}
 	return ""
 }
 
-func (x *DatabaseEntitySchema) GetFields() []string {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-type DatabaseFieldSchema struct {
+type IntList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Raw           []int64                `protobuf:"varint,1,rep,packed,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseFieldSchema) Reset() {
-	*x = DatabaseFieldSchema{}
-	mi := &file_src_protobufs_proto_msgTypes[43]
+func (x *IntList) Reset() {
+	*x = IntList{}
+	mi := &file_src_protobufs_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseFieldSchema) String() string {
+func (x *IntList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseFieldSchema) ProtoMessage() {}
+func (*IntList) ProtoMessage() {}
 
-func (x *DatabaseFieldSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[43]
+func (x *IntList) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,52 +5460,40 @@ func (x *DatabaseFieldSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseFieldSchema.ProtoReflect.Descriptor instead.
-func (*DatabaseFieldSchema) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *DatabaseFieldSchema) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use IntList.ProtoReflect.Descriptor instead.
+func (*IntList) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *DatabaseFieldSchema) GetType() string {
+func (x *IntList) GetRaw() []int64 {
 	if x != nil {
-		return x.Type
+		return x.Raw
 	}
-	return ""
+	return nil
 }
 
-type DatabaseRequest struct {
+type StringList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
-	Value         *anypb.Any             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	WriteTime     *Timestamp             `protobuf:"bytes,4,opt,name=writeTime,proto3" json:"writeTime,omitempty"`
-	WriterId      *String                `protobuf:"bytes,5,opt,name=writerId,proto3" json:"writerId,omitempty"`
-	Success       bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	Raw           []string               `protobuf:"bytes,1,rep,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseRequest) Reset() {
-	*x = DatabaseRequest{}
-	mi := &file_src_protobufs_proto_msgTypes[44]
+func (x *StringList) Reset() {
+	*x = StringList{}
+	mi := &file_src_protobufs_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseRequest) String() string {
+func (x *StringList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseRequest) ProtoMessage() {}
+func (*StringList) ProtoMessage() {}
 
-func (x *DatabaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[44]
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2893,78 +5504,40 @@ func (x *DatabaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseRequest.ProtoReflect.Descriptor instead.
-func (*DatabaseRequest) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *DatabaseRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *DatabaseRequest) GetField() string {
-	if x != nil {
-		return x.Field
-	}
-	return ""
-}
-
-func (x *DatabaseRequest) GetValue() *anypb.Any {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *DatabaseRequest) GetWriteTime() *Timestamp {
-	if x != nil {
-		return x.WriteTime
-	}
-	return nil
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *DatabaseRequest) GetWriterId() *String {
+func (x *StringList) GetRaw() []string {
 	if x != nil {
-		return x.WriterId
+		return x.Raw
 	}
 	return nil
 }
 
-func (x *DatabaseRequest) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type DatabaseSnapshot struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Entities      []*DatabaseEntity       `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
-	Fields        []*DatabaseField        `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
-	EntitySchemas []*DatabaseEntitySchema `protobuf:"bytes,3,rep,name=entitySchemas,proto3" json:"entitySchemas,omitempty"`
-	FieldSchemas  []*DatabaseFieldSchema  `protobuf:"bytes,4,rep,name=fieldSchemas,proto3" json:"fieldSchemas,omitempty"`
+type EntityReferenceList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raw           []string               `protobuf:"bytes,1,rep,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseSnapshot) Reset() {
-	*x = DatabaseSnapshot{}
-	mi := &file_src_protobufs_proto_msgTypes[45]
+func (x *EntityReferenceList) Reset() {
+	*x = EntityReferenceList{}
+	mi := &file_src_protobufs_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseSnapshot) String() string {
+func (x *EntityReferenceList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseSnapshot) ProtoMessage() {}
+func (*EntityReferenceList) ProtoMessage() {}
 
-func (x *DatabaseSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[45]
+func (x *EntityReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2975,61 +5548,40 @@ func (x *DatabaseSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseSnapshot.ProtoReflect.Descriptor instead.
-func (*DatabaseSnapshot) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *DatabaseSnapshot) GetEntities() []*DatabaseEntity {
-	if x != nil {
-		return x.Entities
-	}
-	return nil
-}
-
-func (x *DatabaseSnapshot) GetFields() []*DatabaseField {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-func (x *DatabaseSnapshot) GetEntitySchemas() []*DatabaseEntitySchema {
-	if x != nil {
-		return x.EntitySchemas
-	}
-	return nil
+// Deprecated: Use EntityReferenceList.ProtoReflect.Descriptor instead.
+func (*EntityReferenceList) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *DatabaseSnapshot) GetFieldSchemas() []*DatabaseFieldSchema {
+func (x *EntityReferenceList) GetRaw() []string {
 	if x != nil {
-		return x.FieldSchemas
+		return x.Raw
 	}
 	return nil
 }
 
-type Int struct {
+type StringMap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           int64                  `protobuf:"varint,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	Raw           map[string]string      `protobuf:"bytes,1,rep,name=raw,proto3" json:"raw,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Int) Reset() {
-	*x = Int{}
-	mi := &file_src_protobufs_proto_msgTypes[46]
+func (x *StringMap) Reset() {
+	*x = StringMap{}
+	mi := &file_src_protobufs_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Int) String() string {
+func (x *StringMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Int) ProtoMessage() {}
+func (*StringMap) ProtoMessage() {}
 
-func (x *Int) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[46]
+func (x *StringMap) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3040,40 +5592,40 @@ func (x *Int) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Int.ProtoReflect.Descriptor instead.
-func (*Int) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use StringMap.ProtoReflect.Descriptor instead.
+func (*StringMap) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *Int) GetRaw() int64 {
+func (x *StringMap) GetRaw() map[string]string {
 	if x != nil {
 		return x.Raw
 	}
-	return 0
+	return nil
 }
 
-type String struct {
+type Enum struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	Raw           int64                  `protobuf:"varint,1,opt,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *String) Reset() {
-	*x = String{}
-	mi := &file_src_protobufs_proto_msgTypes[47]
+func (x *Enum) Reset() {
+	*x = Enum{}
+	mi := &file_src_protobufs_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *String) String() string {
+func (x *Enum) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*String) ProtoMessage() {}
+func (*Enum) ProtoMessage() {}
 
-func (x *String) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[47]
+func (x *Enum) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3084,40 +5636,43 @@ func (x *String) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use String.ProtoReflect.Descriptor instead.
-func (*String) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use Enum.ProtoReflect.Descriptor instead.
+func (*Enum) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *String) GetRaw() string {
+func (x *Enum) GetRaw() int64 {
 	if x != nil {
 		return x.Raw
 	}
-	return ""
+	return 0
 }
 
-type Timestamp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+type LogMessage struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Application   string                  `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Level         LogMessage_LogLevelEnum `protobuf:"varint,2,opt,name=level,proto3,enum=qdb.LogMessage_LogLevelEnum" json:"level,omitempty"`
+	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Timestamp) Reset() {
-	*x = Timestamp{}
-	mi := &file_src_protobufs_proto_msgTypes[48]
+func (x *LogMessage) Reset() {
+	*x = LogMessage{}
+	mi := &file_src_protobufs_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Timestamp) String() string {
+func (x *LogMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Timestamp) ProtoMessage() {}
+func (*LogMessage) ProtoMessage() {}
 
-func (x *Timestamp) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[48]
+func (x *LogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3128,40 +5683,61 @@ func (x *Timestamp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Timestamp.ProtoReflect.Descriptor instead.
-func (*Timestamp) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
+func (*LogMessage) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *Timestamp) GetRaw() *timestamppb.Timestamp {
+func (x *LogMessage) GetApplication() string {
 	if x != nil {
-		return x.Raw
+		return x.Application
 	}
-	return nil
+	return ""
 }
 
-type Float struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           float64                `protobuf:"fixed64,1,opt,name=raw,proto3" json:"raw,omitempty"`
+func (x *LogMessage) GetLevel() LogMessage_LogLevelEnum {
+	if x != nil {
+		return x.Level
+	}
+	return LogMessage_UNSPECIFIED
+}
+
+func (x *LogMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogMessage) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ConnectionState struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Raw           ConnectionState_ConnectionStateEnum `protobuf:"varint,1,opt,name=raw,proto3,enum=qdb.ConnectionState_ConnectionStateEnum" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Float) Reset() {
-	*x = Float{}
-	mi := &file_src_protobufs_proto_msgTypes[49]
+func (x *ConnectionState) Reset() {
+	*x = ConnectionState{}
+	mi := &file_src_protobufs_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Float) String() string {
+func (x *ConnectionState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Float) ProtoMessage() {}
+func (*ConnectionState) ProtoMessage() {}
 
-func (x *Float) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[49]
+func (x *ConnectionState) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3172,40 +5748,43 @@ func (x *Float) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Float.ProtoReflect.Descriptor instead.
-func (*Float) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ConnectionState.ProtoReflect.Descriptor instead.
+func (*ConnectionState) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *Float) GetRaw() float64 {
+func (x *ConnectionState) GetRaw() ConnectionState_ConnectionStateEnum {
 	if x != nil {
 		return x.Raw
 	}
-	return 0
+	return ConnectionState_UNSPECIFIED
 }
 
-type Bool struct {
+type DatabaseSnapshotDiff_EntityChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           bool                   `protobuf:"varint,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	Old           *DatabaseEntity        `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New           *DatabaseEntity        `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	OldPath       string                 `protobuf:"bytes,3,opt,name=oldPath,proto3" json:"oldPath,omitempty"`
+	NewPath       string                 `protobuf:"bytes,4,opt,name=newPath,proto3" json:"newPath,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Bool) Reset() {
-	*x = Bool{}
-	mi := &file_src_protobufs_proto_msgTypes[50]
+func (x *DatabaseSnapshotDiff_EntityChange) Reset() {
+	*x = DatabaseSnapshotDiff_EntityChange{}
+	mi := &file_src_protobufs_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Bool) String() string {
+func (x *DatabaseSnapshotDiff_EntityChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bool) ProtoMessage() {}
+func (*DatabaseSnapshotDiff_EntityChange) ProtoMessage() {}
 
-func (x *Bool) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[50]
+func (x *DatabaseSnapshotDiff_EntityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3216,40 +5795,66 @@ func (x *Bool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bool.ProtoReflect.Descriptor instead.
-func (*Bool) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use DatabaseSnapshotDiff_EntityChange.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotDiff_EntityChange) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{53, 0}
 }
 
-func (x *Bool) GetRaw() bool {
+func (x *DatabaseSnapshotDiff_EntityChange) GetOld() *DatabaseEntity {
 	if x != nil {
-		return x.Raw
+		return x.Old
 	}
-	return false
+	return nil
 }
 
-type EntityReference struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+func (x *DatabaseSnapshotDiff_EntityChange) GetNew() *DatabaseEntity {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff_EntityChange) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *DatabaseSnapshotDiff_EntityChange) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+type DatabaseSnapshotDiff_FieldChange struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntityId string                 `protobuf:"bytes,1,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Path     string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Field    string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// Unset when the entity has no such field on that side, as for the fields of added entities
+	OldValue      *anypb.Any `protobuf:"bytes,4,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	NewValue      *anypb.Any `protobuf:"bytes,5,opt,name=newValue,proto3" json:"newValue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityReference) Reset() {
-	*x = EntityReference{}
-	mi := &file_src_protobufs_proto_msgTypes[51]
+func (x *DatabaseSnapshotDiff_FieldChange) Reset() {
+	*x = DatabaseSnapshotDiff_FieldChange{}
+	mi := &file_src_protobufs_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityReference) String() string {
+func (x *DatabaseSnapshotDiff_FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityReference) ProtoMessage() {}
+func (*DatabaseSnapshotDiff_FieldChange) ProtoMessage() {}
 
-func (x *EntityReference) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[51]
+func (x *DatabaseSnapshotDiff_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3260,40 +5865,70 @@ func (x *EntityReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityReference.ProtoReflect.Descriptor instead.
-func (*EntityReference) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use DatabaseSnapshotDiff_FieldChange.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotDiff_FieldChange) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{53, 1}
 }
 
-func (x *EntityReference) GetRaw() string {
+func (x *DatabaseSnapshotDiff_FieldChange) GetEntityId() string {
 	if x != nil {
-		return x.Raw
+		return x.EntityId
 	}
 	return ""
 }
 
-type BinaryFile struct {
+func (x *DatabaseSnapshotDiff_FieldChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DatabaseSnapshotDiff_FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DatabaseSnapshotDiff_FieldChange) GetOldValue() *anypb.Any {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *DatabaseSnapshotDiff_FieldChange) GetNewValue() *anypb.Any {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+// Unset on the side where the schema does not exist
+type DatabaseSnapshotDiff_EntitySchemaChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	Old           *DatabaseEntitySchema  `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New           *DatabaseEntitySchema  `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BinaryFile) Reset() {
-	*x = BinaryFile{}
-	mi := &file_src_protobufs_proto_msgTypes[52]
+func (x *DatabaseSnapshotDiff_EntitySchemaChange) Reset() {
+	*x = DatabaseSnapshotDiff_EntitySchemaChange{}
+	mi := &file_src_protobufs_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinaryFile) String() string {
+func (x *DatabaseSnapshotDiff_EntitySchemaChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryFile) ProtoMessage() {}
+func (*DatabaseSnapshotDiff_EntitySchemaChange) ProtoMessage() {}
 
-func (x *BinaryFile) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[52]
+func (x *DatabaseSnapshotDiff_EntitySchemaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3304,40 +5939,48 @@ func (x *BinaryFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryFile.ProtoReflect.Descriptor instead.
-func (*BinaryFile) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use DatabaseSnapshotDiff_EntitySchemaChange.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotDiff_EntitySchemaChange) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{53, 2}
 }
 
-func (x *BinaryFile) GetRaw() string {
+func (x *DatabaseSnapshotDiff_EntitySchemaChange) GetOld() *DatabaseEntitySchema {
 	if x != nil {
-		return x.Raw
+		return x.Old
 	}
-	return ""
+	return nil
 }
 
-type Transformation struct {
+func (x *DatabaseSnapshotDiff_EntitySchemaChange) GetNew() *DatabaseEntitySchema {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+type DatabaseSnapshotDiff_FieldSchemaChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	Old           *DatabaseFieldSchema   `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New           *DatabaseFieldSchema   `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transformation) Reset() {
-	*x = Transformation{}
-	mi := &file_src_protobufs_proto_msgTypes[53]
+func (x *DatabaseSnapshotDiff_FieldSchemaChange) Reset() {
+	*x = DatabaseSnapshotDiff_FieldSchemaChange{}
+	mi := &file_src_protobufs_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transformation) String() string {
+func (x *DatabaseSnapshotDiff_FieldSchemaChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transformation) ProtoMessage() {}
+func (*DatabaseSnapshotDiff_FieldSchemaChange) ProtoMessage() {}
 
-func (x *Transformation) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[53]
+func (x *DatabaseSnapshotDiff_FieldSchemaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3348,43 +5991,47 @@ func (x *Transformation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transformation.ProtoReflect.Descriptor instead.
-func (*Transformation) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use DatabaseSnapshotDiff_FieldSchemaChange.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotDiff_FieldSchemaChange) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{53, 3}
 }
 
-func (x *Transformation) GetRaw() string {
+func (x *DatabaseSnapshotDiff_FieldSchemaChange) GetOld() *DatabaseFieldSchema {
 	if x != nil {
-		return x.Raw
+		return x.Old
 	}
-	return ""
+	return nil
 }
 
-type LogMessage struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Application   string                  `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
-	Level         LogMessage_LogLevelEnum `protobuf:"varint,2,opt,name=level,proto3,enum=qdb.LogMessage_LogLevelEnum" json:"level,omitempty"`
-	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (x *DatabaseSnapshotDiff_FieldSchemaChange) GetNew() *DatabaseFieldSchema {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+type DatabaseSnapshotRecord_Header struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityCount   int64                  `protobuf:"varint,1,opt,name=entityCount,proto3" json:"entityCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogMessage) Reset() {
-	*x = LogMessage{}
-	mi := &file_src_protobufs_proto_msgTypes[54]
+func (x *DatabaseSnapshotRecord_Header) Reset() {
+	*x = DatabaseSnapshotRecord_Header{}
+	mi := &file_src_protobufs_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogMessage) String() string {
+func (x *DatabaseSnapshotRecord_Header) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogMessage) ProtoMessage() {}
+func (*DatabaseSnapshotRecord_Header) ProtoMessage() {}
 
-func (x *LogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[54]
+func (x *DatabaseSnapshotRecord_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3395,61 +6042,42 @@ func (x *LogMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
-func (*LogMessage) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *LogMessage) GetApplication() string {
-	if x != nil {
-		return x.Application
-	}
-	return ""
-}
-
-func (x *LogMessage) GetLevel() LogMessage_LogLevelEnum {
-	if x != nil {
-		return x.Level
-	}
-	return LogMessage_UNSPECIFIED
-}
-
-func (x *LogMessage) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use DatabaseSnapshotRecord_Header.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotRecord_Header) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{66, 0}
 }
 
-func (x *LogMessage) GetTimestamp() *timestamppb.Timestamp {
+func (x *DatabaseSnapshotRecord_Header) GetEntityCount() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.EntityCount
 	}
-	return nil
+	return 0
 }
 
-type ConnectionState struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	Raw           ConnectionState_ConnectionStateEnum `protobuf:"varint,1,opt,name=raw,proto3,enum=qdb.ConnectionState_ConnectionStateEnum" json:"raw,omitempty"`
+type DatabaseSnapshotRecord_Trailer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityCount   int64                  `protobuf:"varint,1,opt,name=entityCount,proto3" json:"entityCount,omitempty"`
+	FieldCount    int64                  `protobuf:"varint,2,opt,name=fieldCount,proto3" json:"fieldCount,omitempty"`
+	Checksum      string                 `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"` // Hex SHA-256 of the records before the trailer
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectionState) Reset() {
-	*x = ConnectionState{}
-	mi := &file_src_protobufs_proto_msgTypes[55]
+func (x *DatabaseSnapshotRecord_Trailer) Reset() {
+	*x = DatabaseSnapshotRecord_Trailer{}
+	mi := &file_src_protobufs_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionState) String() string {
+func (x *DatabaseSnapshotRecord_Trailer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionState) ProtoMessage() {}
+func (*DatabaseSnapshotRecord_Trailer) ProtoMessage() {}
 
-func (x *ConnectionState) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protobufs_proto_msgTypes[55]
+func (x *DatabaseSnapshotRecord_Trailer) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protobufs_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3460,16 +6088,30 @@ func (x *ConnectionState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionState.ProtoReflect.Descriptor instead.
-func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return file_src_protobufs_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DatabaseSnapshotRecord_Trailer.ProtoReflect.Descriptor instead.
+func (*DatabaseSnapshotRecord_Trailer) Descriptor() ([]byte, []int) {
+	return file_src_protobufs_proto_rawDescGZIP(), []int{66, 1}
 }
 
-func (x *ConnectionState) GetRaw() ConnectionState_ConnectionStateEnum {
+func (x *DatabaseSnapshotRecord_Trailer) GetEntityCount() int64 {
 	if x != nil {
-		return x.Raw
+		return x.EntityCount
 	}
-	return ConnectionState_UNSPECIFIED
+	return 0
+}
+
+func (x *DatabaseSnapshotRecord_Trailer) GetFieldCount() int64 {
+	if x != nil {
+		return x.FieldCount
+	}
+	return 0
+}
+
+func (x *DatabaseSnapshotRecord_Trailer) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
 }
 
 var File_src_protobufs_proto protoreflect.FileDescriptor
@@ -3533,20 +6175,42 @@ var file_src_protobufs_proto_rawDesc = []byte{
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
 	0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
 	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x20,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x54,
 	0x0a, 0x1e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e,
 	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x37, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x57, 0x65, 0x62,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x57, 0x65, 0x62, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x57, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x2b, 0x0a,
+	0x19, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x57,
+	0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x71, 0x64, 0x62, 0x2e,
+	0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x10, 0x02, 0x22, 0x35, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xd2, 0x01, 0x0a, 0x20, 0x57,
+	0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x30, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75,
 	0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x74,
 	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e,
@@ -3555,315 +6219,681 @@ var file_src_protobufs_proto_rawDesc = []byte{
 	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
 	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
 	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22,
-	0x36, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x71, 0x64,
-	0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22,
-	0x68, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x1f, 0x57, 0x65,
-	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e,
-	0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22,
-	0x35, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x20, 0x57, 0x65, 0x62, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x71, 0x64,
-	0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45,
-	0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
-	0x02, 0x22, 0x4d, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
-	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x22, 0xa5, 0x01, 0x0a, 0x20, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
-	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x2f, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xb5, 0x01, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
 	0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a,
 	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b,
 	0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46,
-	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x20, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x1f, 0x57,
-	0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x9e, 0x02, 0x0a, 0x1e, 0x57, 0x65, 0x62,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x74, 0x68, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0d, 0x0a, 0x09, 0x41,
+	0x4e, 0x43, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45,
+	0x53, 0x43, 0x45, 0x4e, 0x44, 0x41, 0x4e, 0x54, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53,
+	0x49, 0x42, 0x4c, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x02, 0x22, 0xd4, 0x01, 0x0a, 0x1f, 0x57, 0x65,
+	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02,
+	0x22, 0x36, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x1f, 0x57, 0x65, 0x62,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x71,
+	0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02,
+	0x22, 0x68, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x1f, 0x57,
+	0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f,
-	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x64, 0x62, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
-	0x45, 0x10, 0x02, 0x22, 0x54, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
-	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x20, 0x57, 0x65,
-	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30,
-	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02,
+	0x22, 0x35, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x20, 0x57, 0x65, 0x62, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x71,
+	0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x10, 0x02, 0x22, 0x4d, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0xa5, 0x01, 0x0a, 0x20, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x20, 0x0a, 0x1e, 0x57, 0x65, 0x62,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x1f,
+	0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2f, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x37, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x02, 0x22, 0x88, 0x02, 0x0a, 0x1f, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x75, 0x70, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a,
+	0x17, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17,
+	0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0xe2, 0x01, 0x0a, 0x20, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b,
+	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x37, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x02, 0x22, 0x70, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x44, 0x69, 0x66, 0x66, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x27, 0x0a,
+	0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0xe2, 0x01, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57,
+	0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2d, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x19, 0x0a, 0x17, 0x57,
+	0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1c, 0x57, 0x65,
+	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x57,
+	0x0a, 0x1d, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x19, 0x57, 0x65, 0x62, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64, 0x62, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x0f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x10, 0x02, 0x22, 0x4e, 0x0a, 0x1a, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x64, 0x0a, 0x25, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x26, 0x57, 0x65, 0x62, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x57, 0x65,
+	0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x65, 0x0a, 0x22, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71,
+	0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x27, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x28, 0x57, 0x65,
+	0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d,
 	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
 	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45,
 	0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
-	0x02, 0x22, 0x19, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x18,
-	0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x74,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64,
-	0x22, 0x1e, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x37, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x19, 0x57, 0x65,
-	0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x71,
-	0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64,
-	0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x0f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f,
+	0x02, 0x22, 0x2e, 0x0a, 0x2c, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5d, 0x0a, 0x2d, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x72, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x70, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x2e, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x77, 0x52, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e,
+	0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x2f, 0x0a,
+	0x1d, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd7,
+	0x01, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2e, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71,
+	0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x22,
+	0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22,
+	0xa8, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0xa0, 0x03, 0x0a, 0x1c, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70,
+	0x72, 0x75, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0d,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x8e, 0x09,
+	0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x4c, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x71, 0x64,
+	0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x5e, 0x0a, 0x13, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x13, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x5b, 0x0a, 0x12, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x12, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x54,
+	0x0a, 0x11, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x71, 0x64, 0x62, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x11, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x1a, 0x90, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x03,
+	0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x03,
+	0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x1a, 0xb7, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a,
+	0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x30, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0x6e, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x03, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x03, 0x6e, 0x65,
+	0x77, 0x1a, 0x6b, 0x0a, 0x11, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x03, 0x6f,
+	0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0xfb,
+	0x01, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x08,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x22, 0x4d, 0x0a,
+	0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x22, 0xd1, 0x01, 0x0a,
+	0x0d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x22, 0xc2, 0x01, 0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x42, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0xce, 0x04, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x0b, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x38, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x71,
+	0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x75, 0x6d,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x48, 0x0a, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x71, 0x64, 0x62, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x22, 0x32, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f,
 	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49,
-	0x54, 0x45, 0x10, 0x02, 0x22, 0x4e, 0x0a, 0x1a, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x25, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x26, 0x57, 0x65,
-	0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x23, 0x0a, 0x21,
-	0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x65, 0x0a, 0x22, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x47,
-	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x27, 0x57, 0x65, 0x62, 0x52,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x28,
-	0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x57,
-	0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e,
-	0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
-	0x45, 0x10, 0x02, 0x22, 0x2e, 0x0a, 0x2c, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x2d, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x3e, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0x50, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a,
-	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22,
-	0xb5, 0x01, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x08, 0x0a, 0x04, 0x48, 0x41, 0x53, 0x48, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x3e, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x45, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x41, 0x53, 0x43,
+	0x41, 0x44, 0x45, 0x10, 0x02, 0x22, 0x3d, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4b, 0x0a, 0x09, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x4f, 0x57, 0x5f,
+	0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x49, 0x47, 0x48,
+	0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x04, 0x22, 0xd1, 0x01, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x49, 0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
+	0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x0e,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x22, 0xd4, 0x01, 0x0a, 0x0f,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x38, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x4f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xb8, 0x01, 0x0a,
-	0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71,
-	0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64,
-	0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
-	0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x3d, 0x0a, 0x13, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0f, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x2c, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27,
-	0x0a, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x64, 0x62,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62,
+	0x65, 0x12, 0x2c, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x27, 0x0a, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xee,
+	0x01, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x22,
+	0xfa, 0x03, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x71, 0x64, 0x62,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71,
+	0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3a, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71, 0x64, 0x62,
 	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x52, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x73, 0x22, 0x17, 0x0a, 0x03, 0x49, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x1a, 0x0a, 0x06, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x39, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x72,
-	0x61, 0x77, 0x22, 0x19, 0x0a, 0x05, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72,
-	0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x18, 0x0a,
-	0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x23, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61,
-	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x1e, 0x0a, 0x0a,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61,
-	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x22, 0x0a, 0x0e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
-	0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77,
-	0x22, 0x97, 0x02, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1c, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5f, 0x0a, 0x0c, 0x4c, 0x6f, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52,
-	0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x02,
-	0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41,
-	0x52, 0x4e, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12,
-	0x09, 0x0a, 0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x06, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a,
-	0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x71, 0x64,
-	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x47, 0x0a, 0x13, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x75,
-	0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x42, 0x09, 0x5a, 0x07, 0x71, 0x64, 0x62, 0x2f, 0x71, 0x64, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x71, 0x64, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x71, 0x64, 0x62, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x1a, 0x2a, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x67, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x17, 0x0a, 0x03,
+	0x49, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x1a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61,
+	0x77, 0x22, 0x39, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c,
+	0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x19, 0x0a, 0x05,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x18, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x61,
+	0x77, 0x22, 0x23, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x62, 0x0a, 0x0a, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x22, 0x0a, 0x0e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x1b,
+	0x0a, 0x07, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x1e, 0x0a, 0x0a, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x27, 0x0a, 0x13, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x61, 0x77, 0x22, 0x6e, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
+	0x70, 0x12, 0x29, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x72, 0x61, 0x77, 0x1a, 0x36, 0x0a, 0x08,
+	0x52, 0x61, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x18, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x97,
+	0x02, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x71, 0x64, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5f, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e,
+	0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x09, 0x0a,
+	0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x06, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x03,
+	0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x71, 0x64, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x75, 0x6d, 0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x47, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x42, 0x09, 0x5a, 0x07, 0x71, 0x64, 0x62, 0x2f, 0x71, 0x64, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3878,133 +6908,227 @@ func file_src_protobufs_proto_rawDescGZIP() []byte {
 	return file_src_protobufs_proto_rawDescData
 }
 
-var file_src_protobufs_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
-var file_src_protobufs_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_src_protobufs_proto_enumTypes = make([]protoimpl.EnumInfo, 26)
+var file_src_protobufs_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_src_protobufs_proto_goTypes = []any{
 	(WebHeader_AuthenticationStatusEnum)(0),                  // 0: qdb.WebHeader.AuthenticationStatusEnum
 	(WebConfigCreateEntityResponse_StatusEnum)(0),            // 1: qdb.WebConfigCreateEntityResponse.StatusEnum
 	(WebConfigDeleteEntityResponse_StatusEnum)(0),            // 2: qdb.WebConfigDeleteEntityResponse.StatusEnum
 	(WebConfigGetEntityResponse_StatusEnum)(0),               // 3: qdb.WebConfigGetEntityResponse.StatusEnum
-	(WebConfigGetFieldSchemaResponse_StatusEnum)(0),          // 4: qdb.WebConfigGetFieldSchemaResponse.StatusEnum
-	(WebConfigSetFieldSchemaResponse_StatusEnum)(0),          // 5: qdb.WebConfigSetFieldSchemaResponse.StatusEnum
-	(WebConfigGetEntitySchemaResponse_StatusEnum)(0),         // 6: qdb.WebConfigGetEntitySchemaResponse.StatusEnum
-	(WebConfigSetEntitySchemaResponse_StatusEnum)(0),         // 7: qdb.WebConfigSetEntitySchemaResponse.StatusEnum
-	(WebConfigCreateSnapshotResponse_StatusEnum)(0),          // 8: qdb.WebConfigCreateSnapshotResponse.StatusEnum
-	(WebConfigRestoreSnapshotResponse_StatusEnum)(0),         // 9: qdb.WebConfigRestoreSnapshotResponse.StatusEnum
-	(WebRuntimeDatabaseRequest_RequestTypeEnum)(0),           // 10: qdb.WebRuntimeDatabaseRequest.RequestTypeEnum
-	(WebRuntimeUnregisterNotificationResponse_StatusEnum)(0), // 11: qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum
-	(LogMessage_LogLevelEnum)(0),                             // 12: qdb.LogMessage.LogLevelEnum
-	(ConnectionState_ConnectionStateEnum)(0),                 // 13: qdb.ConnectionState.ConnectionStateEnum
-	(*WebHeader)(nil),                                        // 14: qdb.WebHeader
-	(*WebMessage)(nil),                                       // 15: qdb.WebMessage
-	(*WebConfigCreateEntityRequest)(nil),                     // 16: qdb.WebConfigCreateEntityRequest
-	(*WebConfigCreateEntityResponse)(nil),                    // 17: qdb.WebConfigCreateEntityResponse
-	(*WebConfigDeleteEntityRequest)(nil),                     // 18: qdb.WebConfigDeleteEntityRequest
-	(*WebConfigDeleteEntityResponse)(nil),                    // 19: qdb.WebConfigDeleteEntityResponse
-	(*WebConfigGetEntityTypesRequest)(nil),                   // 20: qdb.WebConfigGetEntityTypesRequest
-	(*WebConfigGetEntityTypesResponse)(nil),                  // 21: qdb.WebConfigGetEntityTypesResponse
-	(*WebConfigGetEntityRequest)(nil),                        // 22: qdb.WebConfigGetEntityRequest
-	(*WebConfigGetEntityResponse)(nil),                       // 23: qdb.WebConfigGetEntityResponse
-	(*WebConfigGetFieldSchemaRequest)(nil),                   // 24: qdb.WebConfigGetFieldSchemaRequest
-	(*WebConfigGetFieldSchemaResponse)(nil),                  // 25: qdb.WebConfigGetFieldSchemaResponse
-	(*WebConfigSetFieldSchemaRequest)(nil),                   // 26: qdb.WebConfigSetFieldSchemaRequest
-	(*WebConfigSetFieldSchemaResponse)(nil),                  // 27: qdb.WebConfigSetFieldSchemaResponse
-	(*WebConfigGetEntitySchemaRequest)(nil),                  // 28: qdb.WebConfigGetEntitySchemaRequest
-	(*WebConfigGetEntitySchemaResponse)(nil),                 // 29: qdb.WebConfigGetEntitySchemaResponse
-	(*WebConfigSetEntitySchemaRequest)(nil),                  // 30: qdb.WebConfigSetEntitySchemaRequest
-	(*WebConfigSetEntitySchemaResponse)(nil),                 // 31: qdb.WebConfigSetEntitySchemaResponse
-	(*WebConfigCreateSnapshotRequest)(nil),                   // 32: qdb.WebConfigCreateSnapshotRequest
-	(*WebConfigCreateSnapshotResponse)(nil),                  // 33: qdb.WebConfigCreateSnapshotResponse
-	(*WebConfigRestoreSnapshotRequest)(nil),                  // 34: qdb.WebConfigRestoreSnapshotRequest
-	(*WebConfigRestoreSnapshotResponse)(nil),                 // 35: qdb.WebConfigRestoreSnapshotResponse
-	(*WebConfigGetRootRequest)(nil),                          // 36: qdb.WebConfigGetRootRequest
-	(*WebConfigGetRootResponse)(nil),                         // 37: qdb.WebConfigGetRootResponse
-	(*WebConfigGetAllFieldsRequest)(nil),                     // 38: qdb.WebConfigGetAllFieldsRequest
-	(*WebConfigGetAllFieldsResponse)(nil),                    // 39: qdb.WebConfigGetAllFieldsResponse
-	(*WebRuntimeDatabaseRequest)(nil),                        // 40: qdb.WebRuntimeDatabaseRequest
-	(*WebRuntimeDatabaseResponse)(nil),                       // 41: qdb.WebRuntimeDatabaseResponse
-	(*WebRuntimeRegisterNotificationRequest)(nil),            // 42: qdb.WebRuntimeRegisterNotificationRequest
-	(*WebRuntimeRegisterNotificationResponse)(nil),           // 43: qdb.WebRuntimeRegisterNotificationResponse
-	(*WebRuntimeGetNotificationsRequest)(nil),                // 44: qdb.WebRuntimeGetNotificationsRequest
-	(*WebRuntimeGetNotificationsResponse)(nil),               // 45: qdb.WebRuntimeGetNotificationsResponse
-	(*WebRuntimeUnregisterNotificationRequest)(nil),          // 46: qdb.WebRuntimeUnregisterNotificationRequest
-	(*WebRuntimeUnregisterNotificationResponse)(nil),         // 47: qdb.WebRuntimeUnregisterNotificationResponse
-	(*WebRuntimeGetDatabaseConnectionStatusRequest)(nil),     // 48: qdb.WebRuntimeGetDatabaseConnectionStatusRequest
-	(*WebRuntimeGetDatabaseConnectionStatusResponse)(nil),    // 49: qdb.WebRuntimeGetDatabaseConnectionStatusResponse
-	(*WebRuntimeGetEntitiesRequest)(nil),                     // 50: qdb.WebRuntimeGetEntitiesRequest
-	(*WebRuntimeGetEntitiesResponse)(nil),                    // 51: qdb.WebRuntimeGetEntitiesResponse
-	(*DatabaseEntity)(nil),                                   // 52: qdb.DatabaseEntity
-	(*DatabaseField)(nil),                                    // 53: qdb.DatabaseField
-	(*DatabaseNotificationConfig)(nil),                       // 54: qdb.DatabaseNotificationConfig
-	(*DatabaseNotification)(nil),                             // 55: qdb.DatabaseNotification
-	(*DatabaseEntitySchema)(nil),                             // 56: qdb.DatabaseEntitySchema
-	(*DatabaseFieldSchema)(nil),                              // 57: qdb.DatabaseFieldSchema
-	(*DatabaseRequest)(nil),                                  // 58: qdb.DatabaseRequest
-	(*DatabaseSnapshot)(nil),                                 // 59: qdb.DatabaseSnapshot
-	(*Int)(nil),                                              // 60: qdb.Int
-	(*String)(nil),                                           // 61: qdb.String
-	(*Timestamp)(nil),                                        // 62: qdb.Timestamp
-	(*Float)(nil),                                            // 63: qdb.Float
-	(*Bool)(nil),                                             // 64: qdb.Bool
-	(*EntityReference)(nil),                                  // 65: qdb.EntityReference
-	(*BinaryFile)(nil),                                       // 66: qdb.BinaryFile
-	(*Transformation)(nil),                                   // 67: qdb.Transformation
-	(*LogMessage)(nil),                                       // 68: qdb.LogMessage
-	(*ConnectionState)(nil),                                  // 69: qdb.ConnectionState
-	(*timestamppb.Timestamp)(nil),                            // 70: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                                        // 71: google.protobuf.Any
+	(WebConfigGetEntityByPathResponse_StatusEnum)(0),         // 4: qdb.WebConfigGetEntityByPathResponse.StatusEnum
+	(WebConfigGetEntityPathResponse_StatusEnum)(0),           // 5: qdb.WebConfigGetEntityPathResponse.StatusEnum
+	(WebConfigTraverseEntityRequest_DirectionEnum)(0),        // 6: qdb.WebConfigTraverseEntityRequest.DirectionEnum
+	(WebConfigTraverseEntityResponse_StatusEnum)(0),          // 7: qdb.WebConfigTraverseEntityResponse.StatusEnum
+	(WebConfigGetFieldSchemaResponse_StatusEnum)(0),          // 8: qdb.WebConfigGetFieldSchemaResponse.StatusEnum
+	(WebConfigSetFieldSchemaResponse_StatusEnum)(0),          // 9: qdb.WebConfigSetFieldSchemaResponse.StatusEnum
+	(WebConfigGetEntitySchemaResponse_StatusEnum)(0),         // 10: qdb.WebConfigGetEntitySchemaResponse.StatusEnum
+	(WebConfigSetEntitySchemaResponse_StatusEnum)(0),         // 11: qdb.WebConfigSetEntitySchemaResponse.StatusEnum
+	(WebConfigCreateSnapshotResponse_StatusEnum)(0),          // 12: qdb.WebConfigCreateSnapshotResponse.StatusEnum
+	(WebConfigRestoreSnapshotResponse_StatusEnum)(0),         // 13: qdb.WebConfigRestoreSnapshotResponse.StatusEnum
+	(WebConfigDiffSnapshotResponse_StatusEnum)(0),            // 14: qdb.WebConfigDiffSnapshotResponse.StatusEnum
+	(WebRuntimeDatabaseRequest_RequestTypeEnum)(0),           // 15: qdb.WebRuntimeDatabaseRequest.RequestTypeEnum
+	(WebRuntimeUnregisterNotificationResponse_StatusEnum)(0), // 16: qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum
+	(WebRuntimeQueryResponse_StatusEnum)(0),                  // 17: qdb.WebRuntimeQueryResponse.StatusEnum
+	(WebRuntimeGetReferrersResponse_StatusEnum)(0),           // 18: qdb.WebRuntimeGetReferrersResponse.StatusEnum
+	(DatabaseEntityEvent_TypeEnum)(0),                        // 19: qdb.DatabaseEntityEvent.TypeEnum
+	(DatabaseFieldSchema_IndexEnum)(0),                       // 20: qdb.DatabaseFieldSchema.IndexEnum
+	(DatabaseFieldSchema_ReferencePolicyEnum)(0),             // 21: qdb.DatabaseFieldSchema.ReferencePolicyEnum
+	(DatabaseAlarmLimit_LevelEnum)(0),                        // 22: qdb.DatabaseAlarmLimit.LevelEnum
+	(DatabaseComputedField_EvaluationEnum)(0),                // 23: qdb.DatabaseComputedField.EvaluationEnum
+	(LogMessage_LogLevelEnum)(0),                             // 24: qdb.LogMessage.LogLevelEnum
+	(ConnectionState_ConnectionStateEnum)(0),                 // 25: qdb.ConnectionState.ConnectionStateEnum
+	(*WebHeader)(nil),                                        // 26: qdb.WebHeader
+	(*WebMessage)(nil),                                       // 27: qdb.WebMessage
+	(*WebConfigCreateEntityRequest)(nil),                     // 28: qdb.WebConfigCreateEntityRequest
+	(*WebConfigCreateEntityResponse)(nil),                    // 29: qdb.WebConfigCreateEntityResponse
+	(*WebConfigDeleteEntityRequest)(nil),                     // 30: qdb.WebConfigDeleteEntityRequest
+	(*WebConfigDeleteEntityResponse)(nil),                    // 31: qdb.WebConfigDeleteEntityResponse
+	(*WebConfigGetEntityTypesRequest)(nil),                   // 32: qdb.WebConfigGetEntityTypesRequest
+	(*WebConfigGetEntityTypesResponse)(nil),                  // 33: qdb.WebConfigGetEntityTypesResponse
+	(*WebConfigGetEntityRequest)(nil),                        // 34: qdb.WebConfigGetEntityRequest
+	(*WebConfigGetEntityResponse)(nil),                       // 35: qdb.WebConfigGetEntityResponse
+	(*WebConfigGetEntityByPathRequest)(nil),                  // 36: qdb.WebConfigGetEntityByPathRequest
+	(*WebConfigGetEntityByPathResponse)(nil),                 // 37: qdb.WebConfigGetEntityByPathResponse
+	(*WebConfigGetEntityPathRequest)(nil),                    // 38: qdb.WebConfigGetEntityPathRequest
+	(*WebConfigGetEntityPathResponse)(nil),                   // 39: qdb.WebConfigGetEntityPathResponse
+	(*WebConfigTraverseEntityRequest)(nil),                   // 40: qdb.WebConfigTraverseEntityRequest
+	(*WebConfigTraverseEntityResponse)(nil),                  // 41: qdb.WebConfigTraverseEntityResponse
+	(*WebConfigGetFieldSchemaRequest)(nil),                   // 42: qdb.WebConfigGetFieldSchemaRequest
+	(*WebConfigGetFieldSchemaResponse)(nil),                  // 43: qdb.WebConfigGetFieldSchemaResponse
+	(*WebConfigSetFieldSchemaRequest)(nil),                   // 44: qdb.WebConfigSetFieldSchemaRequest
+	(*WebConfigSetFieldSchemaResponse)(nil),                  // 45: qdb.WebConfigSetFieldSchemaResponse
+	(*WebConfigGetEntitySchemaRequest)(nil),                  // 46: qdb.WebConfigGetEntitySchemaRequest
+	(*WebConfigGetEntitySchemaResponse)(nil),                 // 47: qdb.WebConfigGetEntitySchemaResponse
+	(*WebConfigSetEntitySchemaRequest)(nil),                  // 48: qdb.WebConfigSetEntitySchemaRequest
+	(*WebConfigSetEntitySchemaResponse)(nil),                 // 49: qdb.WebConfigSetEntitySchemaResponse
+	(*WebConfigCreateSnapshotRequest)(nil),                   // 50: qdb.WebConfigCreateSnapshotRequest
+	(*WebConfigCreateSnapshotResponse)(nil),                  // 51: qdb.WebConfigCreateSnapshotResponse
+	(*WebConfigRestoreSnapshotRequest)(nil),                  // 52: qdb.WebConfigRestoreSnapshotRequest
+	(*WebConfigRestoreSnapshotResponse)(nil),                 // 53: qdb.WebConfigRestoreSnapshotResponse
+	(*WebConfigDiffSnapshotRequest)(nil),                     // 54: qdb.WebConfigDiffSnapshotRequest
+	(*WebConfigDiffSnapshotResponse)(nil),                    // 55: qdb.WebConfigDiffSnapshotResponse
+	(*WebConfigGetRootRequest)(nil),                          // 56: qdb.WebConfigGetRootRequest
+	(*WebConfigGetRootResponse)(nil),                         // 57: qdb.WebConfigGetRootResponse
+	(*WebConfigGetAllFieldsRequest)(nil),                     // 58: qdb.WebConfigGetAllFieldsRequest
+	(*WebConfigGetAllFieldsResponse)(nil),                    // 59: qdb.WebConfigGetAllFieldsResponse
+	(*WebRuntimeDatabaseRequest)(nil),                        // 60: qdb.WebRuntimeDatabaseRequest
+	(*WebRuntimeDatabaseResponse)(nil),                       // 61: qdb.WebRuntimeDatabaseResponse
+	(*WebRuntimeRegisterNotificationRequest)(nil),            // 62: qdb.WebRuntimeRegisterNotificationRequest
+	(*WebRuntimeRegisterNotificationResponse)(nil),           // 63: qdb.WebRuntimeRegisterNotificationResponse
+	(*WebRuntimeGetNotificationsRequest)(nil),                // 64: qdb.WebRuntimeGetNotificationsRequest
+	(*WebRuntimeGetNotificationsResponse)(nil),               // 65: qdb.WebRuntimeGetNotificationsResponse
+	(*WebRuntimeUnregisterNotificationRequest)(nil),          // 66: qdb.WebRuntimeUnregisterNotificationRequest
+	(*WebRuntimeUnregisterNotificationResponse)(nil),         // 67: qdb.WebRuntimeUnregisterNotificationResponse
+	(*WebRuntimeGetDatabaseConnectionStatusRequest)(nil),     // 68: qdb.WebRuntimeGetDatabaseConnectionStatusRequest
+	(*WebRuntimeGetDatabaseConnectionStatusResponse)(nil),    // 69: qdb.WebRuntimeGetDatabaseConnectionStatusResponse
+	(*WebRuntimeGetEntitiesRequest)(nil),                     // 70: qdb.WebRuntimeGetEntitiesRequest
+	(*WebRuntimeGetEntitiesResponse)(nil),                    // 71: qdb.WebRuntimeGetEntitiesResponse
+	(*WebRuntimeQueryRequest)(nil),                           // 72: qdb.WebRuntimeQueryRequest
+	(*WebRuntimeQueryResponse)(nil),                          // 73: qdb.WebRuntimeQueryResponse
+	(*WebRuntimeGetReferrersRequest)(nil),                    // 74: qdb.WebRuntimeGetReferrersRequest
+	(*WebRuntimeGetReferrersResponse)(nil),                   // 75: qdb.WebRuntimeGetReferrersResponse
+	(*DatabaseReference)(nil),                                // 76: qdb.DatabaseReference
+	(*DatabaseEntity)(nil),                                   // 77: qdb.DatabaseEntity
+	(*DatabaseSnapshotMergeSummary)(nil),                     // 78: qdb.DatabaseSnapshotMergeSummary
+	(*DatabaseSnapshotDiff)(nil),                             // 79: qdb.DatabaseSnapshotDiff
+	(*DatabaseEntityEvent)(nil),                              // 80: qdb.DatabaseEntityEvent
+	(*DatabaseField)(nil),                                    // 81: qdb.DatabaseField
+	(*DatabaseNotificationConfig)(nil),                       // 82: qdb.DatabaseNotificationConfig
+	(*DatabaseNotification)(nil),                             // 83: qdb.DatabaseNotification
+	(*DatabaseEntitySchema)(nil),                             // 84: qdb.DatabaseEntitySchema
+	(*DatabaseFieldSchema)(nil),                              // 85: qdb.DatabaseFieldSchema
+	(*DatabaseEnumValue)(nil),                                // 86: qdb.DatabaseEnumValue
+	(*DatabaseAlarmLimit)(nil),                               // 87: qdb.DatabaseAlarmLimit
+	(*DatabaseComputedField)(nil),                            // 88: qdb.DatabaseComputedField
+	(*DatabaseRequest)(nil),                                  // 89: qdb.DatabaseRequest
+	(*DatabaseQueryRow)(nil),                                 // 90: qdb.DatabaseQueryRow
+	(*DatabaseSnapshot)(nil),                                 // 91: qdb.DatabaseSnapshot
+	(*DatabaseSnapshotRecord)(nil),                           // 92: qdb.DatabaseSnapshotRecord
+	(*Int)(nil),                                              // 93: qdb.Int
+	(*String)(nil),                                           // 94: qdb.String
+	(*Timestamp)(nil),                                        // 95: qdb.Timestamp
+	(*Float)(nil),                                            // 96: qdb.Float
+	(*Bool)(nil),                                             // 97: qdb.Bool
+	(*EntityReference)(nil),                                  // 98: qdb.EntityReference
+	(*BinaryFile)(nil),                                       // 99: qdb.BinaryFile
+	(*Transformation)(nil),                                   // 100: qdb.Transformation
+	(*IntList)(nil),                                          // 101: qdb.IntList
+	(*StringList)(nil),                                       // 102: qdb.StringList
+	(*EntityReferenceList)(nil),                              // 103: qdb.EntityReferenceList
+	(*StringMap)(nil),                                        // 104: qdb.StringMap
+	(*Enum)(nil),                                             // 105: qdb.Enum
+	(*LogMessage)(nil),                                       // 106: qdb.LogMessage
+	(*ConnectionState)(nil),                                  // 107: qdb.ConnectionState
+	(*DatabaseSnapshotDiff_EntityChange)(nil),                // 108: qdb.DatabaseSnapshotDiff.EntityChange
+	(*DatabaseSnapshotDiff_FieldChange)(nil),                 // 109: qdb.DatabaseSnapshotDiff.FieldChange
+	(*DatabaseSnapshotDiff_EntitySchemaChange)(nil),          // 110: qdb.DatabaseSnapshotDiff.EntitySchemaChange
+	(*DatabaseSnapshotDiff_FieldSchemaChange)(nil),           // 111: qdb.DatabaseSnapshotDiff.FieldSchemaChange
+	(*DatabaseSnapshotRecord_Header)(nil),                    // 112: qdb.DatabaseSnapshotRecord.Header
+	(*DatabaseSnapshotRecord_Trailer)(nil),                   // 113: qdb.DatabaseSnapshotRecord.Trailer
+	nil,                                                      // 114: qdb.StringMap.RawEntry
+	(*timestamppb.Timestamp)(nil),                            // 115: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                                        // 116: google.protobuf.Any
 }
 var file_src_protobufs_proto_depIdxs = []int32{
-	70, // 0: qdb.WebHeader.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 1: qdb.WebHeader.authenticationStatus:type_name -> qdb.WebHeader.AuthenticationStatusEnum
-	14, // 2: qdb.WebMessage.header:type_name -> qdb.WebHeader
-	71, // 3: qdb.WebMessage.payload:type_name -> google.protobuf.Any
-	1,  // 4: qdb.WebConfigCreateEntityResponse.status:type_name -> qdb.WebConfigCreateEntityResponse.StatusEnum
-	2,  // 5: qdb.WebConfigDeleteEntityResponse.status:type_name -> qdb.WebConfigDeleteEntityResponse.StatusEnum
-	3,  // 6: qdb.WebConfigGetEntityResponse.status:type_name -> qdb.WebConfigGetEntityResponse.StatusEnum
-	52, // 7: qdb.WebConfigGetEntityResponse.entity:type_name -> qdb.DatabaseEntity
-	4,  // 8: qdb.WebConfigGetFieldSchemaResponse.status:type_name -> qdb.WebConfigGetFieldSchemaResponse.StatusEnum
-	57, // 9: qdb.WebConfigGetFieldSchemaResponse.schema:type_name -> qdb.DatabaseFieldSchema
-	57, // 10: qdb.WebConfigSetFieldSchemaRequest.schema:type_name -> qdb.DatabaseFieldSchema
-	5,  // 11: qdb.WebConfigSetFieldSchemaResponse.status:type_name -> qdb.WebConfigSetFieldSchemaResponse.StatusEnum
-	6,  // 12: qdb.WebConfigGetEntitySchemaResponse.status:type_name -> qdb.WebConfigGetEntitySchemaResponse.StatusEnum
-	56, // 13: qdb.WebConfigGetEntitySchemaResponse.schema:type_name -> qdb.DatabaseEntitySchema
-	7,  // 14: qdb.WebConfigSetEntitySchemaResponse.status:type_name -> qdb.WebConfigSetEntitySchemaResponse.StatusEnum
-	8,  // 15: qdb.WebConfigCreateSnapshotResponse.status:type_name -> qdb.WebConfigCreateSnapshotResponse.StatusEnum
-	59, // 16: qdb.WebConfigCreateSnapshotResponse.snapshot:type_name -> qdb.DatabaseSnapshot
-	59, // 17: qdb.WebConfigRestoreSnapshotRequest.snapshot:type_name -> qdb.DatabaseSnapshot
-	9,  // 18: qdb.WebConfigRestoreSnapshotResponse.status:type_name -> qdb.WebConfigRestoreSnapshotResponse.StatusEnum
-	10, // 19: qdb.WebRuntimeDatabaseRequest.requestType:type_name -> qdb.WebRuntimeDatabaseRequest.RequestTypeEnum
-	58, // 20: qdb.WebRuntimeDatabaseRequest.requests:type_name -> qdb.DatabaseRequest
-	58, // 21: qdb.WebRuntimeDatabaseResponse.response:type_name -> qdb.DatabaseRequest
-	54, // 22: qdb.WebRuntimeRegisterNotificationRequest.requests:type_name -> qdb.DatabaseNotificationConfig
-	55, // 23: qdb.WebRuntimeGetNotificationsResponse.notifications:type_name -> qdb.DatabaseNotification
-	11, // 24: qdb.WebRuntimeUnregisterNotificationResponse.status:type_name -> qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum
-	69, // 25: qdb.WebRuntimeGetDatabaseConnectionStatusResponse.status:type_name -> qdb.ConnectionState
-	52, // 26: qdb.WebRuntimeGetEntitiesResponse.entities:type_name -> qdb.DatabaseEntity
-	65, // 27: qdb.DatabaseEntity.parent:type_name -> qdb.EntityReference
-	65, // 28: qdb.DatabaseEntity.children:type_name -> qdb.EntityReference
-	71, // 29: qdb.DatabaseField.value:type_name -> google.protobuf.Any
-	70, // 30: qdb.DatabaseField.writeTime:type_name -> google.protobuf.Timestamp
-	53, // 31: qdb.DatabaseNotification.current:type_name -> qdb.DatabaseField
-	53, // 32: qdb.DatabaseNotification.previous:type_name -> qdb.DatabaseField
-	53, // 33: qdb.DatabaseNotification.context:type_name -> qdb.DatabaseField
-	71, // 34: qdb.DatabaseRequest.value:type_name -> google.protobuf.Any
-	62, // 35: qdb.DatabaseRequest.writeTime:type_name -> qdb.Timestamp
-	61, // 36: qdb.DatabaseRequest.writerId:type_name -> qdb.String
-	52, // 37: qdb.DatabaseSnapshot.entities:type_name -> qdb.DatabaseEntity
-	53, // 38: qdb.DatabaseSnapshot.fields:type_name -> qdb.DatabaseField
-	56, // 39: qdb.DatabaseSnapshot.entitySchemas:type_name -> qdb.DatabaseEntitySchema
-	57, // 40: qdb.DatabaseSnapshot.fieldSchemas:type_name -> qdb.DatabaseFieldSchema
-	70, // 41: qdb.Timestamp.raw:type_name -> google.protobuf.Timestamp
-	12, // 42: qdb.LogMessage.level:type_name -> qdb.LogMessage.LogLevelEnum
-	70, // 43: qdb.LogMessage.timestamp:type_name -> google.protobuf.Timestamp
-	13, // 44: qdb.ConnectionState.raw:type_name -> qdb.ConnectionState.ConnectionStateEnum
-	45, // [45:45] is the sub-list for method output_type
-	45, // [45:45] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	115, // 0: qdb.WebHeader.timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 1: qdb.WebHeader.authenticationStatus:type_name -> qdb.WebHeader.AuthenticationStatusEnum
+	26,  // 2: qdb.WebMessage.header:type_name -> qdb.WebHeader
+	116, // 3: qdb.WebMessage.payload:type_name -> google.protobuf.Any
+	1,   // 4: qdb.WebConfigCreateEntityResponse.status:type_name -> qdb.WebConfigCreateEntityResponse.StatusEnum
+	2,   // 5: qdb.WebConfigDeleteEntityResponse.status:type_name -> qdb.WebConfigDeleteEntityResponse.StatusEnum
+	3,   // 6: qdb.WebConfigGetEntityResponse.status:type_name -> qdb.WebConfigGetEntityResponse.StatusEnum
+	77,  // 7: qdb.WebConfigGetEntityResponse.entity:type_name -> qdb.DatabaseEntity
+	4,   // 8: qdb.WebConfigGetEntityByPathResponse.status:type_name -> qdb.WebConfigGetEntityByPathResponse.StatusEnum
+	77,  // 9: qdb.WebConfigGetEntityByPathResponse.entity:type_name -> qdb.DatabaseEntity
+	5,   // 10: qdb.WebConfigGetEntityPathResponse.status:type_name -> qdb.WebConfigGetEntityPathResponse.StatusEnum
+	6,   // 11: qdb.WebConfigTraverseEntityRequest.direction:type_name -> qdb.WebConfigTraverseEntityRequest.DirectionEnum
+	7,   // 12: qdb.WebConfigTraverseEntityResponse.status:type_name -> qdb.WebConfigTraverseEntityResponse.StatusEnum
+	77,  // 13: qdb.WebConfigTraverseEntityResponse.entities:type_name -> qdb.DatabaseEntity
+	8,   // 14: qdb.WebConfigGetFieldSchemaResponse.status:type_name -> qdb.WebConfigGetFieldSchemaResponse.StatusEnum
+	85,  // 15: qdb.WebConfigGetFieldSchemaResponse.schema:type_name -> qdb.DatabaseFieldSchema
+	85,  // 16: qdb.WebConfigSetFieldSchemaRequest.schema:type_name -> qdb.DatabaseFieldSchema
+	9,   // 17: qdb.WebConfigSetFieldSchemaResponse.status:type_name -> qdb.WebConfigSetFieldSchemaResponse.StatusEnum
+	10,  // 18: qdb.WebConfigGetEntitySchemaResponse.status:type_name -> qdb.WebConfigGetEntitySchemaResponse.StatusEnum
+	84,  // 19: qdb.WebConfigGetEntitySchemaResponse.schema:type_name -> qdb.DatabaseEntitySchema
+	11,  // 20: qdb.WebConfigSetEntitySchemaResponse.status:type_name -> qdb.WebConfigSetEntitySchemaResponse.StatusEnum
+	12,  // 21: qdb.WebConfigCreateSnapshotResponse.status:type_name -> qdb.WebConfigCreateSnapshotResponse.StatusEnum
+	91,  // 22: qdb.WebConfigCreateSnapshotResponse.snapshot:type_name -> qdb.DatabaseSnapshot
+	91,  // 23: qdb.WebConfigRestoreSnapshotRequest.snapshot:type_name -> qdb.DatabaseSnapshot
+	13,  // 24: qdb.WebConfigRestoreSnapshotResponse.status:type_name -> qdb.WebConfigRestoreSnapshotResponse.StatusEnum
+	78,  // 25: qdb.WebConfigRestoreSnapshotResponse.summary:type_name -> qdb.DatabaseSnapshotMergeSummary
+	91,  // 26: qdb.WebConfigDiffSnapshotRequest.old:type_name -> qdb.DatabaseSnapshot
+	91,  // 27: qdb.WebConfigDiffSnapshotRequest.new:type_name -> qdb.DatabaseSnapshot
+	14,  // 28: qdb.WebConfigDiffSnapshotResponse.status:type_name -> qdb.WebConfigDiffSnapshotResponse.StatusEnum
+	79,  // 29: qdb.WebConfigDiffSnapshotResponse.diff:type_name -> qdb.DatabaseSnapshotDiff
+	15,  // 30: qdb.WebRuntimeDatabaseRequest.requestType:type_name -> qdb.WebRuntimeDatabaseRequest.RequestTypeEnum
+	89,  // 31: qdb.WebRuntimeDatabaseRequest.requests:type_name -> qdb.DatabaseRequest
+	89,  // 32: qdb.WebRuntimeDatabaseResponse.response:type_name -> qdb.DatabaseRequest
+	82,  // 33: qdb.WebRuntimeRegisterNotificationRequest.requests:type_name -> qdb.DatabaseNotificationConfig
+	83,  // 34: qdb.WebRuntimeGetNotificationsResponse.notifications:type_name -> qdb.DatabaseNotification
+	16,  // 35: qdb.WebRuntimeUnregisterNotificationResponse.status:type_name -> qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum
+	107, // 36: qdb.WebRuntimeGetDatabaseConnectionStatusResponse.status:type_name -> qdb.ConnectionState
+	77,  // 37: qdb.WebRuntimeGetEntitiesResponse.entities:type_name -> qdb.DatabaseEntity
+	17,  // 38: qdb.WebRuntimeQueryResponse.status:type_name -> qdb.WebRuntimeQueryResponse.StatusEnum
+	90,  // 39: qdb.WebRuntimeQueryResponse.rows:type_name -> qdb.DatabaseQueryRow
+	18,  // 40: qdb.WebRuntimeGetReferrersResponse.status:type_name -> qdb.WebRuntimeGetReferrersResponse.StatusEnum
+	76,  // 41: qdb.WebRuntimeGetReferrersResponse.referrers:type_name -> qdb.DatabaseReference
+	98,  // 42: qdb.DatabaseEntity.parent:type_name -> qdb.EntityReference
+	98,  // 43: qdb.DatabaseEntity.children:type_name -> qdb.EntityReference
+	81,  // 44: qdb.DatabaseSnapshotMergeSummary.writtenFields:type_name -> qdb.DatabaseField
+	108, // 45: qdb.DatabaseSnapshotDiff.addedEntities:type_name -> qdb.DatabaseSnapshotDiff.EntityChange
+	108, // 46: qdb.DatabaseSnapshotDiff.removedEntities:type_name -> qdb.DatabaseSnapshotDiff.EntityChange
+	108, // 47: qdb.DatabaseSnapshotDiff.movedEntities:type_name -> qdb.DatabaseSnapshotDiff.EntityChange
+	109, // 48: qdb.DatabaseSnapshotDiff.changedFields:type_name -> qdb.DatabaseSnapshotDiff.FieldChange
+	110, // 49: qdb.DatabaseSnapshotDiff.entitySchemaChanges:type_name -> qdb.DatabaseSnapshotDiff.EntitySchemaChange
+	111, // 50: qdb.DatabaseSnapshotDiff.fieldSchemaChanges:type_name -> qdb.DatabaseSnapshotDiff.FieldSchemaChange
+	108, // 51: qdb.DatabaseSnapshotDiff.reorderedEntities:type_name -> qdb.DatabaseSnapshotDiff.EntityChange
+	19,  // 52: qdb.DatabaseEntityEvent.type:type_name -> qdb.DatabaseEntityEvent.TypeEnum
+	77,  // 53: qdb.DatabaseEntityEvent.current:type_name -> qdb.DatabaseEntity
+	77,  // 54: qdb.DatabaseEntityEvent.previous:type_name -> qdb.DatabaseEntity
+	116, // 55: qdb.DatabaseField.value:type_name -> google.protobuf.Any
+	115, // 56: qdb.DatabaseField.writeTime:type_name -> google.protobuf.Timestamp
+	81,  // 57: qdb.DatabaseNotification.current:type_name -> qdb.DatabaseField
+	81,  // 58: qdb.DatabaseNotification.previous:type_name -> qdb.DatabaseField
+	81,  // 59: qdb.DatabaseNotification.context:type_name -> qdb.DatabaseField
+	86,  // 60: qdb.DatabaseFieldSchema.enumValues:type_name -> qdb.DatabaseEnumValue
+	88,  // 61: qdb.DatabaseFieldSchema.computed:type_name -> qdb.DatabaseComputedField
+	87,  // 62: qdb.DatabaseFieldSchema.alarmLimits:type_name -> qdb.DatabaseAlarmLimit
+	20,  // 63: qdb.DatabaseFieldSchema.index:type_name -> qdb.DatabaseFieldSchema.IndexEnum
+	21,  // 64: qdb.DatabaseFieldSchema.onDelete:type_name -> qdb.DatabaseFieldSchema.ReferencePolicyEnum
+	22,  // 65: qdb.DatabaseAlarmLimit.level:type_name -> qdb.DatabaseAlarmLimit.LevelEnum
+	23,  // 66: qdb.DatabaseComputedField.evaluation:type_name -> qdb.DatabaseComputedField.EvaluationEnum
+	116, // 67: qdb.DatabaseRequest.value:type_name -> google.protobuf.Any
+	95,  // 68: qdb.DatabaseRequest.writeTime:type_name -> qdb.Timestamp
+	94,  // 69: qdb.DatabaseRequest.writerId:type_name -> qdb.String
+	81,  // 70: qdb.DatabaseQueryRow.fields:type_name -> qdb.DatabaseField
+	77,  // 71: qdb.DatabaseSnapshot.entities:type_name -> qdb.DatabaseEntity
+	81,  // 72: qdb.DatabaseSnapshot.fields:type_name -> qdb.DatabaseField
+	84,  // 73: qdb.DatabaseSnapshot.entitySchemas:type_name -> qdb.DatabaseEntitySchema
+	85,  // 74: qdb.DatabaseSnapshot.fieldSchemas:type_name -> qdb.DatabaseFieldSchema
+	112, // 75: qdb.DatabaseSnapshotRecord.header:type_name -> qdb.DatabaseSnapshotRecord.Header
+	84,  // 76: qdb.DatabaseSnapshotRecord.entitySchema:type_name -> qdb.DatabaseEntitySchema
+	85,  // 77: qdb.DatabaseSnapshotRecord.fieldSchema:type_name -> qdb.DatabaseFieldSchema
+	77,  // 78: qdb.DatabaseSnapshotRecord.entity:type_name -> qdb.DatabaseEntity
+	81,  // 79: qdb.DatabaseSnapshotRecord.field:type_name -> qdb.DatabaseField
+	113, // 80: qdb.DatabaseSnapshotRecord.trailer:type_name -> qdb.DatabaseSnapshotRecord.Trailer
+	115, // 81: qdb.Timestamp.raw:type_name -> google.protobuf.Timestamp
+	114, // 82: qdb.StringMap.raw:type_name -> qdb.StringMap.RawEntry
+	24,  // 83: qdb.LogMessage.level:type_name -> qdb.LogMessage.LogLevelEnum
+	115, // 84: qdb.LogMessage.timestamp:type_name -> google.protobuf.Timestamp
+	25,  // 85: qdb.ConnectionState.raw:type_name -> qdb.ConnectionState.ConnectionStateEnum
+	77,  // 86: qdb.DatabaseSnapshotDiff.EntityChange.old:type_name -> qdb.DatabaseEntity
+	77,  // 87: qdb.DatabaseSnapshotDiff.EntityChange.new:type_name -> qdb.DatabaseEntity
+	116, // 88: qdb.DatabaseSnapshotDiff.FieldChange.oldValue:type_name -> google.protobuf.Any
+	116, // 89: qdb.DatabaseSnapshotDiff.FieldChange.newValue:type_name -> google.protobuf.Any
+	84,  // 90: qdb.DatabaseSnapshotDiff.EntitySchemaChange.old:type_name -> qdb.DatabaseEntitySchema
+	84,  // 91: qdb.DatabaseSnapshotDiff.EntitySchemaChange.new:type_name -> qdb.DatabaseEntitySchema
+	85,  // 92: qdb.DatabaseSnapshotDiff.FieldSchemaChange.old:type_name -> qdb.DatabaseFieldSchema
+	85,  // 93: qdb.DatabaseSnapshotDiff.FieldSchemaChange.new:type_name -> qdb.DatabaseFieldSchema
+	94,  // [94:94] is the sub-list for method output_type
+	94,  // [94:94] is the sub-list for method input_type
+	94,  // [94:94] is the sub-list for extension type_name
+	94,  // [94:94] is the sub-list for extension extendee
+	0,   // [0:94] is the sub-list for field type_name
 }
 
 func init() { file_src_protobufs_proto_init() }
@@ -4017,8 +7141,8 @@ func file_src_protobufs_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_src_protobufs_proto_rawDesc,
-			NumEnums:      14,
-			NumMessages:   56,
+			NumEnums:      26,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
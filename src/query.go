@@ -0,0 +1,847 @@
+package qdb
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Query is a parsed entity query of the form:
+//
+//	SELECT <field>, ... | * FROM <EntityType>
+//	[WHERE <condition>] [ORDER BY <field> [ASC|DESC], ...] [LIMIT <n> [OFFSET <n>]]
+//
+// Conditions compare a field path with a literal (=, !=, <, <=, >, >=) and can be combined
+// with AND, OR, NOT and parentheses. Field paths follow -> indirection like Read does; a
+// leading Parent hop refers to the parent entity, and Id, Name and Type refer to the entity
+// itself unless the entity has fields with those names.
+type Query struct {
+	Fields     []string // Empty when selecting all fields of the entity type
+	EntityType string
+	Where      *Condition // Nil when all entities of the type match
+	OrderBy    []QueryOrder
+	Limit      int // 0 when unlimited
+	Offset     int
+}
+
+type QueryOrder struct {
+	Field      string
+	Descending bool
+}
+
+// ExecuteQuery parses and runs a query against the database
+func ExecuteQuery(db IDatabase, text string) ([]*DatabaseQueryRow, error) {
+	q, err := ParseQuery(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Execute(db), nil
+}
+
+// Execute runs the query. Conditions on indexed fields are used by EntityFinder to narrow down
+// the entities to evaluate.
+func (q *Query) Execute(db IDatabase) []*DatabaseQueryRow {
+	criteria := SearchCriteria{
+		EntityType: q.EntityType,
+	}
+
+	if q.Where != nil {
+		criteria.Where = []*Condition{q.Where}
+	}
+
+	entities := NewEntityFinder(db).Find(criteria)
+
+	if len(q.OrderBy) > 0 {
+		keys := map[string][]*anypb.Any{}
+		for _, entity := range entities {
+			for _, order := range q.OrderBy {
+				request := queryRead(db, entity.GetId(), order.Field)
+				if request.Success {
+					keys[entity.GetId()] = append(keys[entity.GetId()], request.Value)
+				} else {
+					keys[entity.GetId()] = append(keys[entity.GetId()], nil)
+				}
+			}
+		}
+
+		slices.SortStableFunc(entities, func(a, b IEntity) int {
+			for i, order := range q.OrderBy {
+				c := compareQueryValues(keys[a.GetId()][i], keys[b.GetId()][i])
+				if order.Descending {
+					c = -c
+				}
+
+				if c != 0 {
+					return c
+				}
+			}
+
+			return 0
+		})
+	}
+
+	if q.Offset > 0 {
+		entities = entities[min(q.Offset, len(entities)):]
+	}
+
+	if q.Limit > 0 && len(entities) > q.Limit {
+		entities = entities[:q.Limit]
+	}
+
+	fields := q.Fields
+	if len(fields) == 0 {
+		if schema := db.GetEntitySchema(q.EntityType); schema != nil {
+			fields = schema.Fields
+		}
+	}
+
+	rows := []*DatabaseQueryRow{}
+	for _, entity := range entities {
+		row := &DatabaseQueryRow{
+			EntityId: entity.GetId(),
+		}
+
+		for _, field := range fields {
+			request := queryRead(db, entity.GetId(), field)
+			if !request.Success {
+				continue
+			}
+
+			row.Fields = append(row.Fields, new(DatabaseField).FromRequest(request))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// queryRead reads a field path of an entity, resolving leading Parent hops and the Id, Name
+// and Type pseudo-fields
+func queryRead(db IDatabase, entityId, path string) *DatabaseRequest {
+	request := &DatabaseRequest{
+		Id:    entityId,
+		Field: path,
+	}
+
+	hops := strings.Split(path, "->")
+	for len(hops) > 1 && hops[0] == "Parent" && !db.FieldExists(hops[0], entityId) {
+		entity := db.GetEntity(entityId)
+		if entity == nil || entity.Parent.GetRaw() == "" {
+			return request
+		}
+
+		entityId = entity.Parent.Raw
+		hops = hops[1:]
+	}
+
+	resolved := &DatabaseRequest{
+		Id:    entityId,
+		Field: strings.Join(hops, "->"),
+	}
+	db.Read([]*DatabaseRequest{resolved})
+
+	if resolved.Success {
+		request.Value = resolved.Value
+		request.WriteTime = resolved.WriteTime
+		request.WriterId = resolved.WriterId
+		request.Success = true
+		return request
+	}
+
+	field := hops[len(hops)-1]
+	if field != "Id" && field != "Name" && field != "Type" {
+		return request
+	}
+
+	if len(hops) > 1 {
+		_, entityId = db.ResolveIndirection(strings.Join(hops[:len(hops)-1], "->")+"->"+field, entityId)
+		if entityId == "" {
+			return request
+		}
+	}
+
+	entity := db.GetEntity(entityId)
+	if entity == nil {
+		return request
+	}
+
+	switch field {
+	case "Id":
+		request.Value = NewStringValue(entity.Id)
+	case "Name":
+		request.Value = NewStringValue(entity.Name)
+	case "Type":
+		request.Value = NewStringValue(entity.Type)
+	}
+	request.Success = true
+
+	return request
+}
+
+// compareQueryValues orders numbers before strings and missing values last
+func compareQueryValues(a, b *anypb.Any) int {
+	an, as, aok := queryOrderKey(a)
+	bn, bs, bok := queryOrderKey(b)
+
+	switch {
+	case !aok && !bok:
+		return 0
+	case !aok:
+		return 1
+	case !bok:
+		return -1
+	}
+
+	if c := cmp.Compare(an, bn); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(as, bs)
+}
+
+func queryOrderKey(value *anypb.Any) (float64, string, bool) {
+	if value == nil {
+		return 0, "", false
+	}
+
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		return 0, "", false
+	}
+
+	switch v := m.(type) {
+	case *Int:
+		return float64(v.Raw), "", true
+	case *Float:
+		return v.Raw, "", true
+	case *Enum:
+		return float64(v.Raw), "", true
+	case *Timestamp:
+		return float64(v.Raw.AsTime().UnixNano()), "", true
+	case *Bool:
+		if v.Raw {
+			return 1, "", true
+		}
+		return 0, "", true
+	case *String:
+		return math.Inf(1), v.Raw, true
+	case *EntityReference:
+		return math.Inf(1), v.Raw, true
+	}
+
+	return 0, "", false
+}
+
+type queryLiteralKind int
+
+const (
+	queryLiteralString queryLiteralKind = iota
+	queryLiteralNumber
+	queryLiteralBool
+)
+
+type queryLiteral struct {
+	kind  queryLiteralKind
+	str   string
+	num   float64
+	isInt bool
+	i     int64
+	b     bool
+}
+
+func (l *queryLiteral) time() (time.Time, bool) {
+	switch l.kind {
+	case queryLiteralString:
+		t, err := time.Parse(time.RFC3339Nano, l.str)
+		return t, err == nil
+	case queryLiteralNumber:
+		return time.UnixMilli(int64(l.num)), true
+	}
+
+	return time.Time{}, false
+}
+
+// compare compares a field value with the literal. It returns false if they cannot be compared.
+func (l *queryLiteral) compare(db IDatabase, fieldName string, value *anypb.Any) (int, bool) {
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		return 0, false
+	}
+
+	switch v := m.(type) {
+	case *Int:
+		if l.kind == queryLiteralNumber && l.isInt {
+			return cmp.Compare(v.Raw, l.i), true
+		} else if l.kind == queryLiteralNumber {
+			return cmp.Compare(float64(v.Raw), l.num), true
+		}
+	case *Float:
+		if l.kind == queryLiteralNumber {
+			return cmp.Compare(v.Raw, l.num), true
+		}
+	case *Enum:
+		if l.kind == queryLiteralNumber && l.isInt {
+			return cmp.Compare(v.Raw, l.i), true
+		} else if l.kind == queryLiteralString {
+			schema := db.GetFieldSchema(fieldName)
+			if schema == nil {
+				return 0, false
+			}
+
+			name, ok := schema.EnumName(v.Raw)
+			return cmp.Compare(name, l.str), ok
+		}
+	case *String:
+		if l.kind == queryLiteralString {
+			return cmp.Compare(v.Raw, l.str), true
+		}
+	case *EntityReference:
+		if l.kind == queryLiteralString {
+			return cmp.Compare(v.Raw, l.str), true
+		}
+	case *Bool:
+		if l.kind == queryLiteralBool {
+			return cmp.Compare(boolToInt(v.Raw), boolToInt(l.b)), true
+		}
+	case *Timestamp:
+		if t, ok := l.time(); ok {
+			return v.Raw.AsTime().Compare(t), true
+		}
+	}
+
+	return 0, false
+}
+
+// value converts the literal to a value of the given field schema for index lookups
+func (l *queryLiteral) value(schema *DatabaseFieldSchema) (*anypb.Any, bool) {
+	switch schema.Type {
+	case "qdb.Int":
+		if l.kind == queryLiteralNumber && l.isInt {
+			return NewIntValue(l.i), true
+		}
+	case "qdb.Float":
+		if l.kind == queryLiteralNumber {
+			return NewFloatValue(l.num), true
+		}
+	case "qdb.Enum":
+		if l.kind == queryLiteralNumber && l.isInt {
+			return NewEnumValue(l.i), true
+		} else if l.kind == queryLiteralString {
+			if v, ok := schema.EnumValue(l.str); ok {
+				return NewEnumValue(v), true
+			}
+		}
+	case "qdb.String":
+		if l.kind == queryLiteralString {
+			return NewStringValue(l.str), true
+		}
+	case "qdb.EntityReference":
+		if l.kind == queryLiteralString {
+			return NewEntityReferenceValue(l.str), true
+		}
+	case "qdb.Bool":
+		if l.kind == queryLiteralBool {
+			return NewBoolValue(l.b), true
+		}
+	case "qdb.Timestamp":
+		if t, ok := l.time(); ok {
+			return NewTimestampValue(t), true
+		}
+	}
+
+	return nil, false
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+type queryComparison struct {
+	field   string
+	op      string
+	literal *queryLiteral
+}
+
+func (c *queryComparison) Evaluate(db IDatabase, entityId string) bool {
+	request := queryRead(db, entityId, c.field)
+	if !request.Success {
+		return false
+	}
+
+	fields := strings.Split(c.field, "->")
+	result, ok := c.literal.compare(db, fields[len(fields)-1], request.Value)
+	if !ok {
+		return false
+	}
+
+	switch c.op {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	}
+
+	return false
+}
+
+// Fields lets EntityFinder batch the read of the compared field. Paths through the parent
+// entity are resolved by queryRead and are not batched.
+func (c *queryComparison) Fields() []string {
+	if strings.HasPrefix(c.field, "Parent->") {
+		return nil
+	}
+
+	return []string{c.field}
+}
+
+func (c *queryComparison) Candidates(db IDatabase, entityType string) ([]string, bool) {
+	if strings.Contains(c.field, "->") || c.op == "!=" || !db.FieldExists(c.field, entityType) {
+		return nil, false
+	}
+
+	schema := db.GetFieldSchema(c.field)
+	if schema == nil {
+		return nil, false
+	}
+
+	value, ok := c.literal.value(schema)
+	if !ok {
+		return nil, false
+	}
+
+	if c.op == "=" {
+		return db.FindEntitiesByIndex(entityType, c.field, []*anypb.Any{value})
+	}
+
+	score, ok := indexScore(value)
+	if !ok {
+		return nil, false
+	}
+
+	switch c.op {
+	case "<", "<=":
+		return db.FindEntitiesByRange(entityType, c.field, math.Inf(-1), score)
+	case ">", ">=":
+		return db.FindEntitiesByRange(entityType, c.field, score, math.Inf(1))
+	}
+
+	return nil, false
+}
+
+type queryTokenKind int
+
+const (
+	queryTokenEOF queryTokenKind = iota
+	queryTokenIdent
+	queryTokenString
+	queryTokenNumber
+	queryTokenOperator
+	queryTokenPunct
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string
+	pos  int
+}
+
+func isQueryIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// isQueryParentHop returns true at a ".." hop of a field path
+func isQueryParentHop(runes []rune, i int) bool {
+	return i+1 < len(runes) && runes[i] == '.' && runes[i+1] == '.'
+}
+
+func tokenizeQuery(text string) ([]queryToken, error) {
+	tokens := []queryToken{}
+	runes := []rune(text)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsLetter(r) || r == '_' || r == '[' || isQueryParentHop(runes, i):
+			start := i
+			for i < len(runes) {
+				if isQueryIdentRune(runes[i]) {
+					i++
+				} else if runes[i] == '[' {
+					// Child selectors such as [Sensor], [0] or [Location="North"] are part of the path
+					end := slices.Index(runes[i:], ']')
+					if end < 0 {
+						return nil, fmt.Errorf("unterminated selector at position %d", i)
+					}
+					i += end + 1
+				} else if isQueryParentHop(runes, i) {
+					i += 2
+				} else if runes[i] == '-' && i+2 < len(runes) && runes[i+1] == '>' &&
+					(unicode.IsLetter(runes[i+2]) || runes[i+2] == '_' || runes[i+2] == '[' || isQueryParentHop(runes, i+2)) {
+					i += 2
+				} else {
+					break
+				}
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenIdent, text: string(runes[start:i]), pos: start})
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E' ||
+				((runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenNumber, text: string(runes[start:i]), pos: start})
+		case r == '"' || r == '\'':
+			start := i
+			quote := r
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != quote; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, queryToken{kind: queryTokenString, text: sb.String(), pos: start})
+		case r == '=' || r == '<' || r == '>' || r == '!':
+			start := i
+			i++
+			if i < len(runes) && (runes[i] == '=' || (r == '<' && runes[i] == '>')) {
+				i++
+			}
+			op := string(runes[start:i])
+			if op == "!" {
+				return nil, fmt.Errorf("unexpected '!' at position %d", start)
+			}
+			if op == "<>" {
+				op = "!="
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenOperator, text: op, pos: start})
+		case r == ',' || r == '(' || r == ')' || r == '*':
+			tokens = append(tokens, queryToken{kind: queryTokenPunct, text: string(r), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected '%c' at position %d", r, i)
+		}
+	}
+
+	return append(tokens, queryToken{kind: queryTokenEOF, pos: len(runes)}), nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+// ParseQuery parses the textual form of a Query
+func ParseQuery(text string) (*Query, error) {
+	tokens, err := tokenizeQuery(text)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{tokens: tokens}
+	return p.parseQuery()
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	t := p.tokens[p.pos]
+	if t.kind != queryTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *queryParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == queryTokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (p *queryParser) acceptKeyword(keyword string) bool {
+	if p.isKeyword(keyword) {
+		p.next()
+		return true
+	}
+
+	return false
+}
+
+func (p *queryParser) acceptPunct(punct string) bool {
+	if t := p.peek(); t.kind == queryTokenPunct && t.text == punct {
+		p.next()
+		return true
+	}
+
+	return false
+}
+
+func (p *queryParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), p.peek().pos)
+}
+
+func (p *queryParser) expectKeyword(keyword string) error {
+	if !p.acceptKeyword(keyword) {
+		return p.errorf("expected %s", keyword)
+	}
+
+	return nil
+}
+
+var queryKeywords = []string{"SELECT", "FROM", "WHERE", "AND", "OR", "NOT", "ORDER", "BY", "ASC", "DESC", "LIMIT", "OFFSET", "TRUE", "FALSE"}
+
+func (p *queryParser) expectIdent(what string) (string, error) {
+	t := p.peek()
+	if t.kind != queryTokenIdent || slices.ContainsFunc(queryKeywords, func(k string) bool { return strings.EqualFold(k, t.text) }) {
+		return "", p.errorf("expected %s", what)
+	}
+
+	p.next()
+	return t.text, nil
+}
+
+func (p *queryParser) expectInt(what string) (int, error) {
+	t := p.peek()
+	n, err := strconv.Atoi(t.text)
+	if t.kind != queryTokenNumber || err != nil || n < 0 {
+		return 0, p.errorf("expected %s", what)
+	}
+
+	p.next()
+	return n, nil
+}
+
+func (p *queryParser) parseQuery() (*Query, error) {
+	q := &Query{}
+
+	if err := p.expectKeyword("SELECT"); err != nil {
+		return nil, err
+	}
+
+	if !p.acceptPunct("*") {
+		for {
+			field, err := p.expectIdent("field name")
+			if err != nil {
+				return nil, err
+			}
+			q.Fields = append(q.Fields, field)
+
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+	}
+
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+
+	entityType, err := p.expectIdent("entity type")
+	if err != nil {
+		return nil, err
+	}
+	q.EntityType = entityType
+
+	if p.acceptKeyword("WHERE") {
+		if q.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.acceptKeyword("ORDER") {
+		if err := p.expectKeyword("BY"); err != nil {
+			return nil, err
+		}
+
+		for {
+			field, err := p.expectIdent("field name")
+			if err != nil {
+				return nil, err
+			}
+
+			order := QueryOrder{Field: field}
+			if p.acceptKeyword("DESC") {
+				order.Descending = true
+			} else {
+				p.acceptKeyword("ASC")
+			}
+			q.OrderBy = append(q.OrderBy, order)
+
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+	}
+
+	if p.acceptKeyword("LIMIT") {
+		if q.Limit, err = p.expectInt("limit"); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.acceptKeyword("OFFSET") {
+		if q.Offset, err = p.expectInt("offset"); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().kind != queryTokenEOF {
+		return nil, p.errorf("unexpected '%s'", p.peek().text)
+	}
+
+	return q, nil
+}
+
+func (p *queryParser) parseOr() (*Condition, error) {
+	conditions := []*Condition{}
+
+	for {
+		condition, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+
+		if !p.acceptKeyword("OR") {
+			break
+		}
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+
+	return Any(conditions...), nil
+}
+
+func (p *queryParser) parseAnd() (*Condition, error) {
+	conditions := []*Condition{}
+
+	for {
+		condition, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+
+		if !p.acceptKeyword("AND") {
+			break
+		}
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+
+	return All(conditions...), nil
+}
+
+func (p *queryParser) parseUnary() (*Condition, error) {
+	if p.acceptKeyword("NOT") {
+		condition, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not(condition), nil
+	}
+
+	if p.acceptPunct("(") {
+		condition, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.acceptPunct(")") {
+			return nil, p.errorf("expected ')'")
+		}
+
+		return condition, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *queryParser) parseComparison() (*Condition, error) {
+	field, err := p.expectIdent("field name")
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.peek()
+	if op.kind != queryTokenOperator {
+		return nil, p.errorf("expected comparison operator")
+	}
+	p.next()
+
+	literal, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+
+	return planned(&queryComparison{
+		field:   field,
+		op:      op.text,
+		literal: literal,
+	}), nil
+}
+
+func (p *queryParser) parseLiteral() (*queryLiteral, error) {
+	t := p.peek()
+
+	switch {
+	case t.kind == queryTokenString:
+		p.next()
+		return &queryLiteral{kind: queryLiteralString, str: t.text}, nil
+	case t.kind == queryTokenNumber:
+		p.next()
+		if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			return &queryLiteral{kind: queryLiteralNumber, num: float64(i), isInt: true, i: i}, nil
+		}
+
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s' at position %d", t.text, t.pos)
+		}
+		return &queryLiteral{kind: queryLiteralNumber, num: f}, nil
+	case p.isKeyword("TRUE"):
+		p.next()
+		return &queryLiteral{kind: queryLiteralBool, b: true}, nil
+	case p.isKeyword("FALSE"):
+		p.next()
+		return &queryLiteral{kind: queryLiteralBool, b: false}, nil
+	}
+
+	return nil, p.errorf("expected literal")
+}
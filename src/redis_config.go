@@ -0,0 +1,212 @@
+package qdb
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const DefaultClusterNamespace = "qdb"
+
+// RedisDatabaseConfigFromEnv reads the connection settings from QDB_* environment variables:
+//
+//	QDB_ADDR (redis:6379 by default), QDB_USERNAME, QDB_PASSWORD, QDB_DB, QDB_NAMESPACE, QDB_BLOB_DIR
+//	QDB_TLS, QDB_TLS_CA, QDB_TLS_CERT, QDB_TLS_KEY
+//	QDB_SENTINEL_MASTER, QDB_SENTINEL_ADDRS, QDB_SENTINEL_PASSWORD
+//	QDB_CLUSTER_ADDRS
+//	QDB_CACHE_SIZE
+//
+// Lists of addresses are separated by commas. An error is returned if a number or flag is invalid.
+func RedisDatabaseConfigFromEnv() (RedisDatabaseConfig, error) {
+	config := RedisDatabaseConfig{
+		Address:            os.Getenv("QDB_ADDR"),
+		Username:           os.Getenv("QDB_USERNAME"),
+		Password:           os.Getenv("QDB_PASSWORD"),
+		Namespace:          os.Getenv("QDB_NAMESPACE"),
+		BlobDirectory:      os.Getenv("QDB_BLOB_DIR"),
+		TLSCAFile:          os.Getenv("QDB_TLS_CA"),
+		TLSCertFile:        os.Getenv("QDB_TLS_CERT"),
+		TLSKeyFile:         os.Getenv("QDB_TLS_KEY"),
+		SentinelMasterName: os.Getenv("QDB_SENTINEL_MASTER"),
+		SentinelAddresses:  splitAddresses(os.Getenv("QDB_SENTINEL_ADDRS")),
+		SentinelPassword:   os.Getenv("QDB_SENTINEL_PASSWORD"),
+		ClusterAddresses:   splitAddresses(os.Getenv("QDB_CLUSTER_ADDRS")),
+	}
+
+	if config.Address == "" {
+		config.Address = "redis:6379"
+	}
+
+	if db := os.Getenv("QDB_DB"); db != "" {
+		index, err := strconv.Atoi(db)
+		if err != nil {
+			return config, fmt.Errorf("invalid QDB_DB '%s': %w", db, err)
+		}
+		config.DB = index
+	}
+
+	if size := os.Getenv("QDB_CACHE_SIZE"); size != "" {
+		cacheSize, err := strconv.Atoi(size)
+		if err != nil {
+			return config, fmt.Errorf("invalid QDB_CACHE_SIZE '%s': %w", size, err)
+		}
+		config.CacheSize = cacheSize
+	}
+
+	if enabled := os.Getenv("QDB_TLS"); enabled != "" {
+		tlsEnabled, err := strconv.ParseBool(enabled)
+		if err != nil {
+			return config, fmt.Errorf("invalid QDB_TLS '%s': %w", enabled, err)
+		}
+		config.TLSEnabled = tlsEnabled
+	}
+
+	return config, nil
+}
+
+func splitAddresses(value string) []string {
+	addresses := []string{}
+	for _, address := range strings.Split(value, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
+func (c RedisDatabaseConfig) isCluster() bool {
+	return len(c.ClusterAddresses) > 0
+}
+
+// describe returns the addresses connected to, for logging
+func (c RedisDatabaseConfig) describe() string {
+	if c.SentinelMasterName != "" {
+		return fmt.Sprintf("master '%s' through sentinels %s", c.SentinelMasterName, strings.Join(c.SentinelAddresses, ","))
+	}
+
+	if c.isCluster() {
+		return "cluster " + strings.Join(c.ClusterAddresses, ",")
+	}
+
+	return c.Address
+}
+
+// keyGenerator hash tags every key of the namespace in cluster mode. This is a deliberate
+// single-slot mode: transactions and MGETs span entities, and caches rely on the order of the
+// invalidation stream relative to the writes, which only holds within one slot.
+func (c RedisDatabaseConfig) keyGenerator() RedisDatabaseKeyGenerator {
+	g := NewRedisDatabaseKeyGenerator(c.Namespace)
+
+	if c.isCluster() {
+		g.hashTag = true
+		if g.namespace == "" {
+			g.namespace = DefaultClusterNamespace
+		}
+	}
+
+	return g
+}
+
+// tlsConfig returns nil when TLS is not configured
+func (c RedisDatabaseConfig) tlsConfig() (*tls.Config, error) {
+	if !c.TLSEnabled && c.TLSCAFile == "" && c.TLSCertFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.TLSCAFile != "" {
+		pem, err := os.ReadFile(c.TLSCAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificate found in " + c.TLSCAFile)
+		}
+	}
+
+	if c.TLSCertFile != "" || c.TLSKeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.TLSCertFile, c.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+func (c RedisDatabaseConfig) newClient() (redis.UniversalClient, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.SentinelMasterName != "" {
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       c.SentinelMasterName,
+			SentinelAddrs:    c.SentinelAddresses,
+			SentinelPassword: c.SentinelPassword,
+			Username:         c.Username,
+			Password:         c.Password,
+			DB:               c.DB,
+			TLSConfig:        tlsConfig,
+		}), nil
+	}
+
+	if c.isCluster() {
+		if c.DB != 0 {
+			return nil, errors.New("a DB index cannot be used in cluster mode")
+		}
+
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:     c.ClusterAddresses,
+			Username:  c.Username,
+			Password:  c.Password,
+			TLSConfig: tlsConfig,
+		}), nil
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:      c.Address,
+		Username:  c.Username,
+		Password:  c.Password,
+		DB:        c.DB,
+		TLSConfig: tlsConfig,
+	}), nil
+}
+
+// scanner returns the client to scan keys with. In cluster mode, every key carries the hash tag
+// of the namespace, so they all live on the master serving that slot.
+func (db *RedisDatabase) scanner() redis.Cmdable {
+	return namespaceScanner(db.client, db.keygen)
+}
+
+// namespaceScanner is scanner for any client of the namespace, such as the one of RedisBlobStore
+func namespaceScanner(client redis.UniversalClient, keygen RedisDatabaseKeyGenerator) redis.Cmdable {
+	cluster, ok := client.(*redis.ClusterClient)
+	if !ok {
+		return client
+	}
+
+	master, err := cluster.MasterForKey(context.Background(), keygen.GetNamespacedKey(""))
+	if err != nil {
+		Error("[RedisDatabase::scanner] Failed to find the master of the namespace: %v", err)
+		return client
+	}
+
+	return master
+}
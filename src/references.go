@@ -0,0 +1,232 @@
+package qdb
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// referencedEntities returns the ids held by an EntityReference or EntityReferenceList value
+func referencedEntities(value *anypb.Any) []string {
+	if value == nil {
+		return nil
+	}
+
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		return nil
+	}
+
+	switch v := m.(type) {
+	case *EntityReference:
+		if v.Raw != "" {
+			return []string{v.Raw}
+		}
+	case *EntityReferenceList:
+		return slices.DeleteFunc(slices.Clone(v.Raw), func(id string) bool { return id == "" })
+	}
+
+	return nil
+}
+
+// updateReferences keeps the reverse-reference index in step with the references held by a field
+func (db *RedisDatabase) updateReferences(request *DatabaseRequest, oldRequest *DatabaseRequest, fieldName, entityId string) {
+	referrer := entityId + ":" + fieldName
+
+	previous := []string{}
+	if oldRequest.Success {
+		previous = referencedEntities(oldRequest.Value)
+	}
+	current := referencedEntities(request.Value)
+
+	for _, id := range previous {
+		if !slices.Contains(current, id) {
+			db.client.SRem(context.Background(), db.keygen.GetEntityReferrersKey(id), referrer)
+		}
+	}
+
+	for _, id := range current {
+		if !slices.Contains(previous, id) {
+			db.client.SAdd(context.Background(), db.keygen.GetEntityReferrersKey(id), referrer)
+		}
+	}
+}
+
+// isReferenceType reports whether the values of a field type are indexed by the reverse-reference index
+func isReferenceType(typeName string) bool {
+	return typeName == "qdb.EntityReference" || typeName == "qdb.EntityReferenceList"
+}
+
+// rebuildReferrers drops the entries of a field from the reverse-reference index and rebuilds them
+// from the current field values, so that references written before the index existed are honored
+func (db *RedisDatabase) rebuildReferrers(fieldName string) {
+	it := db.scanner().Scan(context.Background(), 0, db.keygen.GetEntityReferrersKey("*"), 0).Iterator()
+	for it.Next(context.Background()) {
+		for _, referrer := range db.client.SMembers(context.Background(), it.Val()).Val() {
+			if _, field, _ := strings.Cut(referrer, ":"); field == fieldName {
+				db.client.SRem(context.Background(), it.Val(), referrer)
+			}
+		}
+	}
+
+	for _, entityType := range db.GetEntityTypes() {
+		schema := db.GetEntitySchema(entityType)
+		if schema == nil || !slices.Contains(schema.Fields, fieldName) {
+			continue
+		}
+
+		for _, entityId := range db.FindEntities(entityType) {
+			request := &DatabaseRequest{
+				Id:    entityId,
+				Field: fieldName,
+			}
+			db.Read([]*DatabaseRequest{request})
+
+			if !request.Success {
+				continue
+			}
+
+			for _, id := range referencedEntities(request.Value) {
+				db.client.SAdd(context.Background(), db.keygen.GetEntityReferrersKey(id), entityId+":"+fieldName)
+			}
+		}
+	}
+}
+
+// removeFieldReferences drops the references held by a field from the reverse-reference index
+func (db *RedisDatabase) removeFieldReferences(fieldName, entityId string) {
+	request := &DatabaseRequest{
+		Id:    entityId,
+		Field: fieldName,
+	}
+	db.Read([]*DatabaseRequest{request})
+
+	if !request.Success {
+		return
+	}
+
+	for _, id := range referencedEntities(request.Value) {
+		db.client.SRem(context.Background(), db.keygen.GetEntityReferrersKey(id), entityId+":"+fieldName)
+	}
+}
+
+// GetReferrers returns the entity fields holding a reference to the entity
+func (db *RedisDatabase) GetReferrers(entityId string) []*DatabaseReference {
+	members := db.client.SMembers(context.Background(), db.keygen.GetEntityReferrersKey(entityId)).Val()
+	slices.Sort(members)
+
+	referrers := []*DatabaseReference{}
+	for _, member := range members {
+		referrerId, fieldName, ok := strings.Cut(member, ":")
+		if !ok {
+			continue
+		}
+
+		referrers = append(referrers, &DatabaseReference{
+			EntityId: referrerId,
+			Field:    fieldName,
+		})
+	}
+
+	return referrers
+}
+
+// referencePolicy returns what happens to a field's reference when the referenced entity is deleted
+func (db *RedisDatabase) referencePolicy(fieldName string) DatabaseFieldSchema_ReferencePolicyEnum {
+	schema := db.GetFieldSchema(fieldName)
+	if schema == nil {
+		return DatabaseFieldSchema_SET_NULL
+	}
+
+	return schema.OnDelete
+}
+
+// collectDeletions returns the entities removed by deleting an entity: its subtree and,
+// transitively, the entities referencing any of them through a field with the CASCADE policy
+func (db *RedisDatabase) collectDeletions(entityId string) map[string]bool {
+	deleted := map[string]bool{}
+	pending := []string{entityId}
+
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+
+		if deleted[id] {
+			continue
+		}
+
+		entity := db.GetEntity(id)
+		if entity == nil {
+			continue
+		}
+		deleted[id] = true
+
+		for _, child := range entity.Children {
+			pending = append(pending, child.Raw)
+		}
+
+		for _, referrer := range db.GetReferrers(id) {
+			if db.referencePolicy(referrer.Field) == DatabaseFieldSchema_CASCADE {
+				pending = append(pending, referrer.EntityId)
+			}
+		}
+	}
+
+	return deleted
+}
+
+// findRestrictingReferrer returns a reference that prevents the entities from being deleted, if any
+func (db *RedisDatabase) findRestrictingReferrer(deleted map[string]bool) *DatabaseReference {
+	for id := range deleted {
+		for _, referrer := range db.GetReferrers(id) {
+			if !deleted[referrer.EntityId] && db.referencePolicy(referrer.Field) == DatabaseFieldSchema_RESTRICT {
+				return referrer
+			}
+		}
+	}
+
+	return nil
+}
+
+// releaseReferrers clears the references to an entity that is about to be deleted.
+// Referrers that are deleted along with it are left alone.
+func (db *RedisDatabase) releaseReferrers(entityId string, deleted map[string]bool) {
+	for _, referrer := range db.GetReferrers(entityId) {
+		if deleted[referrer.EntityId] {
+			continue
+		}
+
+		request := &DatabaseRequest{
+			Id:    referrer.EntityId,
+			Field: referrer.Field,
+		}
+		db.Read([]*DatabaseRequest{request})
+
+		if !request.Success {
+			continue
+		}
+
+		m, err := request.Value.UnmarshalNew()
+		if err != nil {
+			Error("[RedisDatabase::releaseReferrers] Failed to unmarshal %s.%s: %v", referrer.EntityId, referrer.Field, err)
+			continue
+		}
+
+		switch v := m.(type) {
+		case *EntityReference:
+			request.Value = NewEntityReferenceValue("")
+		case *EntityReferenceList:
+			request.Value = NewEntityReferenceListValue(slices.DeleteFunc(v.Raw, func(id string) bool { return id == entityId }))
+		default:
+			continue
+		}
+
+		request.WriteTime = nil
+		request.WriterId = nil
+		db.Write([]*DatabaseRequest{request})
+	}
+
+	db.client.Del(context.Background(), db.keygen.GetEntityReferrersKey(entityId))
+}
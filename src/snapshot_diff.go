@@ -0,0 +1,314 @@
+package qdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// DiffSnapshots reports how to get from the old snapshot to the new one. Changes are sorted by
+// path, then id, so the result of comparing the same snapshots is always the same.
+// The fields of added entities are reported with an unset old value, those of removed entities
+// are not reported.
+func DiffSnapshots(old, new *DatabaseSnapshot) *DatabaseSnapshotDiff {
+	diff := &DatabaseSnapshotDiff{}
+
+	oldEntities := map[string]*DatabaseEntity{}
+	for _, entity := range old.GetEntities() {
+		oldEntities[entity.Id] = entity
+	}
+
+	newEntities := map[string]*DatabaseEntity{}
+	for _, entity := range new.GetEntities() {
+		newEntities[entity.Id] = entity
+	}
+
+	oldPaths := snapshotEntityPaths(oldEntities)
+	newPaths := snapshotEntityPaths(newEntities)
+
+	for _, id := range sortedKeys(newEntities) {
+		entity := newEntities[id]
+		change := &DatabaseSnapshotDiff_EntityChange{
+			Old:     oldEntities[id],
+			New:     entity,
+			OldPath: oldPaths[id],
+			NewPath: newPaths[id],
+		}
+
+		if change.Old == nil {
+			diff.AddedEntities = append(diff.AddedEntities, change)
+			continue
+		}
+
+		if change.Old.Parent.GetRaw() != entity.Parent.GetRaw() || change.Old.Name != entity.Name {
+			diff.MovedEntities = append(diff.MovedEntities, change)
+		}
+
+		if childrenReordered(change.Old.Children, entity.Children) {
+			diff.ReorderedEntities = append(diff.ReorderedEntities, change)
+		}
+	}
+
+	for _, id := range sortedKeys(oldEntities) {
+		if _, ok := newEntities[id]; !ok {
+			diff.RemovedEntities = append(diff.RemovedEntities, &DatabaseSnapshotDiff_EntityChange{
+				Old:     oldEntities[id],
+				OldPath: oldPaths[id],
+			})
+		}
+	}
+
+	byNewPath := func(a, b *DatabaseSnapshotDiff_EntityChange) int {
+		return cmpPathThenId(a.NewPath, a.New.Id, b.NewPath, b.New.Id)
+	}
+	slices.SortFunc(diff.AddedEntities, byNewPath)
+	slices.SortFunc(diff.MovedEntities, byNewPath)
+	slices.SortFunc(diff.ReorderedEntities, byNewPath)
+	slices.SortFunc(diff.RemovedEntities, func(a, b *DatabaseSnapshotDiff_EntityChange) int {
+		return cmpPathThenId(a.OldPath, a.Old.Id, b.OldPath, b.Old.Id)
+	})
+
+	diff.ChangedFields = diffSnapshotFields(old, new, newEntities, newPaths)
+
+	oldEntitySchemas := map[string]*DatabaseEntitySchema{}
+	for _, schema := range old.GetEntitySchemas() {
+		oldEntitySchemas[schema.Name] = schema
+	}
+
+	newEntitySchemas := map[string]*DatabaseEntitySchema{}
+	for _, schema := range new.GetEntitySchemas() {
+		newEntitySchemas[schema.Name] = schema
+	}
+
+	for _, name := range sortedKeys(mergeKeys(oldEntitySchemas, newEntitySchemas)) {
+		if !proto.Equal(oldEntitySchemas[name], newEntitySchemas[name]) {
+			diff.EntitySchemaChanges = append(diff.EntitySchemaChanges, &DatabaseSnapshotDiff_EntitySchemaChange{
+				Old: oldEntitySchemas[name],
+				New: newEntitySchemas[name],
+			})
+		}
+	}
+
+	oldFieldSchemas := map[string]*DatabaseFieldSchema{}
+	for _, schema := range old.GetFieldSchemas() {
+		oldFieldSchemas[schema.Name] = schema
+	}
+
+	newFieldSchemas := map[string]*DatabaseFieldSchema{}
+	for _, schema := range new.GetFieldSchemas() {
+		newFieldSchemas[schema.Name] = schema
+	}
+
+	for _, name := range sortedKeys(mergeKeys(oldFieldSchemas, newFieldSchemas)) {
+		if !proto.Equal(oldFieldSchemas[name], newFieldSchemas[name]) {
+			diff.FieldSchemaChanges = append(diff.FieldSchemaChanges, &DatabaseSnapshotDiff_FieldSchemaChange{
+				Old: oldFieldSchemas[name],
+				New: newFieldSchemas[name],
+			})
+		}
+	}
+
+	return diff
+}
+
+// childrenReordered reports whether the children present in both lists are in a different order
+func childrenReordered(old, new []*EntityReference) bool {
+	inOld := map[string]bool{}
+	for _, child := range old {
+		inOld[child.Raw] = true
+	}
+
+	inNew := map[string]bool{}
+	for _, child := range new {
+		inNew[child.Raw] = true
+	}
+
+	kept := []string{}
+	for _, child := range old {
+		if inNew[child.Raw] {
+			kept = append(kept, child.Raw)
+		}
+	}
+
+	i := 0
+	for _, child := range new {
+		if !inOld[child.Raw] {
+			continue
+		}
+
+		if i >= len(kept) || kept[i] != child.Raw {
+			return true
+		}
+		i++
+	}
+
+	return false
+}
+
+func diffSnapshotFields(old, new *DatabaseSnapshot, newEntities map[string]*DatabaseEntity, paths map[string]string) []*DatabaseSnapshotDiff_FieldChange {
+	oldFields := map[string]*DatabaseField{}
+	for _, field := range old.GetFields() {
+		if _, ok := newEntities[field.Id]; ok {
+			oldFields[field.Id+":"+field.Name] = field
+		}
+	}
+
+	newFields := map[string]*DatabaseField{}
+	for _, field := range new.GetFields() {
+		newFields[field.Id+":"+field.Name] = field
+	}
+
+	changes := []*DatabaseSnapshotDiff_FieldChange{}
+	for key := range mergeKeys(oldFields, newFields) {
+		oldField, newField := oldFields[key], newFields[key]
+		if oldField != nil && newField != nil && sameValue(oldField.Value, newField.Value) {
+			continue
+		}
+
+		entityId, fieldName, _ := strings.Cut(key, ":")
+		changes = append(changes, &DatabaseSnapshotDiff_FieldChange{
+			EntityId: entityId,
+			Path:     paths[entityId],
+			Field:    fieldName,
+			OldValue: oldField.GetValue(),
+			NewValue: newField.GetValue(),
+		})
+	}
+
+	slices.SortFunc(changes, func(a, b *DatabaseSnapshotDiff_FieldChange) int {
+		if c := cmpPathThenId(a.Path, a.EntityId, b.Path, b.EntityId); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	return changes
+}
+
+// mergeKeys returns the keys present in either map
+func mergeKeys[V any](a, b map[string]V) map[string]bool {
+	keys := map[string]bool{}
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+
+	return keys
+}
+
+// DiffDatabase reports what restoring a snapshot would change in the database
+func DiffDatabase(db IDatabase, snapshot *DatabaseSnapshot) *DatabaseSnapshotDiff {
+	return DiffSnapshots(db.CreateSnapshot(), snapshot)
+}
+
+// DiffSnapshotFromRequest answers a web diff request
+func DiffSnapshotFromRequest(db IDatabase, request *WebConfigDiffSnapshotRequest) *DatabaseSnapshotDiff {
+	if request.Old == nil {
+		return DiffDatabase(db, request.New)
+	}
+
+	return DiffSnapshots(request.Old, request.New)
+}
+
+// IsEmpty reports whether the snapshots are the same
+func (d *DatabaseSnapshotDiff) IsEmpty() bool {
+	return len(d.AddedEntities) == 0 && len(d.RemovedEntities) == 0 && len(d.MovedEntities) == 0 &&
+		len(d.ReorderedEntities) == 0 && len(d.ChangedFields) == 0 && len(d.EntitySchemaChanges) == 0 &&
+		len(d.FieldSchemaChanges) == 0
+}
+
+// Text renders the diff one change per line: '+' for additions, '-' for removals and '~' for changes
+func (d *DatabaseSnapshotDiff) Text() string {
+	var b strings.Builder
+
+	for _, change := range d.EntitySchemaChanges {
+		switch {
+		case change.Old == nil:
+			fmt.Fprintf(&b, "+ entity schema %s: %s\n", change.New.Name, strings.Join(change.New.Fields, ", "))
+		case change.New == nil:
+			fmt.Fprintf(&b, "- entity schema %s\n", change.Old.Name)
+		default:
+			fmt.Fprintf(&b, "~ entity schema %s: %s -> %s\n", change.New.Name, strings.Join(change.Old.Fields, ", "), strings.Join(change.New.Fields, ", "))
+		}
+	}
+
+	for _, change := range d.FieldSchemaChanges {
+		switch {
+		case change.Old == nil:
+			fmt.Fprintf(&b, "+ field schema %s (%s)\n", change.New.Name, change.New.Type)
+		case change.New == nil:
+			fmt.Fprintf(&b, "- field schema %s\n", change.Old.Name)
+		case change.Old.Type != change.New.Type:
+			fmt.Fprintf(&b, "~ field schema %s: %s -> %s\n", change.New.Name, change.Old.Type, change.New.Type)
+		default:
+			fmt.Fprintf(&b, "~ field schema %s\n", change.New.Name)
+		}
+	}
+
+	for _, change := range d.AddedEntities {
+		fmt.Fprintf(&b, "+ entity %s (%s, %s)\n", diffPath(change.NewPath, change.New.Id), change.New.Type, change.New.Id)
+	}
+
+	for _, change := range d.RemovedEntities {
+		fmt.Fprintf(&b, "- entity %s (%s, %s)\n", diffPath(change.OldPath, change.Old.Id), change.Old.Type, change.Old.Id)
+	}
+
+	for _, change := range d.MovedEntities {
+		fmt.Fprintf(&b, "~ entity %s -> %s\n", diffPath(change.OldPath, change.Old.Id), diffPath(change.NewPath, change.New.Id))
+	}
+
+	for _, change := range d.ReorderedEntities {
+		fmt.Fprintf(&b, "~ children of %s reordered\n", diffPath(change.NewPath, change.New.Id))
+	}
+
+	for _, change := range d.ChangedFields {
+		fmt.Fprintf(&b, "~ field %s.%s: %s -> %s\n", diffPath(change.Path, change.EntityId), change.Field, diffValueText(change.OldValue), diffValueText(change.NewValue))
+	}
+
+	return b.String()
+}
+
+// diffPath falls back to the id of entities whose path cannot be computed from the snapshot
+func diffPath(path, entityId string) string {
+	if path == "" {
+		return entityId
+	}
+
+	return path
+}
+
+func diffValueText(value *anypb.Any) string {
+	if value == nil {
+		return "(none)"
+	}
+
+	_, v, err := encodeSnapshotValue(value, nil, map[string]string{})
+	if err != nil {
+		return value.TypeUrl
+	}
+
+	switch v := v.(type) {
+	case *SnapshotDocumentReference:
+		return v.Id
+	case []*SnapshotDocumentReference:
+		ids := []string{}
+		for _, reference := range v {
+			ids = append(ids, reference.Id)
+		}
+		b, _ := json.Marshal(ids)
+		return string(b)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,704 @@
+package qdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+// SnapshotDocument is the human-readable form of a DatabaseSnapshot, used for the JSON and
+// YAML serializations. Entities are listed parents first with their paths, and field values
+// are rendered as plain JSON/YAML values according to their type:
+//
+//	qdb.Int, qdb.Float, qdb.String, qdb.Bool    number, string or boolean
+//	qdb.Enum                                    the enum value name if the schema has one, otherwise a number
+//	qdb.Timestamp                               RFC 3339 string, or null if unset
+//	qdb.EntityReference                         {id, path}
+//	qdb.IntList, qdb.StringList, qdb.StringMap  list or map
+//	qdb.EntityReferenceList                     list of {id, path}
+//	anything else                               the protobuf JSON mapping of the message
+//
+// Paths are informational on export. On import, a reference with a path but no id is
+// resolved against the paths of the document's entities.
+type SnapshotDocument struct {
+	EntitySchemas []map[string]any          `json:"entitySchemas" yaml:"entitySchemas"`
+	FieldSchemas  []map[string]any          `json:"fieldSchemas" yaml:"fieldSchemas"`
+	Entities      []*SnapshotDocumentEntity `json:"entities" yaml:"entities"`
+}
+
+type SnapshotDocumentEntity struct {
+	Id       string                            `json:"id" yaml:"id"`
+	Path     string                            `json:"path,omitempty" yaml:"path,omitempty"`
+	Type     string                            `json:"type" yaml:"type"`
+	Name     string                            `json:"name" yaml:"name"`
+	Parent   string                            `json:"parent,omitempty" yaml:"parent,omitempty"`
+	Children []string                          `json:"children,omitempty" yaml:"children,omitempty"`
+	Fields   map[string]*SnapshotDocumentField `json:"fields,omitempty" yaml:"fields,omitempty"`
+}
+
+type SnapshotDocumentField struct {
+	Type      string `json:"type" yaml:"type"`
+	Value     any    `json:"value" yaml:"value"`
+	WriteTime string `json:"writeTime,omitempty" yaml:"writeTime,omitempty"`
+	WriterId  string `json:"writerId,omitempty" yaml:"writerId,omitempty"`
+	Computed  bool   `json:"computed,omitempty" yaml:"computed,omitempty"`
+}
+
+type SnapshotDocumentReference struct {
+	Id   string `json:"id" yaml:"id"`
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// MarshalSnapshotJSON renders a snapshot as canonical, indented JSON
+func MarshalSnapshotJSON(snapshot *DatabaseSnapshot) ([]byte, error) {
+	document, err := NewSnapshotDocument(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
+
+// UnmarshalSnapshotJSON parses a snapshot rendered by MarshalSnapshotJSON
+func UnmarshalSnapshotJSON(data []byte) (*DatabaseSnapshot, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	document := &SnapshotDocument{}
+	if err := decoder.Decode(document); err != nil {
+		return nil, err
+	}
+
+	return document.ToSnapshot()
+}
+
+// MarshalSnapshotYAML renders a snapshot as canonical YAML
+func MarshalSnapshotYAML(snapshot *DatabaseSnapshot) ([]byte, error) {
+	document, err := NewSnapshotDocument(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalSnapshotYAML parses a snapshot rendered by MarshalSnapshotYAML
+func UnmarshalSnapshotYAML(data []byte) (*DatabaseSnapshot, error) {
+	document := &SnapshotDocument{}
+	if err := yaml.Unmarshal(data, document); err != nil {
+		return nil, err
+	}
+
+	return document.ToSnapshot()
+}
+
+// NewSnapshotDocument converts a snapshot to its human-readable form.
+// Fields of entities missing from the snapshot are dropped.
+func NewSnapshotDocument(snapshot *DatabaseSnapshot) (*SnapshotDocument, error) {
+	document := &SnapshotDocument{
+		EntitySchemas: []map[string]any{},
+		FieldSchemas:  []map[string]any{},
+		Entities:      []*SnapshotDocumentEntity{},
+	}
+
+	for _, schema := range sortedByName(snapshot.EntitySchemas, (*DatabaseEntitySchema).GetName) {
+		m, err := protoToMap(schema)
+		if err != nil {
+			return nil, fmt.Errorf("entity schema %s: %v", schema.Name, err)
+		}
+		document.EntitySchemas = append(document.EntitySchemas, m)
+	}
+
+	fieldSchemas := map[string]*DatabaseFieldSchema{}
+	for _, schema := range sortedByName(snapshot.FieldSchemas, (*DatabaseFieldSchema).GetName) {
+		fieldSchemas[schema.Name] = schema
+
+		m, err := protoToMap(schema)
+		if err != nil {
+			return nil, fmt.Errorf("field schema %s: %v", schema.Name, err)
+		}
+		document.FieldSchemas = append(document.FieldSchemas, m)
+	}
+
+	entities := map[string]*DatabaseEntity{}
+	for _, entity := range snapshot.Entities {
+		entities[entity.Id] = entity
+	}
+	paths := snapshotEntityPaths(entities)
+
+	documentEntities := map[string]*SnapshotDocumentEntity{}
+	for _, entity := range orderSnapshotEntities(entities, paths) {
+		documentEntity := &SnapshotDocumentEntity{
+			Id:     entity.Id,
+			Path:   paths[entity.Id],
+			Type:   entity.Type,
+			Name:   entity.Name,
+			Parent: entity.Parent.GetRaw(),
+		}
+
+		for _, child := range entity.Children {
+			documentEntity.Children = append(documentEntity.Children, child.Raw)
+		}
+
+		documentEntities[entity.Id] = documentEntity
+		document.Entities = append(document.Entities, documentEntity)
+	}
+
+	for _, field := range snapshot.Fields {
+		documentEntity, ok := documentEntities[field.Id]
+		if !ok {
+			Warn("[NewSnapshotDocument] Dropping field %s of entity %s missing from the snapshot", field.Name, field.Id)
+			continue
+		}
+
+		documentField := &SnapshotDocumentField{
+			WriterId: field.WriterId,
+			Computed: field.Computed,
+		}
+
+		if field.WriteTime != nil {
+			documentField.WriteTime = field.WriteTime.AsTime().UTC().Format(time.RFC3339Nano)
+		}
+
+		if field.Value != nil {
+			typeName, value, err := encodeSnapshotValue(field.Value, fieldSchemas[field.Name], paths)
+			if err != nil {
+				return nil, fmt.Errorf("field %s of entity %s: %v", field.Name, field.Id, err)
+			}
+			documentField.Type = typeName
+			documentField.Value = value
+		}
+
+		if documentEntity.Fields == nil {
+			documentEntity.Fields = map[string]*SnapshotDocumentField{}
+		}
+		documentEntity.Fields[field.Name] = documentField
+	}
+
+	return document, nil
+}
+
+// ToSnapshot converts the document back to a snapshot
+func (d *SnapshotDocument) ToSnapshot() (*DatabaseSnapshot, error) {
+	snapshot := &DatabaseSnapshot{}
+
+	for _, m := range d.EntitySchemas {
+		schema := &DatabaseEntitySchema{}
+		if err := mapToProto(m, schema); err != nil {
+			return nil, fmt.Errorf("entity schema: %v", err)
+		}
+		snapshot.EntitySchemas = append(snapshot.EntitySchemas, schema)
+	}
+
+	fieldSchemas := map[string]*DatabaseFieldSchema{}
+	for _, m := range d.FieldSchemas {
+		schema := &DatabaseFieldSchema{}
+		if err := mapToProto(m, schema); err != nil {
+			return nil, fmt.Errorf("field schema: %v", err)
+		}
+		fieldSchemas[schema.Name] = schema
+		snapshot.FieldSchemas = append(snapshot.FieldSchemas, schema)
+	}
+
+	ids := map[string]string{}
+	for _, entity := range d.Entities {
+		if entity.Path != "" {
+			ids[entity.Path] = entity.Id
+		}
+	}
+
+	for _, documentEntity := range d.Entities {
+		if documentEntity.Id == "" {
+			return nil, fmt.Errorf("entity %s has no id", documentEntity.Path)
+		}
+
+		entity := &DatabaseEntity{
+			Id:       documentEntity.Id,
+			Type:     documentEntity.Type,
+			Name:     documentEntity.Name,
+			Parent:   &EntityReference{Raw: documentEntity.Parent},
+			Children: []*EntityReference{},
+		}
+
+		for _, child := range documentEntity.Children {
+			entity.Children = append(entity.Children, &EntityReference{Raw: child})
+		}
+
+		snapshot.Entities = append(snapshot.Entities, entity)
+
+		names := make([]string, 0, len(documentEntity.Fields))
+		for name := range documentEntity.Fields {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+
+		for _, name := range names {
+			documentField := documentEntity.Fields[name]
+			field := &DatabaseField{
+				Id:       documentEntity.Id,
+				Name:     name,
+				WriterId: documentField.WriterId,
+				Computed: documentField.Computed,
+			}
+
+			if documentField.WriteTime != "" {
+				writeTime, err := time.Parse(time.RFC3339Nano, documentField.WriteTime)
+				if err != nil {
+					return nil, fmt.Errorf("field %s of entity %s: invalid write time: %v", name, documentEntity.Id, err)
+				}
+				field.WriteTime = timestamppb.New(writeTime)
+			}
+
+			if documentField.Type != "" {
+				value, err := decodeSnapshotValue(documentField.Type, documentField.Value, fieldSchemas[name], ids)
+				if err != nil {
+					return nil, fmt.Errorf("field %s of entity %s: %v", name, documentEntity.Id, err)
+				}
+				field.Value = value
+			}
+
+			snapshot.Fields = append(snapshot.Fields, field)
+		}
+	}
+
+	return snapshot, nil
+}
+
+func sortedByName[T any](items []T, name func(T) string) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return strings.Compare(name(a), name(b))
+	})
+
+	return sorted
+}
+
+// snapshotEntityPaths computes the path of every entity from the entities of the snapshot
+func snapshotEntityPaths(entities map[string]*DatabaseEntity) map[string]string {
+	paths := map[string]string{}
+
+	var pathOf func(entityId string, visited map[string]bool) string
+	pathOf = func(entityId string, visited map[string]bool) string {
+		if path, ok := paths[entityId]; ok {
+			return path
+		}
+
+		entity, ok := entities[entityId]
+		if !ok || visited[entityId] {
+			return ""
+		}
+		visited[entityId] = true
+
+		path := entity.Name
+		if parentId := entity.Parent.GetRaw(); parentId != "" {
+			parentPath := pathOf(parentId, visited)
+			if parentPath == "" {
+				return ""
+			}
+			path = parentPath + EntityPathSeparator + path
+		}
+
+		paths[entityId] = path
+		return path
+	}
+
+	for entityId := range entities {
+		pathOf(entityId, map[string]bool{})
+	}
+
+	return paths
+}
+
+// orderSnapshotEntities lists the entities parents first, following the order of children,
+// starting from the entities whose parent is not in the snapshot
+func orderSnapshotEntities(entities map[string]*DatabaseEntity, paths map[string]string) []*DatabaseEntity {
+	roots := []*DatabaseEntity{}
+	for _, entity := range entities {
+		if _, ok := entities[entity.Parent.GetRaw()]; !ok {
+			roots = append(roots, entity)
+		}
+	}
+
+	slices.SortFunc(roots, func(a, b *DatabaseEntity) int {
+		return cmpPathThenId(paths[a.Id], a.Id, paths[b.Id], b.Id)
+	})
+
+	ordered := []*DatabaseEntity{}
+	visited := map[string]bool{}
+
+	var visit func(entity *DatabaseEntity)
+	visit = func(entity *DatabaseEntity) {
+		if visited[entity.Id] {
+			return
+		}
+		visited[entity.Id] = true
+		ordered = append(ordered, entity)
+
+		for _, child := range entity.Children {
+			if c, ok := entities[child.Raw]; ok && c.Parent.GetRaw() == entity.Id {
+				visit(c)
+			}
+		}
+	}
+
+	for _, root := range roots {
+		visit(root)
+	}
+
+	// Entities that can't be reached from a root, such as those in a parent cycle
+	rest := []*DatabaseEntity{}
+	for _, entity := range entities {
+		if !visited[entity.Id] {
+			rest = append(rest, entity)
+		}
+	}
+
+	slices.SortFunc(rest, func(a, b *DatabaseEntity) int {
+		return cmpPathThenId(paths[a.Id], a.Id, paths[b.Id], b.Id)
+	})
+
+	for _, entity := range rest {
+		visit(entity)
+	}
+
+	return ordered
+}
+
+func cmpPathThenId(aPath, aId, bPath, bId string) int {
+	if c := strings.Compare(aPath, bPath); c != 0 {
+		return c
+	}
+
+	return strings.Compare(aId, bId)
+}
+
+func protoToMap(m proto.Message) (map[string]any, error) {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func mapToProto(value any, m proto.Message) error {
+	b, err := json.Marshal(normalizeYAML(value))
+	if err != nil {
+		return err
+	}
+
+	return protojson.Unmarshal(b, m)
+}
+
+// normalizeYAML converts the map[any]any values some YAML documents decode to into
+// map[string]any so that they can be encoded as JSON
+func normalizeYAML(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		m := map[string]any{}
+		for key, item := range v {
+			m[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+		return m
+	case map[string]any:
+		m := map[string]any{}
+		for key, item := range v {
+			m[key] = normalizeYAML(item)
+		}
+		return m
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = normalizeYAML(item)
+		}
+		return items
+	}
+
+	return value
+}
+
+func encodeSnapshotReference(entityId string, paths map[string]string) *SnapshotDocumentReference {
+	return &SnapshotDocumentReference{
+		Id:   entityId,
+		Path: paths[entityId],
+	}
+}
+
+func encodeSnapshotValue(value *anypb.Any, schema *DatabaseFieldSchema, paths map[string]string) (string, any, error) {
+	m, err := value.UnmarshalNew()
+	if err != nil {
+		return "", nil, err
+	}
+
+	typeName := string(m.ProtoReflect().Descriptor().FullName())
+
+	switch v := m.(type) {
+	case *Int:
+		return typeName, v.Raw, nil
+	case *Float:
+		if math.IsNaN(v.Raw) || math.IsInf(v.Raw, 0) {
+			return typeName, strconv.FormatFloat(v.Raw, 'g', -1, 64), nil
+		}
+		return typeName, v.Raw, nil
+	case *String:
+		return typeName, v.Raw, nil
+	case *Bool:
+		return typeName, v.Raw, nil
+	case *Enum:
+		if schema != nil {
+			if name, ok := schema.EnumName(v.Raw); ok {
+				return typeName, name, nil
+			}
+		}
+		return typeName, v.Raw, nil
+	case *Timestamp:
+		if v.Raw == nil {
+			return typeName, nil, nil
+		}
+		return typeName, v.Raw.AsTime().UTC().Format(time.RFC3339Nano), nil
+	case *EntityReference:
+		return typeName, encodeSnapshotReference(v.Raw, paths), nil
+	case *EntityReferenceList:
+		references := []*SnapshotDocumentReference{}
+		for _, entityId := range v.Raw {
+			references = append(references, encodeSnapshotReference(entityId, paths))
+		}
+		return typeName, references, nil
+	case *IntList:
+		return typeName, slices.Clone(v.Raw), nil
+	case *StringList:
+		return typeName, slices.Clone(v.Raw), nil
+	case *StringMap:
+		return typeName, v.Raw, nil
+	}
+
+	generic, err := protoToMap(m)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return typeName, generic, nil
+}
+
+func decodeSnapshotValue(typeName string, value any, schema *DatabaseFieldSchema, ids map[string]string) (*anypb.Any, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(typeName))
+	if err != nil {
+		return nil, fmt.Errorf("unknown type %s", typeName)
+	}
+
+	m := messageType.New().Interface()
+
+	switch v := m.(type) {
+	case *Int:
+		v.Raw, err = snapshotInt(value)
+	case *Float:
+		v.Raw, err = snapshotFloat(value)
+	case *String:
+		v.Raw, err = snapshotString(value)
+	case *Bool:
+		b, ok := value.(bool)
+		if !ok {
+			err = fmt.Errorf("expected a boolean, got %v", value)
+		}
+		v.Raw = b
+	case *Enum:
+		if name, ok := value.(string); ok && schema != nil {
+			if raw, found := schema.EnumValue(name); found {
+				v.Raw = raw
+				break
+			}
+		}
+		v.Raw, err = snapshotInt(value)
+	case *Timestamp:
+		var s string
+		if s, err = snapshotString(value); err == nil && s != "" {
+			var t time.Time
+			if t, err = time.Parse(time.RFC3339Nano, s); err == nil {
+				v.Raw = timestamppb.New(t)
+			}
+		}
+	case *EntityReference:
+		v.Raw, err = snapshotReference(value, ids)
+	case *EntityReferenceList:
+		items, ok := value.([]any)
+		if !ok && value != nil {
+			err = fmt.Errorf("expected a list, got %v", value)
+		}
+		for _, item := range items {
+			var id string
+			if id, err = snapshotReference(item, ids); err != nil {
+				break
+			}
+			v.Raw = append(v.Raw, id)
+		}
+	case *IntList:
+		items, ok := value.([]any)
+		if !ok && value != nil {
+			err = fmt.Errorf("expected a list, got %v", value)
+		}
+		for _, item := range items {
+			var i int64
+			if i, err = snapshotInt(item); err != nil {
+				break
+			}
+			v.Raw = append(v.Raw, i)
+		}
+	case *StringList:
+		items, ok := value.([]any)
+		if !ok && value != nil {
+			err = fmt.Errorf("expected a list, got %v", value)
+		}
+		for _, item := range items {
+			var s string
+			if s, err = snapshotString(item); err != nil {
+				break
+			}
+			v.Raw = append(v.Raw, s)
+		}
+	case *StringMap:
+		items, ok := normalizeYAML(value).(map[string]any)
+		if !ok && value != nil {
+			err = fmt.Errorf("expected a map, got %v", value)
+		}
+		v.Raw = map[string]string{}
+		for key, item := range items {
+			var s string
+			if s, err = snapshotString(item); err != nil {
+				break
+			}
+			v.Raw[key] = s
+		}
+	default:
+		if value != nil {
+			err = mapToProto(value, m)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Deterministic, so map values encode the same way every time
+	encoded := &anypb.Any{}
+	if err := anypb.MarshalFrom(encoded, m, proto.MarshalOptions{Deterministic: true}); err != nil {
+		return nil, err
+	}
+
+	return encoded, nil
+}
+
+func snapshotInt(value any) (int64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Int64()
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint64:
+		return int64(v), nil
+	case float64:
+		if v == math.Trunc(v) {
+			return int64(v), nil
+		}
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	}
+
+	return 0, fmt.Errorf("expected an integer, got %v", value)
+}
+
+func snapshotFloat(value any) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+
+	return 0, fmt.Errorf("expected a number, got %v", value)
+}
+
+func snapshotString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano), nil
+	case nil:
+		return "", nil
+	}
+
+	return "", fmt.Errorf("expected a string, got %v", value)
+}
+
+// snapshotReference reads a {id, path} reference, resolving the path if there is no id
+func snapshotReference(value any, ids map[string]string) (string, error) {
+	switch v := normalizeYAML(value).(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	case map[string]any:
+		if id, _ := v["id"].(string); id != "" {
+			return id, nil
+		}
+
+		if path, _ := v["path"].(string); path != "" {
+			id, ok := ids[path]
+			if !ok {
+				return "", fmt.Errorf("no entity has path %s", path)
+			}
+			return id, nil
+		}
+
+		return "", nil
+	}
+
+	return "", fmt.Errorf("expected a reference, got %v", value)
+}
@@ -0,0 +1,292 @@
+package qdb
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// SnapshotMergeOptions controls how MergeSnapshot applies a snapshot.
+// Prune deletes the entities that are not in the snapshot. SuppressNotifications also
+// suppresses entity lifecycle events. DryRun only reports what the merge would change.
+type SnapshotMergeOptions struct {
+	Prune                   bool
+	SuppressNotifications   bool
+	SuppressTransformations bool
+	DryRun                  bool
+}
+
+// HasChanges reports whether the merge changed, or would change, anything
+func (s *DatabaseSnapshotMergeSummary) HasChanges() bool {
+	return len(s.CreatedEntitySchemas) > 0 || len(s.UpdatedEntitySchemas) > 0 ||
+		len(s.CreatedFieldSchemas) > 0 || len(s.UpdatedFieldSchemas) > 0 ||
+		len(s.CreatedEntities) > 0 || len(s.UpdatedEntities) > 0 ||
+		len(s.PrunedEntities) > 0 || len(s.WrittenFields) > 0
+}
+
+// MergeSnapshot upserts the schemas, entities and fields of a snapshot. Unlike RestoreSnapshot,
+// keys the snapshot does not own are left alone: notification configs, temp keys and, unless
+// pruning, entities missing from the snapshot. Fields already holding the snapshot value are not written.
+func (db *RedisDatabase) MergeSnapshot(snapshot *DatabaseSnapshot, options SnapshotMergeOptions) *DatabaseSnapshotMergeSummary {
+	summary := &DatabaseSnapshotMergeSummary{}
+
+	if !options.DryRun {
+		db.muteNotifications = options.SuppressNotifications
+		db.muteTransformations = options.SuppressTransformations
+		defer func() {
+			db.muteNotifications = false
+			db.muteTransformations = false
+		}()
+	}
+
+	// Field schemas go first, since setting an entity schema initializes its new fields
+	fieldSchemas := map[string]*DatabaseFieldSchema{}
+	for _, schema := range db.GetFieldSchemas() {
+		fieldSchemas[schema.Name] = schema
+	}
+
+	for _, schema := range snapshot.FieldSchemas {
+		if existing, ok := fieldSchemas[schema.Name]; !ok {
+			summary.CreatedFieldSchemas = append(summary.CreatedFieldSchemas, schema.Name)
+		} else if !proto.Equal(existing, schema) {
+			summary.UpdatedFieldSchemas = append(summary.UpdatedFieldSchemas, schema.Name)
+		} else {
+			continue
+		}
+
+		fieldSchemas[schema.Name] = schema
+		if !options.DryRun {
+			db.SetFieldSchema(schema.Name, schema)
+		}
+	}
+
+	for _, schema := range snapshot.EntitySchemas {
+		if existing := db.GetEntitySchema(schema.Name); existing == nil {
+			summary.CreatedEntitySchemas = append(summary.CreatedEntitySchemas, schema.Name)
+		} else if !proto.Equal(existing, schema) {
+			summary.UpdatedEntitySchemas = append(summary.UpdatedEntitySchemas, schema.Name)
+		} else {
+			continue
+		}
+
+		if !options.DryRun {
+			db.SetEntitySchema(schema.Name, schema)
+		}
+	}
+
+	inSnapshot := map[string]bool{}
+	for _, entity := range snapshot.Entities {
+		inSnapshot[entity.Id] = true
+	}
+
+	pruned := map[string]bool{}
+	if options.Prune {
+		pruned = db.prunedBySnapshot(inSnapshot)
+		summary.PrunedEntities = sortedKeys(pruned)
+
+		if !options.DryRun {
+			db.pruneEntities(pruned)
+		}
+	}
+
+	ids := make([]string, len(snapshot.Entities))
+	for i, entity := range snapshot.Entities {
+		ids[i] = entity.Id
+	}
+
+	created := map[string]bool{}
+	for i, existing := range db.GetEntities(ids) {
+		entity := snapshot.Entities[i]
+
+		if existing == nil {
+			created[entity.Id] = true
+			summary.CreatedEntities = append(summary.CreatedEntities, entity.Id)
+
+			if !options.DryRun {
+				db.SetEntity(entity.Id, entity)
+				db.client.SAdd(context.Background(), db.keygen.GetEntityTypeKey(entity.Type), entity.Id)
+				db.publishEntityEvent(DatabaseEntityEvent_CREATED, entity, nil)
+			}
+
+			continue
+		}
+
+		// Children that only exist in the database are kept, so they are not left dangling
+		merged := proto.Clone(entity).(*DatabaseEntity)
+		for _, child := range existing.Children {
+			if !inSnapshot[child.Raw] && !pruned[child.Raw] && !slices.ContainsFunc(merged.Children, func(c *EntityReference) bool { return c.Raw == child.Raw }) {
+				merged.Children = append(merged.Children, child)
+			}
+		}
+
+		if proto.Equal(existing, merged) {
+			continue
+		}
+
+		summary.UpdatedEntities = append(summary.UpdatedEntities, entity.Id)
+
+		if !options.DryRun {
+			db.SetEntity(entity.Id, merged)
+			if existing.Type != merged.Type {
+				db.client.SRem(context.Background(), db.keygen.GetEntityTypeKey(existing.Type), entity.Id)
+				db.client.SAdd(context.Background(), db.keygen.GetEntityTypeKey(merged.Type), entity.Id)
+			}
+		}
+	}
+
+	summary.WrittenFields = db.mergeSnapshotFields(snapshot, fieldSchemas, created, options.DryRun)
+
+	return summary
+}
+
+// prunedBySnapshot returns the entities of the database that are not in the snapshot
+func (db *RedisDatabase) prunedBySnapshot(inSnapshot map[string]bool) map[string]bool {
+	pruned := map[string]bool{}
+
+	for _, entityType := range db.GetEntityTypes() {
+		for _, entityId := range db.FindEntities(entityType) {
+			if !inSnapshot[entityId] {
+				pruned[entityId] = true
+			}
+		}
+	}
+
+	return pruned
+}
+
+// pruneEntities deletes the topmost pruned entities, which deletes the rest with their subtree
+func (db *RedisDatabase) pruneEntities(pruned map[string]bool) {
+	ids := sortedKeys(pruned)
+	entities := db.GetEntities(ids)
+
+	for i, entity := range entities {
+		if entity == nil || pruned[entity.Parent.GetRaw()] {
+			continue
+		}
+
+		if db.EntityExists(ids[i]) {
+			db.DeleteEntity(ids[i])
+		}
+	}
+}
+
+// mergeSnapshotFields writes the snapshot fields whose value differs from the database. The computed
+// fields of created entities are evaluated rather than written, once their other fields are in place,
+// and their fields missing from the snapshot are initialized.
+func (db *RedisDatabase) mergeSnapshotFields(snapshot *DatabaseSnapshot, fieldSchemas map[string]*DatabaseFieldSchema, created map[string]bool, dryRun bool) []*DatabaseField {
+	written := []*DatabaseField{}
+	inSnapshot := map[string]bool{}
+
+	requests := make([]*DatabaseRequest, len(snapshot.Fields))
+	for i, field := range snapshot.Fields {
+		inSnapshot[field.Id+":"+field.Name] = true
+		requests[i] = &DatabaseRequest{
+			Id:    field.Id,
+			Field: field.Name,
+		}
+	}
+
+	if !dryRun {
+		db.Read(requests)
+	} else {
+		// Entities created by the merge do not exist yet
+		existing := []*DatabaseRequest{}
+		for _, request := range requests {
+			if !created[request.Id] {
+				existing = append(existing, request)
+			}
+		}
+		db.Read(existing)
+	}
+
+	for i, field := range snapshot.Fields {
+		schema := fieldSchemas[field.Name]
+		if schema == nil {
+			Error("[RedisDatabase::MergeSnapshot] Failed to get field schema for %s", field.Name)
+			continue
+		}
+
+		if schema.Computed != nil {
+			continue
+		}
+
+		if requests[i].Success && sameValue(requests[i].Value, field.Value) {
+			continue
+		}
+
+		written = append(written, field)
+
+		if !dryRun {
+			db.Write([]*DatabaseRequest{
+				{
+					Id:        field.Id,
+					Field:     field.Name,
+					Value:     field.Value,
+					WriteTime: &Timestamp{Raw: field.WriteTime},
+					WriterId:  &String{Raw: field.WriterId},
+				},
+			})
+		}
+	}
+
+	if dryRun {
+		return written
+	}
+
+	for _, entity := range snapshot.Entities {
+		if !created[entity.Id] {
+			continue
+		}
+
+		schema := db.GetEntitySchema(entity.Type)
+		if schema == nil {
+			Error("[RedisDatabase::MergeSnapshot] Failed to get entity schema for type %s", entity.Type)
+			continue
+		}
+
+		for _, fieldName := range schema.Fields {
+			if !inSnapshot[entity.Id+":"+fieldName] || fieldSchemas[fieldName].GetComputed() != nil {
+				db.Write([]*DatabaseRequest{{Id: entity.Id, Field: fieldName}})
+			}
+		}
+	}
+
+	return written
+}
+
+// sameValue compares the messages held by two values, since the encoding of maps is not deterministic
+func sameValue(a, b *anypb.Any) bool {
+	if a.GetTypeUrl() != b.GetTypeUrl() {
+		return false
+	}
+
+	am, err := a.UnmarshalNew()
+	if err != nil {
+		return false
+	}
+
+	bm, err := b.UnmarshalNew()
+	if err != nil {
+		return false
+	}
+
+	return proto.Equal(am, bm)
+}
+
+// RestoreSnapshotFromRequest answers a web restore request, merging the snapshot if requested.
+// The summary is nil when the database was replaced by the snapshot.
+func RestoreSnapshotFromRequest(db IDatabase, request *WebConfigRestoreSnapshotRequest) *DatabaseSnapshotMergeSummary {
+	if !request.Merge {
+		db.RestoreSnapshot(request.Snapshot)
+		return nil
+	}
+
+	return db.MergeSnapshot(request.Snapshot, SnapshotMergeOptions{
+		Prune:                   request.Prune,
+		SuppressNotifications:   request.SuppressNotifications,
+		SuppressTransformations: request.SuppressTransformations,
+		DryRun:                  request.DryRun,
+	})
+}
@@ -0,0 +1,244 @@
+package qdb
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// SnapshotScope selects the entities of a partial snapshot. An entity is included when it
+// matches every scope that is set, so an empty scope includes the whole database.
+type SnapshotScope struct {
+	RootId      string // The entity and its subtree
+	EntityTypes []string
+	Query       string // The entities a query returns
+}
+
+// scopedEntities returns the entities included by the scope, or nil when the scope is empty
+func (db *RedisDatabase) scopedEntities(scope SnapshotScope) map[string]bool {
+	var included map[string]bool
+
+	restrict := func(ids []string) {
+		matches := map[string]bool{}
+		for _, id := range ids {
+			if included == nil || included[id] {
+				matches[id] = true
+			}
+		}
+		included = matches
+	}
+
+	if scope.RootId != "" {
+		root := db.GetEntity(scope.RootId)
+		if root == nil {
+			Error("[RedisDatabase::scopedEntities] Failed to get root entity: %v", scope.RootId)
+			return map[string]bool{}
+		}
+
+		ids := []string{root.Id}
+		for _, descendant := range newEntityFromProto(db, root).GetDescendants(TraversalOptions{}) {
+			ids = append(ids, descendant.GetId())
+		}
+		restrict(ids)
+	}
+
+	if len(scope.EntityTypes) > 0 {
+		ids := []string{}
+		for _, entityType := range scope.EntityTypes {
+			ids = append(ids, db.FindEntities(entityType)...)
+		}
+		restrict(ids)
+	}
+
+	if scope.Query != "" {
+		rows, err := ExecuteQuery(db, scope.Query)
+		if err != nil {
+			Error("[RedisDatabase::scopedEntities] Failed to execute query '%s': %v", scope.Query, err)
+			return map[string]bool{}
+		}
+
+		ids := []string{}
+		for _, row := range rows {
+			ids = append(ids, row.EntityId)
+		}
+		restrict(ids)
+	}
+
+	return included
+}
+
+// CreatePartialSnapshot creates a snapshot of the entities in a scope, with only the schemas they use.
+// The children of an entity are limited to the entities in the snapshot.
+func (db *RedisDatabase) CreatePartialSnapshot(scope SnapshotScope) *DatabaseSnapshot {
+	snapshot := &DatabaseSnapshot{}
+	included := db.scopedEntities(scope)
+
+	usedEntityType := map[string]bool{}
+	usedFields := map[string]bool{}
+	for _, entityType := range db.GetEntityTypes() {
+		entitySchema := db.GetEntitySchema(entityType)
+		for _, entityId := range db.FindEntities(entityType) {
+			if included != nil && !included[entityId] {
+				continue
+			}
+
+			entity := db.GetEntity(entityId)
+			if entity == nil {
+				continue
+			}
+
+			if included != nil {
+				entity.Children = slices.DeleteFunc(entity.Children, func(child *EntityReference) bool {
+					return !included[child.Raw]
+				})
+			}
+
+			usedEntityType[entityType] = true
+			snapshot.Entities = append(snapshot.Entities, entity)
+			for _, fieldName := range entitySchema.Fields {
+				request := &DatabaseRequest{
+					Id:    entityId,
+					Field: fieldName,
+				}
+				db.Read([]*DatabaseRequest{request})
+				if request.Success {
+					snapshot.Fields = append(snapshot.Fields, new(DatabaseField).FromRequest(request))
+				}
+				usedFields[fieldName] = true
+			}
+		}
+
+		if usedEntityType[entityType] {
+			snapshot.EntitySchemas = append(snapshot.EntitySchemas, entitySchema)
+		}
+	}
+
+	for _, fieldSchema := range db.GetFieldSchemas() {
+		if usedFields[fieldSchema.Name] {
+			snapshot.FieldSchemas = append(snapshot.FieldSchemas, fieldSchema)
+		}
+	}
+
+	return snapshot
+}
+
+// RemapSnapshot gives the entities of a snapshot new ids. Entities whose parent is not in the
+// snapshot are moved under parentId, and references between entities of the snapshot follow
+// the new ids. It returns the remapped copy and the new id of each entity.
+func RemapSnapshot(snapshot *DatabaseSnapshot, parentId string) (*DatabaseSnapshot, map[string]string) {
+	remapped := proto.Clone(snapshot).(*DatabaseSnapshot)
+
+	ids := map[string]string{}
+	for _, entity := range remapped.Entities {
+		ids[entity.Id] = uuid.New().String()
+	}
+
+	for _, entity := range remapped.Entities {
+		entity.Id = ids[entity.Id]
+
+		if id, ok := ids[entity.Parent.GetRaw()]; ok {
+			entity.Parent = &EntityReference{Raw: id}
+		} else {
+			entity.Parent = &EntityReference{Raw: parentId}
+		}
+
+		children := []*EntityReference{}
+		for _, child := range entity.Children {
+			if id, ok := ids[child.Raw]; ok {
+				children = append(children, &EntityReference{Raw: id})
+			}
+		}
+		entity.Children = children
+	}
+
+	for _, field := range remapped.Fields {
+		if id, ok := ids[field.Id]; ok {
+			field.Id = id
+		}
+
+		field.Value = rewriteEntityReferences(field.Value, ids)
+	}
+
+	return remapped, ids
+}
+
+// ImportSnapshot copies the entities of a snapshot under a parent entity with new ids and returns
+// the new id of each entity. Schemas missing from the database are created, but existing schemas
+// are left alone, so importing cannot remove fields from entities already in the database.
+// Imported fields that the schema of their entity in the database does not have are skipped.
+func (db *RedisDatabase) ImportSnapshot(snapshot *DatabaseSnapshot, parentId string) map[string]string {
+	if parentId != "" && db.GetEntity(parentId) == nil {
+		Error("[RedisDatabase::ImportSnapshot] Failed to get parent entity: %v", parentId)
+		return nil
+	}
+
+	remapped, ids := RemapSnapshot(snapshot, parentId)
+
+	remapped.EntitySchemas = slices.DeleteFunc(remapped.EntitySchemas, func(schema *DatabaseEntitySchema) bool {
+		return db.GetEntitySchema(schema.Name) != nil
+	})
+
+	remapped.FieldSchemas = slices.DeleteFunc(remapped.FieldSchemas, func(schema *DatabaseFieldSchema) bool {
+		return db.GetFieldSchema(schema.Name) != nil
+	})
+
+	entitySchemas := map[string]*DatabaseEntitySchema{}
+	for _, schema := range remapped.EntitySchemas {
+		entitySchemas[schema.Name] = schema
+	}
+
+	entityTypes := map[string]string{}
+	for _, entity := range remapped.Entities {
+		entityTypes[entity.Id] = entity.Type
+		if _, ok := entitySchemas[entity.Type]; !ok {
+			entitySchemas[entity.Type] = db.GetEntitySchema(entity.Type)
+		}
+	}
+
+	remapped.Fields = slices.DeleteFunc(remapped.Fields, func(field *DatabaseField) bool {
+		entityType := entityTypes[field.Id]
+		if slices.Contains(entitySchemas[entityType].GetFields(), field.Name) {
+			return false
+		}
+
+		Warn("[RedisDatabase::ImportSnapshot] Skipping field %s of entity %s: entity type %s has no such field", field.Name, field.Id, entityType)
+		return true
+	})
+
+	db.MergeSnapshot(remapped, SnapshotMergeOptions{})
+
+	if parentId != "" {
+		db.adoptImportedEntities(parentId, remapped.Entities)
+	}
+
+	return ids
+}
+
+// adoptImportedEntities adds the imported entities placed directly under a parent to its children
+func (db *RedisDatabase) adoptImportedEntities(parentId string, entities []*DatabaseEntity) {
+	err := db.transact(func(tx *redis.Tx) error {
+		parent, err := db.getEntityInTx(tx, parentId)
+		if err != nil {
+			return err
+		}
+
+		for _, entity := range entities {
+			if entity.Parent.GetRaw() == parentId {
+				parent.Children = append(parent.Children, &EntityReference{Raw: entity.Id})
+			}
+		}
+
+		_, err = tx.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			return db.setEntitiesInTx(pipe, parent)
+		})
+
+		return err
+	}, db.keygen.GetEntityKey(parentId))
+
+	if err != nil {
+		Error("[RedisDatabase::ImportSnapshot] Failed to add imported entities to the children of %s: %v", parentId, err)
+	}
+}
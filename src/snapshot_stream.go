@@ -0,0 +1,389 @@
+package qdb
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"slices"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// SnapshotStreamBatchSize is the number of entities read or restored per round trip when streaming
+const SnapshotStreamBatchSize = 100
+
+type SnapshotProgress struct {
+	Entities      int64
+	TotalEntities int64
+	Fields        int64
+}
+
+type SnapshotProgressFunc func(SnapshotProgress)
+
+// ErrIncompleteSnapshotStream is returned when a streamed snapshot ends without its trailer
+var ErrIncompleteSnapshotStream = errors.New("snapshot stream ended without a trailer")
+
+// CreateSnapshotStream writes a snapshot as length-delimited DatabaseSnapshotRecords: a header,
+// the schemas in use, then each entity followed by its fields, and a trailer with the counts and
+// checksum of the records. Entities are read in batches with a single round trip for the entities
+// and one for their fields, so the snapshot is never held in memory. Web clients still exchange
+// whole DatabaseSnapshots, since a web message carries a single protobuf.
+func (db *RedisDatabase) CreateSnapshotStream(w io.Writer, progress SnapshotProgressFunc) bool {
+	bw := bufio.NewWriter(w)
+	checksum := sha256.New()
+	out := io.MultiWriter(bw, checksum)
+	write := func(record *DatabaseSnapshotRecord) bool {
+		if _, err := protodelim.MarshalTo(out, record); err != nil {
+			Error("[RedisDatabase::CreateSnapshotStream] Failed to write record: %v", err)
+			return false
+		}
+		return true
+	}
+
+	status := SnapshotProgress{}
+	entitySchemas := []*DatabaseEntitySchema{}
+	usedFields := map[string]bool{}
+
+	entityTypes := db.GetEntityTypes()
+	slices.Sort(entityTypes)
+	for _, entityType := range entityTypes {
+		count, err := db.client.SCard(context.Background(), db.keygen.GetEntityTypeKey(entityType)).Result()
+		if err != nil {
+			Error("[RedisDatabase::CreateSnapshotStream] Failed to count entities of type %s: %v", entityType, err)
+			return false
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		schema := db.GetEntitySchema(entityType)
+		if schema == nil {
+			return false
+		}
+
+		entitySchemas = append(entitySchemas, schema)
+		status.TotalEntities += count
+		for _, fieldName := range schema.Fields {
+			usedFields[fieldName] = true
+		}
+	}
+
+	if !write(&DatabaseSnapshotRecord{Header: &DatabaseSnapshotRecord_Header{EntityCount: status.TotalEntities}}) {
+		return false
+	}
+
+	for _, schema := range entitySchemas {
+		if !write(&DatabaseSnapshotRecord{EntitySchema: schema}) {
+			return false
+		}
+	}
+
+	for _, schema := range db.GetFieldSchemas() {
+		if usedFields[schema.Name] && !write(&DatabaseSnapshotRecord{FieldSchema: schema}) {
+			return false
+		}
+	}
+
+	for _, schema := range entitySchemas {
+		// Scanning a set can return an entity more than once
+		seen := map[string]bool{}
+
+		for cursor := ""; ; {
+			page, next := db.FindEntitiesPage(schema.Name, cursor, SnapshotStreamBatchSize)
+
+			ids := slices.DeleteFunc(page, func(id string) bool { return seen[id] })
+			for _, id := range ids {
+				seen[id] = true
+			}
+
+			entities := db.GetEntities(ids)
+			requests := []*DatabaseRequest{}
+			entityRequests := map[string][]*DatabaseRequest{}
+			for _, entity := range entities {
+				if entity == nil {
+					continue
+				}
+
+				for _, fieldName := range schema.Fields {
+					request := &DatabaseRequest{
+						Id:    entity.Id,
+						Field: fieldName,
+					}
+					requests = append(requests, request)
+					entityRequests[entity.Id] = append(entityRequests[entity.Id], request)
+				}
+			}
+			db.Read(requests)
+
+			for _, entity := range entities {
+				if entity == nil {
+					continue
+				}
+
+				if !write(&DatabaseSnapshotRecord{Entity: entity}) {
+					return false
+				}
+				status.Entities++
+
+				for _, request := range entityRequests[entity.Id] {
+					if !request.Success {
+						continue
+					}
+
+					if !write(&DatabaseSnapshotRecord{Field: new(DatabaseField).FromRequest(request)}) {
+						return false
+					}
+					status.Fields++
+				}
+			}
+
+			if progress != nil {
+				progress(status)
+			}
+
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+	}
+
+	if !write(&DatabaseSnapshotRecord{Trailer: &DatabaseSnapshotRecord_Trailer{
+		EntityCount: status.Entities,
+		FieldCount:  status.Fields,
+		Checksum:    hex.EncodeToString(checksum.Sum(nil)),
+	}}) {
+		return false
+	}
+
+	if err := bw.Flush(); err != nil {
+		Error("[RedisDatabase::CreateSnapshotStream] Failed to flush snapshot: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// SnapshotStreamReader reads the records of a streamed snapshot one at a time. It checks the
+// trailer against the records read before it, so a stream that reads to io.EOF is complete.
+type SnapshotStreamReader struct {
+	r        *checksumReader
+	entities int64
+	fields   int64
+	trailer  bool
+}
+
+func NewSnapshotStreamReader(r io.Reader) *SnapshotStreamReader {
+	return &SnapshotStreamReader{
+		r: &checksumReader{r: bufio.NewReader(r), hash: sha256.New()},
+	}
+}
+
+// Next returns the next record, or io.EOF after the trailer. The trailer itself is not returned.
+func (r *SnapshotStreamReader) Next() (*DatabaseSnapshotRecord, error) {
+	checksum := hex.EncodeToString(r.r.hash.Sum(nil))
+
+	record := &DatabaseSnapshotRecord{}
+	if err := protodelim.UnmarshalFrom(r.r, record); err != nil {
+		if errors.Is(err, io.EOF) && !r.trailer {
+			return nil, ErrIncompleteSnapshotStream
+		}
+
+		return nil, err
+	}
+
+	if r.trailer {
+		return nil, errors.New("snapshot stream has records after its trailer")
+	}
+
+	switch {
+	case record.Entity != nil:
+		r.entities++
+	case record.Field != nil:
+		r.fields++
+	case record.Trailer != nil:
+		r.trailer = true
+
+		if record.Trailer.Checksum != checksum {
+			return nil, errors.New("snapshot stream checksum mismatch")
+		}
+
+		if record.Trailer.EntityCount != r.entities || record.Trailer.FieldCount != r.fields {
+			return nil, fmt.Errorf("snapshot stream has %d entities and %d fields, but its trailer expects %d and %d",
+				r.entities, r.fields, record.Trailer.EntityCount, record.Trailer.FieldCount)
+		}
+
+		return r.Next()
+	}
+
+	return record, nil
+}
+
+// checksumReader hashes exactly the bytes consumed from a buffered reader
+type checksumReader struct {
+	r    *bufio.Reader
+	hash hash.Hash
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.hash.Write(p[:n])
+	return n, err
+}
+
+func (r *checksumReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.hash.Write([]byte{b})
+	}
+	return b, err
+}
+
+// ReadSnapshotStream collects a streamed snapshot into a DatabaseSnapshot
+func ReadSnapshotStream(r io.Reader) (*DatabaseSnapshot, error) {
+	snapshot := &DatabaseSnapshot{}
+	reader := NewSnapshotStreamReader(r)
+
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return snapshot, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case record.EntitySchema != nil:
+			snapshot.EntitySchemas = append(snapshot.EntitySchemas, record.EntitySchema)
+		case record.FieldSchema != nil:
+			snapshot.FieldSchemas = append(snapshot.FieldSchemas, record.FieldSchema)
+		case record.Entity != nil:
+			snapshot.Entities = append(snapshot.Entities, record.Entity)
+		case record.Field != nil:
+			snapshot.Fields = append(snapshot.Fields, record.Field)
+		}
+	}
+}
+
+// RestoreSnapshotStream replaces the content of the database with a streamed snapshot, like
+// RestoreSnapshot. The stream is first copied to a temp file and checked against its trailer, so
+// a truncated or corrupt stream leaves the database untouched.
+func (db *RedisDatabase) RestoreSnapshotStream(r io.Reader, progress SnapshotProgressFunc) bool {
+	Info("[RedisDatabase::RestoreSnapshotStream] Restoring snapshot...")
+
+	spooled, err := spoolSnapshotStream(r)
+	if err != nil {
+		Error("[RedisDatabase::RestoreSnapshotStream] Failed to read snapshot: %v", err)
+		return false
+	}
+	defer os.Remove(spooled.Name())
+	defer spooled.Close()
+
+	if err := db.flush(); err != nil {
+		Error("[RedisDatabase::RestoreSnapshotStream] Failed to flush database: %v", err)
+		return false
+	}
+
+	status := SnapshotProgress{}
+	computed := []string{}
+	fields := []*DatabaseRequest{}
+	flush := func() {
+		db.Write(fields)
+		status.Fields += int64(len(fields))
+		fields = []*DatabaseRequest{}
+
+		if progress != nil {
+			progress(status)
+		}
+	}
+
+	reader := NewSnapshotStreamReader(spooled)
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			Error("[RedisDatabase::RestoreSnapshotStream] Failed to read record: %v", err)
+			return false
+		}
+
+		switch {
+		case record.Header != nil:
+			status.TotalEntities = record.Header.EntityCount
+		case record.EntitySchema != nil:
+			db.SetEntitySchema(record.EntitySchema.Name, record.EntitySchema)
+		case record.FieldSchema != nil:
+			db.SetFieldSchema(record.FieldSchema.Name, record.FieldSchema)
+			if record.FieldSchema.Computed != nil {
+				computed = append(computed, record.FieldSchema.Name)
+			}
+		case record.Entity != nil:
+			db.SetEntity(record.Entity.Id, record.Entity)
+			db.client.SAdd(context.Background(), db.keygen.GetEntityTypeKey(record.Entity.Type), record.Entity.Id)
+			status.Entities++
+
+			if status.Entities%SnapshotStreamBatchSize == 0 {
+				flush()
+			}
+		case record.Field != nil:
+			// Computed fields cannot be written: they are evaluated once the stream is restored
+			if slices.Contains(computed, record.Field.Name) {
+				continue
+			}
+
+			fields = append(fields, &DatabaseRequest{
+				Id:        record.Field.Id,
+				Field:     record.Field.Name,
+				Value:     record.Field.Value,
+				WriteTime: &Timestamp{Raw: record.Field.WriteTime},
+				WriterId:  &String{Raw: record.Field.WriterId},
+			})
+		}
+	}
+	flush()
+	db.releaseOrphanedBlobs()
+
+	for _, fieldName := range computed {
+		db.refreshComputedField(fieldName)
+	}
+
+	Info("[RedisDatabase::RestoreSnapshotStream] Snapshot restored.")
+	return true
+}
+
+// spoolSnapshotStream copies a streamed snapshot to a temp file, reading every record so the
+// trailer is checked, and returns the file rewound to its start
+func spoolSnapshotStream(r io.Reader) (*os.File, error) {
+	f, err := os.CreateTemp("", "qdb-snapshot-*")
+	if err != nil {
+		return nil, err
+	}
+
+	reader := NewSnapshotStreamReader(io.TeeReader(r, f))
+	for err == nil {
+		_, err = reader.Next()
+	}
+
+	if errors.Is(err, io.EOF) {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	return f, nil
+}
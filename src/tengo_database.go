@@ -2,10 +2,15 @@ package qdb
 
 import (
 	"container/heap"
+	"encoding/json"
 	"errors"
 	"time"
 
 	"github.com/d5/tengo/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 type ITengoEntity interface {
@@ -14,7 +19,12 @@ type ITengoEntity interface {
 	GetType(...tengo.Object) (tengo.Object, error)
 	GetChildren(...tengo.Object) (tengo.Object, error)
 	GetParent(...tengo.Object) (tengo.Object, error)
+	GetPath(...tengo.Object) (tengo.Object, error)
 	GetField(...tengo.Object) (tengo.Object, error)
+	GetAncestors(...tengo.Object) (tengo.Object, error)
+	GetDescendants(...tengo.Object) (tengo.Object, error)
+	GetSiblings(...tengo.Object) (tengo.Object, error)
+	FindAncestorOfType(...tengo.Object) (tengo.Object, error)
 }
 
 type TengoEntity struct {
@@ -31,6 +41,13 @@ type ITengoField interface {
 	PullBinaryFile(...tengo.Object) (tengo.Object, error)
 	PullEntityReference(...tengo.Object) (tengo.Object, error)
 	PullTimestamp(...tengo.Object) (tengo.Object, error)
+	PullIntList(...tengo.Object) (tengo.Object, error)
+	PullStringList(...tengo.Object) (tengo.Object, error)
+	PullEntityReferenceList(...tengo.Object) (tengo.Object, error)
+	PullStringMap(...tengo.Object) (tengo.Object, error)
+	PullValue(...tengo.Object) (tengo.Object, error)
+	PullEnum(...tengo.Object) (tengo.Object, error)
+	PullEnumName(...tengo.Object) (tengo.Object, error)
 	PullWriteTime(...tengo.Object) (tengo.Object, error)
 	PullWriter(...tengo.Object) (tengo.Object, error)
 
@@ -41,6 +58,13 @@ type ITengoField interface {
 	GetBinaryFile(...tengo.Object) (tengo.Object, error)
 	GetEntityReference(...tengo.Object) (tengo.Object, error)
 	GetTimestamp(...tengo.Object) (tengo.Object, error)
+	GetIntList(...tengo.Object) (tengo.Object, error)
+	GetStringList(...tengo.Object) (tengo.Object, error)
+	GetEntityReferenceList(...tengo.Object) (tengo.Object, error)
+	GetStringMap(...tengo.Object) (tengo.Object, error)
+	GetValue(...tengo.Object) (tengo.Object, error)
+	GetEnum(...tengo.Object) (tengo.Object, error)
+	GetEnumName(...tengo.Object) (tengo.Object, error)
 	GetWriteTime(...tengo.Object) (tengo.Object, error)
 	GetWriter(...tengo.Object) (tengo.Object, error)
 	GetId(...tengo.Object) (tengo.Object, error)
@@ -53,6 +77,12 @@ type ITengoField interface {
 	PushBinaryFile(...tengo.Object) (tengo.Object, error)
 	PushEntityReference(...tengo.Object) (tengo.Object, error)
 	PushTimestamp(...tengo.Object) (tengo.Object, error)
+	PushIntList(...tengo.Object) (tengo.Object, error)
+	PushStringList(...tengo.Object) (tengo.Object, error)
+	PushEntityReferenceList(...tengo.Object) (tengo.Object, error)
+	PushStringMap(...tengo.Object) (tengo.Object, error)
+	PushValue(...tengo.Object) (tengo.Object, error)
+	PushEnum(...tengo.Object) (tengo.Object, error)
 }
 
 type TengoField struct {
@@ -62,7 +92,9 @@ type TengoField struct {
 type ITengoDatabase interface {
 	ToTengoMap() tengo.Object
 	GetEntity(...tengo.Object) (tengo.Object, error)
+	GetEntityByPath(...tengo.Object) (tengo.Object, error)
 	Find(...tengo.Object) (tengo.Object, error)
+	Query(...tengo.Object) (tengo.Object, error)
 	Schedule(...tengo.Object) (tengo.Object, error)
 	PopAvailableJobs() []*TengoJob
 }
@@ -148,10 +180,18 @@ func (tdb *TengoDatabase) ToTengoMap() tengo.Object {
 				Name:  "entity",
 				Value: tdb.GetEntity,
 			},
+			"getEntityByPath": &tengo.UserFunction{
+				Name:  "getEntityByPath",
+				Value: tdb.GetEntityByPath,
+			},
 			"find": &tengo.UserFunction{
 				Name:  "find",
 				Value: tdb.Find,
 			},
+			"query": &tengo.UserFunction{
+				Name:  "query",
+				Value: tdb.Query,
+			},
 			"schedule": &tengo.UserFunction{
 				Name:  "schedule",
 				Value: tdb.Schedule,
@@ -182,6 +222,33 @@ func (tdb *TengoDatabase) GetEntity(args ...tengo.Object) (tengo.Object, error)
 	return NewTengoEntity(e).ToTengoMap(), nil
 }
 
+func (tdb *TengoDatabase) GetEntityByPath(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	path, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     "path",
+			Expected: "string",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	entityId := tdb.db.ResolveEntityPath(path)
+	if entityId == "" {
+		return nil, errors.New("entity not found")
+	}
+
+	e := NewEntity(tdb.db, entityId)
+	if e.entity == nil {
+		return nil, errors.New("entity not found")
+	}
+
+	return NewTengoEntity(e).ToTengoMap(), nil
+}
+
 func (tdb *TengoDatabase) Find(args ...tengo.Object) (tengo.Object, error) {
 	if len(args) < 1 {
 		return nil, tengo.ErrWrongNumArguments
@@ -231,6 +298,44 @@ func (tdb *TengoDatabase) Find(args ...tengo.Object) (tengo.Object, error) {
 	return &tengo.Array{Value: resultEntities}, nil
 }
 
+// Query runs a query and returns its rows as maps of the entity id and the selected field values
+func (tdb *TengoDatabase) Query(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	text, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     "query",
+			Expected: "string",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	rows, err := ExecuteQuery(tdb.db, text)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]tengo.Object, 0, len(rows))
+	for _, row := range rows {
+		fields := map[string]tengo.Object{}
+		for _, field := range row.Fields {
+			fields[field.Name] = valueToTengo(field.Value)
+		}
+
+		result = append(result, &tengo.Map{
+			Value: map[string]tengo.Object{
+				"id":     &tengo.String{Value: row.EntityId},
+				"fields": &tengo.Map{Value: fields},
+			},
+		})
+	}
+
+	return &tengo.Array{Value: result}, nil
+}
+
 func (tdb *TengoDatabase) Schedule(args ...tengo.Object) (tengo.Object, error) {
 	if len(args) < 1 {
 		return nil, tengo.ErrWrongNumArguments
@@ -292,10 +397,30 @@ func (te *TengoEntity) ToTengoMap() tengo.Object {
 				Name:  "parent",
 				Value: te.GetParent,
 			},
+			"path": &tengo.UserFunction{
+				Name:  "path",
+				Value: te.GetPath,
+			},
 			"field": &tengo.UserFunction{
 				Name:  "field",
 				Value: te.GetField,
 			},
+			"ancestors": &tengo.UserFunction{
+				Name:  "ancestors",
+				Value: te.GetAncestors,
+			},
+			"descendants": &tengo.UserFunction{
+				Name:  "descendants",
+				Value: te.GetDescendants,
+			},
+			"siblings": &tengo.UserFunction{
+				Name:  "siblings",
+				Value: te.GetSiblings,
+			},
+			"ancestorOfType": &tengo.UserFunction{
+				Name:  "ancestorOfType",
+				Value: te.FindAncestorOfType,
+			},
 		},
 	}
 }
@@ -321,6 +446,112 @@ func (te *TengoEntity) GetParent(...tengo.Object) (tengo.Object, error) {
 	return &tengo.String{Value: te.entity.GetParent().Raw}, nil
 }
 
+func (te *TengoEntity) GetPath(...tengo.Object) (tengo.Object, error) {
+	return &tengo.String{Value: te.entity.GetPath()}, nil
+}
+
+func entitiesToTengo(entities []IEntity) tengo.Object {
+	result := make([]tengo.Object, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, NewTengoEntity(entity).ToTengoMap())
+	}
+
+	return &tengo.Array{Value: result}
+}
+
+func (te *TengoEntity) GetAncestors(...tengo.Object) (tengo.Object, error) {
+	return entitiesToTengo(te.entity.GetAncestors()), nil
+}
+
+// GetDescendants takes an optional map of options:
+// {order: "breadth" or "depth", maxDepth: int, types: [string...]}
+func (te *TengoEntity) GetDescendants(args ...tengo.Object) (tengo.Object, error) {
+	options := TraversalOptions{}
+
+	if len(args) > 0 {
+		m, ok := args[0].(*tengo.Map)
+		if !ok {
+			return nil, &tengo.ErrInvalidArgumentType{
+				Name:     "options",
+				Expected: "map",
+				Found:    args[0].TypeName(),
+			}
+		}
+
+		if order, ok := m.Value["order"]; ok {
+			switch s, _ := tengo.ToString(order); s {
+			case "breadth":
+				options.Order = BreadthFirst
+			case "depth":
+				options.Order = DepthFirst
+			default:
+				return nil, &tengo.ErrInvalidArgumentType{
+					Name:     "order",
+					Expected: "\"breadth\" or \"depth\"",
+					Found:    order.String(),
+				}
+			}
+		}
+
+		if maxDepth, ok := m.Value["maxDepth"]; ok {
+			depth, ok := tengo.ToInt(maxDepth)
+			if !ok {
+				return nil, &tengo.ErrInvalidArgumentType{
+					Name:     "maxDepth",
+					Expected: "int",
+					Found:    maxDepth.TypeName(),
+				}
+			}
+			options.MaxDepth = depth
+		}
+
+		if types, ok := m.Value["types"]; ok {
+			array, ok := types.(*tengo.Array)
+			if !ok {
+				return nil, &tengo.ErrInvalidArgumentType{
+					Name:     "types",
+					Expected: "array",
+					Found:    types.TypeName(),
+				}
+			}
+
+			for _, t := range array.Value {
+				if entityType, ok := tengo.ToString(t); ok {
+					options.EntityTypes = append(options.EntityTypes, entityType)
+				}
+			}
+		}
+	}
+
+	return entitiesToTengo(te.entity.GetDescendants(options)), nil
+}
+
+func (te *TengoEntity) GetSiblings(...tengo.Object) (tengo.Object, error) {
+	return entitiesToTengo(te.entity.GetSiblings()), nil
+}
+
+func (te *TengoEntity) FindAncestorOfType(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	entityType, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     "entityType",
+			Expected: "string",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	ancestor := te.entity.FindAncestorOfType(entityType)
+	if ancestor == nil {
+		return tengo.UndefinedValue, nil
+	}
+
+	return NewTengoEntity(ancestor).ToTengoMap(), nil
+}
+
 func (te *TengoEntity) GetField(args ...tengo.Object) (tengo.Object, error) {
 	if len(args) < 1 {
 		return nil, tengo.ErrWrongNumArguments
@@ -371,6 +602,38 @@ func (tf *TengoField) ToTengoMap() tengo.Object {
 				Name:  "pullTimestamp",
 				Value: tf.PullTimestamp,
 			},
+			"pullIntList": &tengo.UserFunction{
+				Name:  "pullIntList",
+				Value: tf.PullIntList,
+			},
+			"pullStringList": &tengo.UserFunction{
+				Name:  "pullStringList",
+				Value: tf.PullStringList,
+			},
+			"pullEntityReferenceList": &tengo.UserFunction{
+				Name:  "pullEntityReferenceList",
+				Value: tf.PullEntityReferenceList,
+			},
+			"pullStringMap": &tengo.UserFunction{
+				Name:  "pullStringMap",
+				Value: tf.PullStringMap,
+			},
+			"pullValue": &tengo.UserFunction{
+				Name:  "pullValue",
+				Value: tf.PullValue,
+			},
+			"pullEnum": &tengo.UserFunction{
+				Name:  "pullEnum",
+				Value: tf.PullEnum,
+			},
+			"pullFloatIn": &tengo.UserFunction{
+				Name:  "pullFloatIn",
+				Value: tf.PullFloatIn,
+			},
+			"pullEnumName": &tengo.UserFunction{
+				Name:  "pullEnumName",
+				Value: tf.PullEnumName,
+			},
 			"pullWriteTime": &tengo.UserFunction{
 				Name:  "pullWriteTime",
 				Value: tf.PullWriteTime,
@@ -387,6 +650,10 @@ func (tf *TengoField) ToTengoMap() tengo.Object {
 				Name:  "getFloat",
 				Value: tf.GetFloat,
 			},
+			"getFloatIn": &tengo.UserFunction{
+				Name:  "getFloatIn",
+				Value: tf.GetFloatIn,
+			},
 			"getString": &tengo.UserFunction{
 				Name:  "getString",
 				Value: tf.GetString,
@@ -407,6 +674,34 @@ func (tf *TengoField) ToTengoMap() tengo.Object {
 				Name:  "getTimestamp",
 				Value: tf.GetTimestamp,
 			},
+			"getIntList": &tengo.UserFunction{
+				Name:  "getIntList",
+				Value: tf.GetIntList,
+			},
+			"getStringList": &tengo.UserFunction{
+				Name:  "getStringList",
+				Value: tf.GetStringList,
+			},
+			"getEntityReferenceList": &tengo.UserFunction{
+				Name:  "getEntityReferenceList",
+				Value: tf.GetEntityReferenceList,
+			},
+			"getStringMap": &tengo.UserFunction{
+				Name:  "getStringMap",
+				Value: tf.GetStringMap,
+			},
+			"getValue": &tengo.UserFunction{
+				Name:  "getValue",
+				Value: tf.GetValue,
+			},
+			"getEnum": &tengo.UserFunction{
+				Name:  "getEnum",
+				Value: tf.GetEnum,
+			},
+			"getEnumName": &tengo.UserFunction{
+				Name:  "getEnumName",
+				Value: tf.GetEnumName,
+			},
 			"getWriteTime": &tengo.UserFunction{
 				Name:  "getWriteTime",
 				Value: tf.GetWriteTime,
@@ -451,6 +746,30 @@ func (tf *TengoField) ToTengoMap() tengo.Object {
 				Name:  "pushTimestamp",
 				Value: tf.PushTimestamp,
 			},
+			"pushIntList": &tengo.UserFunction{
+				Name:  "pushIntList",
+				Value: tf.PushIntList,
+			},
+			"pushStringList": &tengo.UserFunction{
+				Name:  "pushStringList",
+				Value: tf.PushStringList,
+			},
+			"pushEntityReferenceList": &tengo.UserFunction{
+				Name:  "pushEntityReferenceList",
+				Value: tf.PushEntityReferenceList,
+			},
+			"pushStringMap": &tengo.UserFunction{
+				Name:  "pushStringMap",
+				Value: tf.PushStringMap,
+			},
+			"pushValue": &tengo.UserFunction{
+				Name:  "pushValue",
+				Value: tf.PushValue,
+			},
+			"pushEnum": &tengo.UserFunction{
+				Name:  "pushEnum",
+				Value: tf.PushEnum,
+			},
 		},
 	}
 }
@@ -487,6 +806,48 @@ func (tf *TengoField) PullTimestamp(...tengo.Object) (tengo.Object, error) {
 	return &tengo.Time{Value: tf.field.PullTimestamp()}, nil
 }
 
+func (tf *TengoField) PullIntList(...tengo.Object) (tengo.Object, error) {
+	return intsToTengo(tf.field.PullIntList()), nil
+}
+
+func (tf *TengoField) PullStringList(...tengo.Object) (tengo.Object, error) {
+	return stringsToTengo(tf.field.PullStringList()), nil
+}
+
+func (tf *TengoField) PullEntityReferenceList(...tengo.Object) (tengo.Object, error) {
+	return stringsToTengo(tf.field.PullEntityReferenceList()), nil
+}
+
+func (tf *TengoField) PullStringMap(...tengo.Object) (tengo.Object, error) {
+	return stringMapToTengo(tf.field.PullStringMap()), nil
+}
+
+func (tf *TengoField) PullValue(args ...tengo.Object) (tengo.Object, error) {
+	m, err := tengoNewMessage(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return messageToTengo(tf.field.PullValue(m))
+}
+
+func (tf *TengoField) PullEnum(...tengo.Object) (tengo.Object, error) {
+	return &tengo.Int{Value: tf.field.PullEnum()}, nil
+}
+
+func (tf *TengoField) PullFloatIn(args ...tengo.Object) (tengo.Object, error) {
+	unit, err := tengoUnitArgument(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tengo.Float{Value: tf.field.PullFloatIn(unit)}, nil
+}
+
+func (tf *TengoField) PullEnumName(...tengo.Object) (tengo.Object, error) {
+	return &tengo.String{Value: tf.field.PullEnumName()}, nil
+}
+
 func (tf *TengoField) PullWriteTime(...tengo.Object) (tengo.Object, error) {
 	return &tengo.Time{Value: tf.field.PullWriteTime()}, nil
 }
@@ -503,6 +864,15 @@ func (tf *TengoField) GetFloat(...tengo.Object) (tengo.Object, error) {
 	return &tengo.Float{Value: tf.field.GetFloat()}, nil
 }
 
+func (tf *TengoField) GetFloatIn(args ...tengo.Object) (tengo.Object, error) {
+	unit, err := tengoUnitArgument(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tengo.Float{Value: tf.field.GetFloatIn(unit)}, nil
+}
+
 func (tf *TengoField) GetString(...tengo.Object) (tengo.Object, error) {
 	return &tengo.String{Value: tf.field.GetString()}, nil
 }
@@ -527,6 +897,39 @@ func (tf *TengoField) GetTimestamp(...tengo.Object) (tengo.Object, error) {
 	return &tengo.Time{Value: tf.field.GetTimestamp()}, nil
 }
 
+func (tf *TengoField) GetIntList(...tengo.Object) (tengo.Object, error) {
+	return intsToTengo(tf.field.GetIntList()), nil
+}
+
+func (tf *TengoField) GetStringList(...tengo.Object) (tengo.Object, error) {
+	return stringsToTengo(tf.field.GetStringList()), nil
+}
+
+func (tf *TengoField) GetEntityReferenceList(...tengo.Object) (tengo.Object, error) {
+	return stringsToTengo(tf.field.GetEntityReferenceList()), nil
+}
+
+func (tf *TengoField) GetStringMap(...tengo.Object) (tengo.Object, error) {
+	return stringMapToTengo(tf.field.GetStringMap()), nil
+}
+
+func (tf *TengoField) GetValue(args ...tengo.Object) (tengo.Object, error) {
+	m, err := tengoNewMessage(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return messageToTengo(tf.field.GetValue(m))
+}
+
+func (tf *TengoField) GetEnum(...tengo.Object) (tengo.Object, error) {
+	return &tengo.Int{Value: tf.field.GetEnum()}, nil
+}
+
+func (tf *TengoField) GetEnumName(...tengo.Object) (tengo.Object, error) {
+	return &tengo.String{Value: tf.field.GetEnumName()}, nil
+}
+
 func (tf *TengoField) GetWriteTime(...tengo.Object) (tengo.Object, error) {
 	return &tengo.Time{Value: tf.field.GetWriteTime()}, nil
 }
@@ -668,3 +1071,263 @@ func (tf *TengoField) PushTimestamp(args ...tengo.Object) (tengo.Object, error)
 	tf.field.PushTimestamp(t)
 	return tengo.UndefinedValue, nil
 }
+
+func (tf *TengoField) PushIntList(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	elements, ok := tengoToArray(args[0])
+	if !ok {
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     "l",
+			Expected: "array",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	l := make([]int64, 0, len(elements))
+	for _, element := range elements {
+		i, ok := tengo.ToInt64(element)
+		if !ok {
+			return nil, &tengo.ErrInvalidArgumentType{
+				Name:     "l",
+				Expected: "array(int)",
+				Found:    element.TypeName(),
+			}
+		}
+		l = append(l, i)
+	}
+
+	tf.field.PushIntList(l)
+	return tengo.UndefinedValue, nil
+}
+
+func (tf *TengoField) PushStringList(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	l, err := tengoToStrings("l", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	tf.field.PushStringList(l)
+	return tengo.UndefinedValue, nil
+}
+
+func (tf *TengoField) PushEntityReferenceList(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	l, err := tengoToStrings("l", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	tf.field.PushEntityReferenceList(l)
+	return tengo.UndefinedValue, nil
+}
+
+func (tf *TengoField) PushStringMap(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	var elements map[string]tengo.Object
+	switch v := args[0].(type) {
+	case *tengo.Map:
+		elements = v.Value
+	case *tengo.ImmutableMap:
+		elements = v.Value
+	default:
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     "m",
+			Expected: "map",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	m := map[string]string{}
+	for key, element := range elements {
+		value, ok := tengo.ToString(element)
+		if !ok {
+			return nil, &tengo.ErrInvalidArgumentType{
+				Name:     "m",
+				Expected: "map(string)",
+				Found:    element.TypeName(),
+			}
+		}
+		m[key] = value
+	}
+
+	tf.field.PushStringMap(m)
+	return tengo.UndefinedValue, nil
+}
+
+// PushValue writes an arbitrary registered protobuf message. The first argument is the
+// full message name (ie. "qdb.Int") and the second is a map using the message's JSON mapping.
+func (tf *TengoField) PushValue(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 2 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	m, err := tengoNewMessage(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(tengo.ToInterface(args[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+
+	tf.field.PushValue(m)
+	return tengo.UndefinedValue, nil
+}
+
+// PushEnum accepts either the enum number or its name as declared in the field schema
+func (tf *TengoField) PushEnum(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	switch v := args[0].(type) {
+	case *tengo.String:
+		tf.field.PushEnum(v.Value)
+	case *tengo.Int:
+		tf.field.PushEnum(v.Value)
+	default:
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     "e",
+			Expected: "int|string",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	return tengo.UndefinedValue, nil
+}
+
+func tengoNewMessage(args ...tengo.Object) (proto.Message, error) {
+	if len(args) < 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	typeName, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     "typeName",
+			Expected: "string",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(typeName))
+	if err != nil {
+		return nil, err
+	}
+
+	return messageType.New().Interface(), nil
+}
+
+// tengoUnitArgument returns the optional unit argument, defaulting to the unit of the field schema
+func tengoUnitArgument(args ...tengo.Object) (string, error) {
+	if len(args) < 1 {
+		return "", nil
+	}
+
+	unit, ok := tengo.ToString(args[0])
+	if !ok {
+		return "", &tengo.ErrInvalidArgumentType{
+			Name:     "unit",
+			Expected: "string",
+			Found:    args[0].TypeName(),
+		}
+	}
+
+	return unit, nil
+}
+
+func messageToTengo(m proto.Message) (tengo.Object, error) {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return tengo.FromInterface(v)
+}
+
+func tengoToArray(o tengo.Object) ([]tengo.Object, bool) {
+	switch v := o.(type) {
+	case *tengo.Array:
+		return v.Value, true
+	case *tengo.ImmutableArray:
+		return v.Value, true
+	default:
+		return nil, false
+	}
+}
+
+func tengoToStrings(name string, o tengo.Object) ([]string, error) {
+	elements, ok := tengoToArray(o)
+	if !ok {
+		return nil, &tengo.ErrInvalidArgumentType{
+			Name:     name,
+			Expected: "array",
+			Found:    o.TypeName(),
+		}
+	}
+
+	l := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, ok := tengo.ToString(element)
+		if !ok {
+			return nil, &tengo.ErrInvalidArgumentType{
+				Name:     name,
+				Expected: "array(string)",
+				Found:    element.TypeName(),
+			}
+		}
+		l = append(l, s)
+	}
+
+	return l, nil
+}
+
+func intsToTengo(l []int64) tengo.Object {
+	a := make([]tengo.Object, 0, len(l))
+	for _, i := range l {
+		a = append(a, &tengo.Int{Value: i})
+	}
+
+	return &tengo.Array{Value: a}
+}
+
+func stringsToTengo(l []string) tengo.Object {
+	a := make([]tengo.Object, 0, len(l))
+	for _, s := range l {
+		a = append(a, &tengo.String{Value: s})
+	}
+
+	return &tengo.Array{Value: a}
+}
+
+func stringMapToTengo(m map[string]string) tengo.Object {
+	v := make(map[string]tengo.Object, len(m))
+	for key, value := range m {
+		v[key] = &tengo.String{Value: value}
+	}
+
+	return &tengo.Map{Value: v}
+}
@@ -0,0 +1,86 @@
+package qdb
+
+import "strings"
+
+// unitDefinition describes how a unit relates to the base unit of its quantity:
+// base = value*factor + offset
+type unitDefinition struct {
+	quantity string
+	factor   float64
+	offset   float64
+}
+
+var units = map[string]unitDefinition{
+	// Temperature (base: K)
+	"K":  {"temperature", 1, 0},
+	"C":  {"temperature", 1, 273.15},
+	"°C": {"temperature", 1, 273.15},
+	"F":  {"temperature", 5.0 / 9.0, 459.67 * 5.0 / 9.0},
+	"°F": {"temperature", 5.0 / 9.0, 459.67 * 5.0 / 9.0},
+
+	// Pressure (base: Pa)
+	"Pa":   {"pressure", 1, 0},
+	"kPa":  {"pressure", 1e3, 0},
+	"MPa":  {"pressure", 1e6, 0},
+	"mbar": {"pressure", 1e2, 0},
+	"bar":  {"pressure", 1e5, 0},
+	"psi":  {"pressure", 6894.757293168, 0},
+	"atm":  {"pressure", 101325, 0},
+	"mmHg": {"pressure", 133.322387415, 0},
+	"inHg": {"pressure", 3386.389, 0},
+
+	// Duration (base: s)
+	"ns":  {"duration", 1e-9, 0},
+	"us":  {"duration", 1e-6, 0},
+	"µs":  {"duration", 1e-6, 0},
+	"ms":  {"duration", 1e-3, 0},
+	"s":   {"duration", 1, 0},
+	"min": {"duration", 60, 0},
+	"h":   {"duration", 3600, 0},
+	"d":   {"duration", 86400, 0},
+
+	// Length (base: m)
+	"mm": {"length", 1e-3, 0},
+	"cm": {"length", 1e-2, 0},
+	"m":  {"length", 1, 0},
+	"km": {"length", 1e3, 0},
+	"in": {"length", 0.0254, 0},
+	"ft": {"length", 0.3048, 0},
+
+	// Ratio (base: fraction)
+	"%": {"ratio", 1e-2, 0},
+}
+
+// RegisterUnit adds a unit that can be used in field schemas and conversions.
+// factor and offset convert a value in the unit to the base unit of its quantity.
+func RegisterUnit(name, quantity string, factor, offset float64) {
+	units[name] = unitDefinition{
+		quantity: quantity,
+		factor:   factor,
+		offset:   offset,
+	}
+}
+
+// ConvertUnit converts a value between two units of the same quantity.
+// It returns false if either unit is unknown or the quantities differ.
+func ConvertUnit(value float64, from, to string) (float64, bool) {
+	from = strings.TrimSpace(from)
+	to = strings.TrimSpace(to)
+
+	if from == to {
+		return value, true
+	}
+
+	fromUnit, ok := units[from]
+	if !ok {
+		return 0, false
+	}
+
+	toUnit, ok := units[to]
+	if !ok || fromUnit.quantity != toUnit.quantity {
+		return 0, false
+	}
+
+	base := value*fromUnit.factor + fromUnit.offset
+	return (base - toUnit.offset) / toUnit.factor, true
+}
@@ -0,0 +1,171 @@
+package qdb
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// WebBlobWorker serves the content of BinaryFile fields over HTTP:
+//
+//	GET  /blob?id=<entityId>&field=<fieldName> streams the file content
+//	POST /blob?id=<entityId>&field=<fieldName> stores the request body and writes the field
+//
+// File content is streamed on the HTTP goroutine. Field reads and writes are handed over to
+// DoWork so that they happen on the application thread like every other database access.
+type WebBlobWorker struct {
+	db    IDatabase
+	tasks chan func()
+}
+
+func NewWebBlobWorker(db IDatabase) *WebBlobWorker {
+	return &WebBlobWorker{
+		db:    db,
+		tasks: make(chan func(), 100),
+	}
+}
+
+func (w *WebBlobWorker) Init() {
+	http.Handle("/blob", w)
+}
+
+func (w *WebBlobWorker) Deinit() {
+
+}
+
+func (w *WebBlobWorker) DoWork() {
+	for {
+		select {
+		case task := <-w.tasks:
+			task()
+		default:
+			return
+		}
+	}
+}
+
+func (w *WebBlobWorker) ServeHTTP(wr http.ResponseWriter, req *http.Request) {
+	entityId := req.URL.Query().Get("id")
+	fieldName := req.URL.Query().Get("field")
+	if entityId == "" || fieldName == "" {
+		http.Error(wr, "missing 'id' or 'field' parameter", http.StatusBadRequest)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		w.onDownload(wr, req, entityId, fieldName)
+	case http.MethodPost, http.MethodPut:
+		w.onUpload(wr, req, entityId, fieldName)
+	default:
+		http.Error(wr, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (w *WebBlobWorker) onDownload(wr http.ResponseWriter, req *http.Request, entityId, fieldName string) {
+	var file *BinaryFile
+	if !w.runInWorker(req, func() {
+		field := NewField(w.db, entityId, fieldName)
+		file = field.PullValue(new(BinaryFile)).(*BinaryFile)
+		if !field.req.Success {
+			file = nil
+		}
+	}) {
+		return
+	}
+
+	if file == nil {
+		http.NotFound(wr, req)
+		return
+	}
+
+	mimeType := file.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	wr.Header().Set("Content-Type", mimeType)
+
+	if file.Hash != "" {
+		wr.Header().Set("Content-Length", strconv.FormatInt(file.Size, 10))
+		wr.Header().Set("ETag", "\""+file.Hash+"\"")
+	}
+
+	if !w.db.ReadBlob(file, wr) {
+		Error("[WebBlobWorker::onDownload] Failed to stream file for %s.%s", entityId, fieldName)
+	}
+}
+
+func (w *WebBlobWorker) onUpload(wr http.ResponseWriter, req *http.Request, entityId, fieldName string) {
+	file := w.db.WriteBlob(req.Body, req.Header.Get("Content-Type"))
+	if file == nil {
+		http.Error(wr, "failed to store file", http.StatusInternalServerError)
+		return
+	}
+
+	// The task may still run after the client went away. The field is then left alone, since the
+	// stored content has already been discarded.
+	var mu sync.Mutex
+	pushed, handled, abandoned := false, false, false
+	if !w.runInWorker(req, func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if abandoned {
+			return
+		}
+
+		handled = true
+		pushed = NewField(w.db, entityId, fieldName).PushValue(file)
+		if !pushed {
+			w.db.DiscardBlob(file)
+		}
+	}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !handled {
+			abandoned = true
+			w.db.DiscardBlob(file)
+		}
+		return
+	}
+
+	if !pushed {
+		http.Error(wr, "failed to write field", http.StatusInternalServerError)
+		return
+	}
+
+	b, err := protojson.Marshal(file)
+	if err != nil {
+		Error("[WebBlobWorker::onUpload] Failed to marshal response: %v", err)
+		return
+	}
+
+	wr.Header().Set("Content-Type", "application/json")
+	wr.Write(b)
+}
+
+// runInWorker queues fn to be executed by DoWork and waits for it to complete.
+// It returns false if the client went away before that happened.
+func (w *WebBlobWorker) runInWorker(req *http.Request, fn func()) bool {
+	done := make(chan interface{})
+	task := func() {
+		fn()
+		close(done)
+	}
+
+	select {
+	case w.tasks <- task:
+	case <-req.Context().Done():
+		return false
+	}
+
+	select {
+	case <-done:
+		return true
+	case <-req.Context().Done():
+		return false
+	}
+}
@@ -86,6 +86,7 @@ class DatabaseInteractor {
             }
         }
         this._serverInteractor = new ServerInteractor(` + "`" + `${location.protocol == "https:" ? "wss:" : "ws:"}//${location.hostname}${port}/ws` + "`" + `);
+        this._blobUrl = ` + "`" + `${location.protocol}//${location.hostname}${port}/blob` + "`" + `;
         this._notificationManager = new DatabaseNotificationManager();
         this._runInBackground = false;
         this._isConnected = null;
@@ -196,20 +197,91 @@ class DatabaseInteractor {
             });
     }
 
-    queryAllEntities(entityType) {
+    // Calls fetchPage(cursor) until the server stops returning a next cursor and
+    // concatenates the items of every page
+    _collectPages(fetchPage) {
+        const items = [];
+        const next = cursor => fetchPage(cursor).then(page => {
+            items.push(...page.items);
+            return page.nextCursor ? next(page.nextCursor) : items;
+        });
+
+        return next("");
+    }
+
+    queryEntitiesPage(entityType, cursor, pageSize) {
         const request = new proto.qdb.WebRuntimeGetEntitiesRequest();
         request.setEntitytype(entityType);
+        request.setCursor(cursor || "");
+        request.setPagesize(pageSize || 0);
 
         return this._serverInteractor
             .send(request, proto.qdb.WebRuntimeGetEntitiesResponse)
             .then(response => {
-                return {entities: response.getEntitiesList()};
+                return {entities: response.getEntitiesList(), nextCursor: response.getNextcursor()};
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::queryEntitiesPage] Failed to get entities: ${error}` + "`" + `);
+            });
+    }
+
+    queryAllEntities(entityType) {
+        return this._collectPages(cursor => this.queryEntitiesPage(entityType, cursor)
+                .then(page => ({items: page.entities, nextCursor: page.nextCursor})))
+            .then(entities => {
+                return {entities: entities};
             })
             .catch(error => {
                 throw new Error(` + "`" + `[DatabaseInteractor::queryAllEntities] Failed to get all entities: ${error}` + "`" + `);
             });
     }
 
+    query(text) {
+        const request = new proto.qdb.WebRuntimeQueryRequest();
+        request.setQuery(text);
+
+        return this._serverInteractor
+            .send(request, proto.qdb.WebRuntimeQueryResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebRuntimeQueryResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::query] Could not complete the request: ${response.getError()}` + "`" + `);
+                }
+
+                return {
+                    rows: response.getRowsList().map(row => ({
+                        entityId: row.getEntityid(),
+                        fields: Object.fromEntries(row.getFieldsList().map(field => [field.getName(), qUnpackValue(field.getValue())])),
+                    }))
+                };
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::query] Failed to run query: ${error}` + "`" + `);
+            });
+    }
+
+    queryReferrers(entityId) {
+        const request = new proto.qdb.WebRuntimeGetReferrersRequest();
+        request.setId(entityId);
+
+        return this._serverInteractor
+            .send(request, proto.qdb.WebRuntimeGetReferrersResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebRuntimeGetReferrersResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::queryReferrers] Could not complete the request: ${response.getStatus()}` + "`" + `);
+                }
+
+                return {
+                    referrers: response.getReferrersList().map(referrer => ({
+                        entityId: referrer.getEntityid(),
+                        field: referrer.getField(),
+                    }))
+                };
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::queryReferrers] Failed to get referrers: ${error}` + "`" + `);
+            });
+    }
+
     queryEntity(entityId) {
         const request = new proto.qdb.WebConfigGetEntityRequest();
         request.setId(entityId);
@@ -228,6 +300,82 @@ class DatabaseInteractor {
             });
     }
 
+    queryEntityByPath(path) {
+        const request = new proto.qdb.WebConfigGetEntityByPathRequest();
+        request.setPath(path);
+
+        return this._serverInteractor
+            .send(request, proto.qdb.WebConfigGetEntityByPathResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebConfigGetEntityByPathResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::queryEntityByPath] Could not complete the request: ${response.getStatus()}` + "`" + `);
+                }
+
+                return {entity: response.getEntity()};
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::queryEntityByPath] Failed to get entity: ${error}` + "`" + `);
+            });
+    }
+
+    queryEntityPath(entityId) {
+        const request = new proto.qdb.WebConfigGetEntityPathRequest();
+        request.setId(entityId);
+
+        return this._serverInteractor
+            .send(request, proto.qdb.WebConfigGetEntityPathResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebConfigGetEntityPathResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::queryEntityPath] Could not complete the request: ${response.getStatus()}` + "`" + `);
+                }
+
+                return {path: response.getPath()};
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::queryEntityPath] Failed to get entity path: ${error}` + "`" + `);
+            });
+    }
+
+    _traverseEntity(entityId, direction, options = {}) {
+        const request = new proto.qdb.WebConfigTraverseEntityRequest();
+        request.setId(entityId);
+        request.setDirection(direction);
+        request.setDepthfirst(options.depthFirst || false);
+        request.setMaxdepth(options.maxDepth || 0);
+        request.setEntitytypesList(options.entityTypes || []);
+
+        return this._serverInteractor
+            .send(request, proto.qdb.WebConfigTraverseEntityResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebConfigTraverseEntityResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::_traverseEntity] Could not complete the request: ${response.getStatus()}` + "`" + `);
+                }
+
+                return {entities: response.getEntitiesList()};
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::_traverseEntity] Failed to traverse entity: ${error}` + "`" + `);
+            });
+    }
+
+    queryAncestors(entityId) {
+        return this._traverseEntity(entityId, proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum.ANCESTORS);
+    }
+
+    queryNearestAncestor(entityId, entityType) {
+        return this._traverseEntity(entityId, proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum.ANCESTORS, {entityTypes: [entityType]})
+            .then(result => ({entity: result.entities.length > 0 ? result.entities[0] : null}));
+    }
+
+    // options: {depthFirst: bool, maxDepth: number, entityTypes: [string]}
+    queryDescendants(entityId, options = {}) {
+        return this._traverseEntity(entityId, proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum.DESCENDANTS, options);
+    }
+
+    querySiblings(entityId) {
+        return this._traverseEntity(entityId, proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum.SIBLINGS);
+    }
+
     queryEntitySchema(entityType) {
         const request = new proto.qdb.WebConfigGetEntitySchemaRequest();
         request.setType(entityType);
@@ -247,10 +395,16 @@ class DatabaseInteractor {
     }
 
     queryAllFields() {
-        return this._serverInteractor
-            .send(new proto.qdb.WebConfigGetAllFieldsRequest(), proto.qdb.WebConfigGetAllFieldsResponse)
-            .then(response => {
-                return {fields: response.getFieldsList()};
+        return this._collectPages(cursor => {
+                const request = new proto.qdb.WebConfigGetAllFieldsRequest();
+                request.setCursor(cursor);
+
+                return this._serverInteractor
+                    .send(request, proto.qdb.WebConfigGetAllFieldsResponse)
+                    .then(response => ({items: response.getFieldsList(), nextCursor: response.getNextcursor()}));
+            })
+            .then(fields => {
+                return {fields: fields};
             })
             .catch(error => {
                 throw new Error(` + "`" + `[DatabaseInteractor::queryAllFields] Failed to get all fields: ${error}` + "`" + `);
@@ -258,10 +412,16 @@ class DatabaseInteractor {
     }
 
     queryAllEntityTypes() {
-        return this._serverInteractor
-            .send(new proto.qdb.WebConfigGetEntityTypesRequest(), proto.qdb.WebConfigGetEntityTypesResponse)
-            .then(response => {
-                return {entityTypes: response.getTypesList()};
+        return this._collectPages(cursor => {
+                const request = new proto.qdb.WebConfigGetEntityTypesRequest();
+                request.setCursor(cursor);
+
+                return this._serverInteractor
+                    .send(request, proto.qdb.WebConfigGetEntityTypesResponse)
+                    .then(response => ({items: response.getTypesList(), nextCursor: response.getNextcursor()}));
+            })
+            .then(entityTypes => {
+                return {entityTypes: entityTypes};
             })
             .catch(error => {
                 throw new Error(` + "`" + `[DatabaseInteractor::queryAllEntityTypes] Failed to get all entity types: ${error}` + "`" + `);
@@ -306,13 +466,50 @@ class DatabaseInteractor {
             });
     }
 
-    createField(fieldName, fieldType) {
+    queryFieldSchema(fieldName) {
+        const request = new proto.qdb.WebConfigGetFieldSchemaRequest();
+        request.setField(fieldName);
+
+        return this._serverInteractor
+            .send(request, proto.qdb.WebConfigGetFieldSchemaResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::queryFieldSchema] Could not complete the request: ${response.getStatus()}` + "`" + `);
+                }
+
+                return {schema: response.getSchema()};
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::queryFieldSchema] Failed to get field schema: ${error}` + "`" + `);
+            });
+    }
+
+    createField(fieldName, fieldType, enumValues, engineering) {
         const request = new proto.qdb.WebConfigSetFieldSchemaRequest();
         request.setField( fieldName );
 
         const schema = new proto.qdb.DatabaseFieldSchema();
         schema.setName( fieldName );
-        schema.setType( 'qdb.' + fieldType );
+        schema.setType( fieldType.includes('.') ? fieldType : 'qdb.' + fieldType );
+        schema.setEnumvaluesList( Object.entries(enumValues || {}).map(([name, value]) => {
+            const enumValue = new proto.qdb.DatabaseEnumValue();
+            enumValue.setName(name);
+            enumValue.setValue(value);
+            return enumValue;
+        }) );
+
+        if (engineering) {
+            schema.setUnit( engineering.unit || "" );
+            schema.setDisplayprecision( engineering.displayPrecision || 0 );
+            schema.setScale( engineering.scale || 0 );
+            schema.setOffset( engineering.offset || 0 );
+            schema.setAlarmlimitsList( Object.entries(engineering.alarmLimits || {}).map(([level, value]) => {
+                const alarmLimit = new proto.qdb.DatabaseAlarmLimit();
+                alarmLimit.setLevel(proto.qdb.DatabaseAlarmLimit.LevelEnum[level]);
+                alarmLimit.setValue(value);
+                return alarmLimit;
+            }) );
+        }
         request.setSchema( schema );
 
         return this._serverInteractor.send(request, proto.qdb.WebConfigSetFieldSchemaResponse)
@@ -365,6 +562,52 @@ class DatabaseInteractor {
             });
     }
 
+    mergeSnapshot(snapshot, options) {
+        const me = this;
+        const request = new proto.qdb.WebConfigRestoreSnapshotRequest();
+        request.setSnapshot(snapshot);
+        request.setMerge(true);
+        request.setPrune(!!(options && options.prune));
+        request.setSuppressnotifications(!!(options && options.suppressNotifications));
+        request.setSuppresstransformations(!!(options && options.suppressTransformations));
+        request.setDryrun(!!(options && options.dryRun));
+
+        return me._serverInteractor
+            .send(request, proto.qdb.WebConfigRestoreSnapshotResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::mergeSnapshot] Could not complete the request: ${response.getStatus()}` + "`" + `);
+                }
+
+                return {summary: response.getSummary()};
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::mergeSnapshot] Failed to merge snapshot: ${error}` + "`" + `);
+            });
+    }
+
+    diffSnapshot(oldSnapshot, newSnapshot) {
+        const me = this;
+        const request = new proto.qdb.WebConfigDiffSnapshotRequest();
+        if (oldSnapshot) {
+            request.setOld(oldSnapshot);
+        }
+        request.setNew(newSnapshot);
+
+        return me._serverInteractor
+            .send(request, proto.qdb.WebConfigDiffSnapshotResponse)
+            .then(response => {
+                if (response.getStatus() !== proto.qdb.WebConfigDiffSnapshotResponse.StatusEnum.SUCCESS) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::diffSnapshot] Could not complete the request: ${response.getStatus()}` + "`" + `);
+                }
+
+                return {diff: response.getDiff(), text: response.getText()};
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::diffSnapshot] Failed to diff snapshot: ${error}` + "`" + `);
+            });
+    }
+
     queryRootEntityId() {
         return this._serverInteractor
             .send(new proto.qdb.WebConfigGetRootRequest(), proto.qdb.WebConfigGetRootResponse)
@@ -380,6 +623,28 @@ class DatabaseInteractor {
             });
     }
 
+    getFileUrl(entityId, fieldName) {
+        return ` + "`" + `${this._blobUrl}?id=${encodeURIComponent(entityId)}&field=${encodeURIComponent(fieldName)}` + "`" + `;
+    }
+
+    uploadFile(entityId, fieldName, file) {
+        return fetch(this.getFileUrl(entityId, fieldName), {
+                method: "POST",
+                headers: {"Content-Type": file.type || "application/octet-stream"},
+                body: file,
+            })
+            .then(response => {
+                if (!response.ok) {
+                    throw new Error(` + "`" + `[DatabaseInteractor::uploadFile] Could not complete the request: ${response.status}` + "`" + `);
+                }
+
+                return response.json();
+            })
+            .catch(error => {
+                throw new Error(` + "`" + `[DatabaseInteractor::uploadFile] Failed to upload file: ${error}` + "`" + `);
+            });
+    }
+
     processNotifications() {
         return this._serverInteractor
             .send(new proto.qdb.WebRuntimeGetNotificationsRequest(), proto.qdb.WebRuntimeGetNotificationsResponse)
@@ -485,7 +750,8 @@ class DatabaseInteractor {
 
             dr.setId(r.id);
             dr.setField(r.field);
-            dr.setValue(r.value);
+            // Values are either packed already or given raw along with their type name
+            dr.setValue(r.value instanceof proto.google.protobuf.Any ? r.value : qPackValue(r.type, r.value));
 
             return dr;
         }));
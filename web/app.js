@@ -714,20 +714,45 @@ goog.exportSymbol('proto.qdb.BinaryFile', null, global);
 goog.exportSymbol('proto.qdb.Bool', null, global);
 goog.exportSymbol('proto.qdb.ConnectionState', null, global);
 goog.exportSymbol('proto.qdb.ConnectionState.ConnectionStateEnum', null, global);
+goog.exportSymbol('proto.qdb.DatabaseAlarmLimit', null, global);
+goog.exportSymbol('proto.qdb.DatabaseAlarmLimit.LevelEnum', null, global);
+goog.exportSymbol('proto.qdb.DatabaseComputedField', null, global);
+goog.exportSymbol('proto.qdb.DatabaseComputedField.EvaluationEnum', null, global);
 goog.exportSymbol('proto.qdb.DatabaseEntity', null, global);
+goog.exportSymbol('proto.qdb.DatabaseEntityEvent', null, global);
+goog.exportSymbol('proto.qdb.DatabaseEntityEvent.TypeEnum', null, global);
 goog.exportSymbol('proto.qdb.DatabaseEntitySchema', null, global);
+goog.exportSymbol('proto.qdb.DatabaseEnumValue', null, global);
 goog.exportSymbol('proto.qdb.DatabaseField', null, global);
 goog.exportSymbol('proto.qdb.DatabaseFieldSchema', null, global);
+goog.exportSymbol('proto.qdb.DatabaseFieldSchema.IndexEnum', null, global);
+goog.exportSymbol('proto.qdb.DatabaseFieldSchema.ReferencePolicyEnum', null, global);
 goog.exportSymbol('proto.qdb.DatabaseNotification', null, global);
 goog.exportSymbol('proto.qdb.DatabaseNotificationConfig', null, global);
+goog.exportSymbol('proto.qdb.DatabaseQueryRow', null, global);
+goog.exportSymbol('proto.qdb.DatabaseReference', null, global);
 goog.exportSymbol('proto.qdb.DatabaseRequest', null, global);
 goog.exportSymbol('proto.qdb.DatabaseSnapshot', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotDiff', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotDiff.EntityChange', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotDiff.FieldChange', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotMergeSummary', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotRecord', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotRecord.Header', null, global);
+goog.exportSymbol('proto.qdb.DatabaseSnapshotRecord.Trailer', null, global);
 goog.exportSymbol('proto.qdb.EntityReference', null, global);
+goog.exportSymbol('proto.qdb.EntityReferenceList', null, global);
+goog.exportSymbol('proto.qdb.Enum', null, global);
 goog.exportSymbol('proto.qdb.Float', null, global);
 goog.exportSymbol('proto.qdb.Int', null, global);
+goog.exportSymbol('proto.qdb.IntList', null, global);
 goog.exportSymbol('proto.qdb.LogMessage', null, global);
 goog.exportSymbol('proto.qdb.LogMessage.LogLevelEnum', null, global);
 goog.exportSymbol('proto.qdb.String', null, global);
+goog.exportSymbol('proto.qdb.StringList', null, global);
+goog.exportSymbol('proto.qdb.StringMap', null, global);
 goog.exportSymbol('proto.qdb.Timestamp', null, global);
 goog.exportSymbol('proto.qdb.Transformation', null, global);
 goog.exportSymbol('proto.qdb.WebConfigCreateEntityRequest', null, global);
@@ -739,8 +764,17 @@ goog.exportSymbol('proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum', null,
 goog.exportSymbol('proto.qdb.WebConfigDeleteEntityRequest', null, global);
 goog.exportSymbol('proto.qdb.WebConfigDeleteEntityResponse', null, global);
 goog.exportSymbol('proto.qdb.WebConfigDeleteEntityResponse.StatusEnum', null, global);
+goog.exportSymbol('proto.qdb.WebConfigDiffSnapshotRequest', null, global);
+goog.exportSymbol('proto.qdb.WebConfigDiffSnapshotResponse', null, global);
+goog.exportSymbol('proto.qdb.WebConfigDiffSnapshotResponse.StatusEnum', null, global);
 goog.exportSymbol('proto.qdb.WebConfigGetAllFieldsRequest', null, global);
 goog.exportSymbol('proto.qdb.WebConfigGetAllFieldsResponse', null, global);
+goog.exportSymbol('proto.qdb.WebConfigGetEntityByPathRequest', null, global);
+goog.exportSymbol('proto.qdb.WebConfigGetEntityByPathResponse', null, global);
+goog.exportSymbol('proto.qdb.WebConfigGetEntityByPathResponse.StatusEnum', null, global);
+goog.exportSymbol('proto.qdb.WebConfigGetEntityPathRequest', null, global);
+goog.exportSymbol('proto.qdb.WebConfigGetEntityPathResponse', null, global);
+goog.exportSymbol('proto.qdb.WebConfigGetEntityPathResponse.StatusEnum', null, global);
 goog.exportSymbol('proto.qdb.WebConfigGetEntityRequest', null, global);
 goog.exportSymbol('proto.qdb.WebConfigGetEntityResponse', null, global);
 goog.exportSymbol('proto.qdb.WebConfigGetEntityResponse.StatusEnum', null, global);
@@ -763,6 +797,10 @@ goog.exportSymbol('proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum', null,
 goog.exportSymbol('proto.qdb.WebConfigSetFieldSchemaRequest', null, global);
 goog.exportSymbol('proto.qdb.WebConfigSetFieldSchemaResponse', null, global);
 goog.exportSymbol('proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum', null, global);
+goog.exportSymbol('proto.qdb.WebConfigTraverseEntityRequest', null, global);
+goog.exportSymbol('proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum', null, global);
+goog.exportSymbol('proto.qdb.WebConfigTraverseEntityResponse', null, global);
+goog.exportSymbol('proto.qdb.WebConfigTraverseEntityResponse.StatusEnum', null, global);
 goog.exportSymbol('proto.qdb.WebHeader', null, global);
 goog.exportSymbol('proto.qdb.WebHeader.AuthenticationStatusEnum', null, global);
 goog.exportSymbol('proto.qdb.WebMessage', null, global);
@@ -775,6 +813,12 @@ goog.exportSymbol('proto.qdb.WebRuntimeGetEntitiesRequest', null, global);
 goog.exportSymbol('proto.qdb.WebRuntimeGetEntitiesResponse', null, global);
 goog.exportSymbol('proto.qdb.WebRuntimeGetNotificationsRequest', null, global);
 goog.exportSymbol('proto.qdb.WebRuntimeGetNotificationsResponse', null, global);
+goog.exportSymbol('proto.qdb.WebRuntimeGetReferrersRequest', null, global);
+goog.exportSymbol('proto.qdb.WebRuntimeGetReferrersResponse', null, global);
+goog.exportSymbol('proto.qdb.WebRuntimeGetReferrersResponse.StatusEnum', null, global);
+goog.exportSymbol('proto.qdb.WebRuntimeQueryRequest', null, global);
+goog.exportSymbol('proto.qdb.WebRuntimeQueryResponse', null, global);
+goog.exportSymbol('proto.qdb.WebRuntimeQueryResponse.StatusEnum', null, global);
 goog.exportSymbol('proto.qdb.WebRuntimeRegisterNotificationRequest', null, global);
 goog.exportSymbol('proto.qdb.WebRuntimeRegisterNotificationResponse', null, global);
 goog.exportSymbol('proto.qdb.WebRuntimeUnregisterNotificationRequest', null, global);
@@ -990,6 +1034,132 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.qdb.WebConfigGetEntityResponse.displayName = 'proto.qdb.WebConfigGetEntityResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigGetEntityByPathRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.WebConfigGetEntityByPathRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigGetEntityByPathRequest.displayName = 'proto.qdb.WebConfigGetEntityByPathRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigGetEntityByPathResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.WebConfigGetEntityByPathResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigGetEntityByPathResponse.displayName = 'proto.qdb.WebConfigGetEntityByPathResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigGetEntityPathRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.WebConfigGetEntityPathRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigGetEntityPathRequest.displayName = 'proto.qdb.WebConfigGetEntityPathRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigGetEntityPathResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.WebConfigGetEntityPathResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigGetEntityPathResponse.displayName = 'proto.qdb.WebConfigGetEntityPathResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigTraverseEntityRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.WebConfigTraverseEntityRequest.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.WebConfigTraverseEntityRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigTraverseEntityRequest.displayName = 'proto.qdb.WebConfigTraverseEntityRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigTraverseEntityResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.WebConfigTraverseEntityResponse.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.WebConfigTraverseEntityResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigTraverseEntityResponse.displayName = 'proto.qdb.WebConfigTraverseEntityResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1242,6 +1412,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.qdb.WebConfigRestoreSnapshotResponse.displayName = 'proto.qdb.WebConfigRestoreSnapshotResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigDiffSnapshotRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.WebConfigDiffSnapshotRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigDiffSnapshotRequest.displayName = 'proto.qdb.WebConfigDiffSnapshotRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.WebConfigDiffSnapshotResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.WebConfigDiffSnapshotResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.WebConfigDiffSnapshotResponse.displayName = 'proto.qdb.WebConfigDiffSnapshotResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1588,16 +1800,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseEntity = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseEntity.repeatedFields_, null);
+proto.qdb.WebRuntimeQueryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.DatabaseEntity, jspb.Message);
+goog.inherits(proto.qdb.WebRuntimeQueryRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseEntity.displayName = 'proto.qdb.DatabaseEntity';
+  proto.qdb.WebRuntimeQueryRequest.displayName = 'proto.qdb.WebRuntimeQueryRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1609,16 +1821,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseField = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.qdb.WebRuntimeQueryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.WebRuntimeQueryResponse.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.DatabaseField, jspb.Message);
+goog.inherits(proto.qdb.WebRuntimeQueryResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseField.displayName = 'proto.qdb.DatabaseField';
+  proto.qdb.WebRuntimeQueryResponse.displayName = 'proto.qdb.WebRuntimeQueryResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1630,16 +1842,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseNotificationConfig = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseNotificationConfig.repeatedFields_, null);
+proto.qdb.WebRuntimeGetReferrersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.DatabaseNotificationConfig, jspb.Message);
+goog.inherits(proto.qdb.WebRuntimeGetReferrersRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseNotificationConfig.displayName = 'proto.qdb.DatabaseNotificationConfig';
+  proto.qdb.WebRuntimeGetReferrersRequest.displayName = 'proto.qdb.WebRuntimeGetReferrersRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1651,16 +1863,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseNotification = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseNotification.repeatedFields_, null);
+proto.qdb.WebRuntimeGetReferrersResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.WebRuntimeGetReferrersResponse.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.DatabaseNotification, jspb.Message);
+goog.inherits(proto.qdb.WebRuntimeGetReferrersResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseNotification.displayName = 'proto.qdb.DatabaseNotification';
+  proto.qdb.WebRuntimeGetReferrersResponse.displayName = 'proto.qdb.WebRuntimeGetReferrersResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1672,16 +1884,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseEntitySchema = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseEntitySchema.repeatedFields_, null);
+proto.qdb.DatabaseReference = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.DatabaseEntitySchema, jspb.Message);
+goog.inherits(proto.qdb.DatabaseReference, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseEntitySchema.displayName = 'proto.qdb.DatabaseEntitySchema';
+  proto.qdb.DatabaseReference.displayName = 'proto.qdb.DatabaseReference';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1693,16 +1905,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseFieldSchema = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.qdb.DatabaseEntity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseEntity.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.DatabaseFieldSchema, jspb.Message);
+goog.inherits(proto.qdb.DatabaseEntity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseFieldSchema.displayName = 'proto.qdb.DatabaseFieldSchema';
+  proto.qdb.DatabaseEntity.displayName = 'proto.qdb.DatabaseEntity';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1714,16 +1926,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.qdb.DatabaseSnapshotMergeSummary = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseSnapshotMergeSummary.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.DatabaseRequest, jspb.Message);
+goog.inherits(proto.qdb.DatabaseSnapshotMergeSummary, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseRequest.displayName = 'proto.qdb.DatabaseRequest';
+  proto.qdb.DatabaseSnapshotMergeSummary.displayName = 'proto.qdb.DatabaseSnapshotMergeSummary';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1735,16 +1947,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.DatabaseSnapshot = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseSnapshot.repeatedFields_, null);
+proto.qdb.DatabaseSnapshotDiff = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseSnapshotDiff.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.DatabaseSnapshot, jspb.Message);
+goog.inherits(proto.qdb.DatabaseSnapshotDiff, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.DatabaseSnapshot.displayName = 'proto.qdb.DatabaseSnapshot';
+  proto.qdb.DatabaseSnapshotDiff.displayName = 'proto.qdb.DatabaseSnapshotDiff';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1756,16 +1968,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.Int = function(opt_data) {
+proto.qdb.DatabaseSnapshotDiff.EntityChange = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.Int, jspb.Message);
+goog.inherits(proto.qdb.DatabaseSnapshotDiff.EntityChange, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.Int.displayName = 'proto.qdb.Int';
+  proto.qdb.DatabaseSnapshotDiff.EntityChange.displayName = 'proto.qdb.DatabaseSnapshotDiff.EntityChange';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1777,16 +1989,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.String = function(opt_data) {
+proto.qdb.DatabaseSnapshotDiff.FieldChange = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.String, jspb.Message);
+goog.inherits(proto.qdb.DatabaseSnapshotDiff.FieldChange, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.String.displayName = 'proto.qdb.String';
+  proto.qdb.DatabaseSnapshotDiff.FieldChange.displayName = 'proto.qdb.DatabaseSnapshotDiff.FieldChange';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1798,16 +2010,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.Timestamp = function(opt_data) {
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.Timestamp, jspb.Message);
+goog.inherits(proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.Timestamp.displayName = 'proto.qdb.Timestamp';
+  proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.displayName = 'proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1819,16 +2031,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.Float = function(opt_data) {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.Float, jspb.Message);
+goog.inherits(proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.Float.displayName = 'proto.qdb.Float';
+  proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.displayName = 'proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1840,16 +2052,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.Bool = function(opt_data) {
+proto.qdb.DatabaseEntityEvent = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.Bool, jspb.Message);
+goog.inherits(proto.qdb.DatabaseEntityEvent, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.Bool.displayName = 'proto.qdb.Bool';
+  proto.qdb.DatabaseEntityEvent.displayName = 'proto.qdb.DatabaseEntityEvent';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1861,16 +2073,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.EntityReference = function(opt_data) {
+proto.qdb.DatabaseField = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.qdb.EntityReference, jspb.Message);
+goog.inherits(proto.qdb.DatabaseField, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.EntityReference.displayName = 'proto.qdb.EntityReference';
+  proto.qdb.DatabaseField.displayName = 'proto.qdb.DatabaseField';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1882,16 +2094,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.BinaryFile = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.qdb.DatabaseNotificationConfig = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseNotificationConfig.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.BinaryFile, jspb.Message);
+goog.inherits(proto.qdb.DatabaseNotificationConfig, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.BinaryFile.displayName = 'proto.qdb.BinaryFile';
+  proto.qdb.DatabaseNotificationConfig.displayName = 'proto.qdb.DatabaseNotificationConfig';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1903,16 +2115,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.Transformation = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.qdb.DatabaseNotification = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseNotification.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.Transformation, jspb.Message);
+goog.inherits(proto.qdb.DatabaseNotification, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.Transformation.displayName = 'proto.qdb.Transformation';
+  proto.qdb.DatabaseNotification.displayName = 'proto.qdb.DatabaseNotification';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1924,16 +2136,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.LogMessage = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.qdb.DatabaseEntitySchema = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseEntitySchema.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.LogMessage, jspb.Message);
+goog.inherits(proto.qdb.DatabaseEntitySchema, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.LogMessage.displayName = 'proto.qdb.LogMessage';
+  proto.qdb.DatabaseEntitySchema.displayName = 'proto.qdb.DatabaseEntitySchema';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1945,34 +2157,538 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.qdb.ConnectionState = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.qdb.DatabaseFieldSchema = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseFieldSchema.repeatedFields_, null);
 };
-goog.inherits(proto.qdb.ConnectionState, jspb.Message);
+goog.inherits(proto.qdb.DatabaseFieldSchema, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.qdb.ConnectionState.displayName = 'proto.qdb.ConnectionState';
+  proto.qdb.DatabaseFieldSchema.displayName = 'proto.qdb.DatabaseFieldSchema';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.qdb.WebHeader.prototype.toObject = function(opt_includeInstance) {
+proto.qdb.DatabaseEnumValue = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.DatabaseEnumValue, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseEnumValue.displayName = 'proto.qdb.DatabaseEnumValue';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseAlarmLimit = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.DatabaseAlarmLimit, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseAlarmLimit.displayName = 'proto.qdb.DatabaseAlarmLimit';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseComputedField = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseComputedField.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.DatabaseComputedField, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseComputedField.displayName = 'proto.qdb.DatabaseComputedField';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.DatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseRequest.displayName = 'proto.qdb.DatabaseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseQueryRow = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseQueryRow.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.DatabaseQueryRow, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseQueryRow.displayName = 'proto.qdb.DatabaseQueryRow';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseSnapshot = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.DatabaseSnapshot.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.DatabaseSnapshot, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseSnapshot.displayName = 'proto.qdb.DatabaseSnapshot';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseSnapshotRecord = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.DatabaseSnapshotRecord, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseSnapshotRecord.displayName = 'proto.qdb.DatabaseSnapshotRecord';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseSnapshotRecord.Header = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.DatabaseSnapshotRecord.Header, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseSnapshotRecord.Header.displayName = 'proto.qdb.DatabaseSnapshotRecord.Header';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.DatabaseSnapshotRecord.Trailer = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.DatabaseSnapshotRecord.Trailer, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.DatabaseSnapshotRecord.Trailer.displayName = 'proto.qdb.DatabaseSnapshotRecord.Trailer';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.Int = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.Int, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.Int.displayName = 'proto.qdb.Int';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.String = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.String, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.String.displayName = 'proto.qdb.String';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.Timestamp = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.Timestamp, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.Timestamp.displayName = 'proto.qdb.Timestamp';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.Float = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.Float, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.Float.displayName = 'proto.qdb.Float';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.Bool = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.Bool, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.Bool.displayName = 'proto.qdb.Bool';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.EntityReference = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.EntityReference, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.EntityReference.displayName = 'proto.qdb.EntityReference';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.BinaryFile = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.BinaryFile, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.BinaryFile.displayName = 'proto.qdb.BinaryFile';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.Transformation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.Transformation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.Transformation.displayName = 'proto.qdb.Transformation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.IntList = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.IntList.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.IntList, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.IntList.displayName = 'proto.qdb.IntList';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.StringList = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.StringList.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.StringList, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.StringList.displayName = 'proto.qdb.StringList';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.EntityReferenceList = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.qdb.EntityReferenceList.repeatedFields_, null);
+};
+goog.inherits(proto.qdb.EntityReferenceList, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.EntityReferenceList.displayName = 'proto.qdb.EntityReferenceList';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.StringMap = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.StringMap, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.StringMap.displayName = 'proto.qdb.StringMap';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.Enum = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.Enum, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.Enum.displayName = 'proto.qdb.Enum';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.LogMessage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.LogMessage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.LogMessage.displayName = 'proto.qdb.LogMessage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.qdb.ConnectionState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.qdb.ConnectionState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.qdb.ConnectionState.displayName = 'proto.qdb.ConnectionState';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebHeader.prototype.toObject = function(opt_includeInstance) {
   return proto.qdb.WebHeader.toObject(opt_includeInstance, this);
 };
 
@@ -1982,15 +2698,6841 @@ proto.qdb.WebHeader.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebHeader} msg The msg instance to transform.
+ * @param {!proto.qdb.WebHeader} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebHeader.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+authenticationstatus: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebHeader}
+ */
+proto.qdb.WebHeader.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebHeader;
+  return proto.qdb.WebHeader.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebHeader} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebHeader}
+ */
+proto.qdb.WebHeader.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.qdb.WebHeader.AuthenticationStatusEnum} */ (reader.readEnum());
+      msg.setAuthenticationstatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebHeader.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebHeader.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebHeader} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebHeader.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getAuthenticationstatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebHeader.AuthenticationStatusEnum = {
+  UNSPECIFIED: 0,
+  AUTHENTICATED: 1,
+  UNAUTHENTICATED: 2
+};
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.qdb.WebHeader.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebHeader} returns this
+ */
+proto.qdb.WebHeader.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.qdb.WebHeader.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.qdb.WebHeader} returns this
+*/
+proto.qdb.WebHeader.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebHeader} returns this
+ */
+proto.qdb.WebHeader.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebHeader.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional AuthenticationStatusEnum authenticationStatus = 3;
+ * @return {!proto.qdb.WebHeader.AuthenticationStatusEnum}
+ */
+proto.qdb.WebHeader.prototype.getAuthenticationstatus = function() {
+  return /** @type {!proto.qdb.WebHeader.AuthenticationStatusEnum} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebHeader.AuthenticationStatusEnum} value
+ * @return {!proto.qdb.WebHeader} returns this
+ */
+proto.qdb.WebHeader.prototype.setAuthenticationstatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebMessage.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebMessage.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebMessage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebMessage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+header: (f = msg.getHeader()) && proto.qdb.WebHeader.toObject(includeInstance, f),
+payload: (f = msg.getPayload()) && google_protobuf_any_pb.Any.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebMessage}
+ */
+proto.qdb.WebMessage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebMessage;
+  return proto.qdb.WebMessage.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebMessage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebMessage}
+ */
+proto.qdb.WebMessage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.qdb.WebHeader;
+      reader.readMessage(value,proto.qdb.WebHeader.deserializeBinaryFromReader);
+      msg.setHeader(value);
+      break;
+    case 2:
+      var value = new google_protobuf_any_pb.Any;
+      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
+      msg.setPayload(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebMessage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebMessage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebMessage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebMessage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeader();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.qdb.WebHeader.serializeBinaryToWriter
+    );
+  }
+  f = message.getPayload();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_any_pb.Any.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional WebHeader header = 1;
+ * @return {?proto.qdb.WebHeader}
+ */
+proto.qdb.WebMessage.prototype.getHeader = function() {
+  return /** @type{?proto.qdb.WebHeader} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.WebHeader, 1));
+};
+
+
+/**
+ * @param {?proto.qdb.WebHeader|undefined} value
+ * @return {!proto.qdb.WebMessage} returns this
+*/
+proto.qdb.WebMessage.prototype.setHeader = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebMessage} returns this
+ */
+proto.qdb.WebMessage.prototype.clearHeader = function() {
+  return this.setHeader(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebMessage.prototype.hasHeader = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional google.protobuf.Any payload = 2;
+ * @return {?proto.google.protobuf.Any}
+ */
+proto.qdb.WebMessage.prototype.getPayload = function() {
+  return /** @type{?proto.google.protobuf.Any} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Any|undefined} value
+ * @return {!proto.qdb.WebMessage} returns this
+*/
+proto.qdb.WebMessage.prototype.setPayload = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebMessage} returns this
+ */
+proto.qdb.WebMessage.prototype.clearPayload = function() {
+  return this.setPayload(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebMessage.prototype.hasPayload = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigCreateEntityRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigCreateEntityRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateEntityRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+type: jspb.Message.getFieldWithDefault(msg, 1, ""),
+name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parentid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigCreateEntityRequest}
+ */
+proto.qdb.WebConfigCreateEntityRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigCreateEntityRequest;
+  return proto.qdb.WebConfigCreateEntityRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigCreateEntityRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigCreateEntityRequest}
+ */
+proto.qdb.WebConfigCreateEntityRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setParentid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigCreateEntityRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigCreateEntityRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateEntityRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getParentid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string type = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigCreateEntityRequest} returns this
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigCreateEntityRequest} returns this
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string parentId = 3;
+ * @return {string}
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.getParentid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigCreateEntityRequest} returns this
+ */
+proto.qdb.WebConfigCreateEntityRequest.prototype.setParentid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigCreateEntityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigCreateEntityResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigCreateEntityResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateEntityResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+id: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigCreateEntityResponse}
+ */
+proto.qdb.WebConfigCreateEntityResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigCreateEntityResponse;
+  return proto.qdb.WebConfigCreateEntityResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigCreateEntityResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigCreateEntityResponse}
+ */
+proto.qdb.WebConfigCreateEntityResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigCreateEntityResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigCreateEntityResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigCreateEntityResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateEntityResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigCreateEntityResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum}
+ */
+proto.qdb.WebConfigCreateEntityResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigCreateEntityResponse} returns this
+ */
+proto.qdb.WebConfigCreateEntityResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string id = 2;
+ * @return {string}
+ */
+proto.qdb.WebConfigCreateEntityResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigCreateEntityResponse} returns this
+ */
+proto.qdb.WebConfigCreateEntityResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigDeleteEntityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigDeleteEntityRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigDeleteEntityRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDeleteEntityRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigDeleteEntityRequest}
+ */
+proto.qdb.WebConfigDeleteEntityRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigDeleteEntityRequest;
+  return proto.qdb.WebConfigDeleteEntityRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigDeleteEntityRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigDeleteEntityRequest}
+ */
+proto.qdb.WebConfigDeleteEntityRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigDeleteEntityRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigDeleteEntityRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigDeleteEntityRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDeleteEntityRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigDeleteEntityRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigDeleteEntityRequest} returns this
+ */
+proto.qdb.WebConfigDeleteEntityRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigDeleteEntityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigDeleteEntityResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigDeleteEntityResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDeleteEntityResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigDeleteEntityResponse}
+ */
+proto.qdb.WebConfigDeleteEntityResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigDeleteEntityResponse;
+  return proto.qdb.WebConfigDeleteEntityResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigDeleteEntityResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigDeleteEntityResponse}
+ */
+proto.qdb.WebConfigDeleteEntityResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigDeleteEntityResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigDeleteEntityResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigDeleteEntityResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDeleteEntityResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigDeleteEntityResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum}
+ */
+proto.qdb.WebConfigDeleteEntityResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigDeleteEntityResponse} returns this
+ */
+proto.qdb.WebConfigDeleteEntityResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityTypesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityTypesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+cursor: jspb.Message.getFieldWithDefault(msg, 1, ""),
+pagesize: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityTypesRequest}
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityTypesRequest;
+  return proto.qdb.WebConfigGetEntityTypesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityTypesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityTypesRequest}
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPagesize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityTypesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityTypesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPagesize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string cursor = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetEntityTypesRequest} returns this
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 pageSize = 2;
+ * @return {number}
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.prototype.getPagesize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.WebConfigGetEntityTypesRequest} returns this
+ */
+proto.qdb.WebConfigGetEntityTypesRequest.prototype.setPagesize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityTypesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityTypesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+typesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+nextcursor: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityTypesResponse}
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityTypesResponse;
+  return proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityTypesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityTypesResponse}
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addTypes(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextcursor(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityTypesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityTypesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getNextcursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string types = 1;
+ * @return {!Array<string>}
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.getTypesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.WebConfigGetEntityTypesResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.setTypesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.WebConfigGetEntityTypesResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.addTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebConfigGetEntityTypesResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.clearTypesList = function() {
+  return this.setTypesList([]);
+};
+
+
+/**
+ * optional string nextCursor = 2;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.getNextcursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetEntityTypesResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityTypesResponse.prototype.setNextcursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityRequest}
+ */
+proto.qdb.WebConfigGetEntityRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityRequest;
+  return proto.qdb.WebConfigGetEntityRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityRequest}
+ */
+proto.qdb.WebConfigGetEntityRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetEntityRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetEntityRequest} returns this
+ */
+proto.qdb.WebConfigGetEntityRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+entity: (f = msg.getEntity()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityResponse}
+ */
+proto.qdb.WebConfigGetEntityResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityResponse;
+  return proto.qdb.WebConfigGetEntityResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityResponse}
+ */
+proto.qdb.WebConfigGetEntityResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigGetEntityResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.setEntity(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getEntity();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigGetEntityResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigGetEntityResponse.StatusEnum}
+ */
+proto.qdb.WebConfigGetEntityResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigGetEntityResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigGetEntityResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigGetEntityResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseEntity entity = 2;
+ * @return {?proto.qdb.DatabaseEntity}
+ */
+proto.qdb.WebConfigGetEntityResponse.prototype.getEntity = function() {
+  return /** @type{?proto.qdb.DatabaseEntity} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseEntity|undefined} value
+ * @return {!proto.qdb.WebConfigGetEntityResponse} returns this
+*/
+proto.qdb.WebConfigGetEntityResponse.prototype.setEntity = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigGetEntityResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityResponse.prototype.clearEntity = function() {
+  return this.setEntity(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigGetEntityResponse.prototype.hasEntity = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityByPathRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityByPathRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+path: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityByPathRequest}
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityByPathRequest;
+  return proto.qdb.WebConfigGetEntityByPathRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityByPathRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityByPathRequest}
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityByPathRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityByPathRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string path = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetEntityByPathRequest} returns this
+ */
+proto.qdb.WebConfigGetEntityByPathRequest.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityByPathResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityByPathResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+entity: (f = msg.getEntity()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityByPathResponse}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityByPathResponse;
+  return proto.qdb.WebConfigGetEntityByPathResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityByPathResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityByPathResponse}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigGetEntityByPathResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.setEntity(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityByPathResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityByPathResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getEntity();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigGetEntityByPathResponse.StatusEnum}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigGetEntityByPathResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigGetEntityByPathResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigGetEntityByPathResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseEntity entity = 2;
+ * @return {?proto.qdb.DatabaseEntity}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.getEntity = function() {
+  return /** @type{?proto.qdb.DatabaseEntity} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseEntity|undefined} value
+ * @return {!proto.qdb.WebConfigGetEntityByPathResponse} returns this
+*/
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.setEntity = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigGetEntityByPathResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.clearEntity = function() {
+  return this.setEntity(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigGetEntityByPathResponse.prototype.hasEntity = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityPathRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityPathRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityPathRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityPathRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityPathRequest}
+ */
+proto.qdb.WebConfigGetEntityPathRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityPathRequest;
+  return proto.qdb.WebConfigGetEntityPathRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityPathRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityPathRequest}
+ */
+proto.qdb.WebConfigGetEntityPathRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityPathRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityPathRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityPathRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityPathRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetEntityPathRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetEntityPathRequest} returns this
+ */
+proto.qdb.WebConfigGetEntityPathRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntityPathResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntityPathResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntityPathResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityPathResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+path: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntityPathResponse}
+ */
+proto.qdb.WebConfigGetEntityPathResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntityPathResponse;
+  return proto.qdb.WebConfigGetEntityPathResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntityPathResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntityPathResponse}
+ */
+proto.qdb.WebConfigGetEntityPathResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigGetEntityPathResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntityPathResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntityPathResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntityPathResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntityPathResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigGetEntityPathResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigGetEntityPathResponse.StatusEnum}
+ */
+proto.qdb.WebConfigGetEntityPathResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigGetEntityPathResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigGetEntityPathResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigGetEntityPathResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityPathResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string path = 2;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetEntityPathResponse.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetEntityPathResponse} returns this
+ */
+proto.qdb.WebConfigGetEntityPathResponse.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebConfigTraverseEntityRequest.repeatedFields_ = [5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigTraverseEntityRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigTraverseEntityRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigTraverseEntityRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+direction: jspb.Message.getFieldWithDefault(msg, 2, 0),
+depthfirst: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+maxdepth: jspb.Message.getFieldWithDefault(msg, 4, 0),
+entitytypesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigTraverseEntityRequest;
+  return proto.qdb.WebConfigTraverseEntityRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigTraverseEntityRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum} */ (reader.readEnum());
+      msg.setDirection(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDepthfirst(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxdepth(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEntitytypes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigTraverseEntityRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigTraverseEntityRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigTraverseEntityRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDirection();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getDepthfirst();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getMaxdepth();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getEntitytypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum = {
+  ANCESTORS: 0,
+  DESCENDANTS: 1,
+  SIBLINGS: 2
+};
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest} returns this
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional DirectionEnum direction = 2;
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.getDirection = function() {
+  return /** @type {!proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigTraverseEntityRequest.DirectionEnum} value
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest} returns this
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.setDirection = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional bool depthFirst = 3;
+ * @return {boolean}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.getDepthfirst = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest} returns this
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.setDepthfirst = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional int32 maxDepth = 4;
+ * @return {number}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.getMaxdepth = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest} returns this
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.setMaxdepth = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * repeated string entityTypes = 5;
+ * @return {!Array<string>}
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.getEntitytypesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest} returns this
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.setEntitytypesList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest} returns this
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.addEntitytypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebConfigTraverseEntityRequest} returns this
+ */
+proto.qdb.WebConfigTraverseEntityRequest.prototype.clearEntitytypesList = function() {
+  return this.setEntitytypesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebConfigTraverseEntityResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigTraverseEntityResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigTraverseEntityResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigTraverseEntityResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+entitiesList: jspb.Message.toObjectList(msg.getEntitiesList(),
+    proto.qdb.DatabaseEntity.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigTraverseEntityResponse}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigTraverseEntityResponse;
+  return proto.qdb.WebConfigTraverseEntityResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigTraverseEntityResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigTraverseEntityResponse}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigTraverseEntityResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.addEntities(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigTraverseEntityResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigTraverseEntityResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigTraverseEntityResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getEntitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigTraverseEntityResponse.StatusEnum}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigTraverseEntityResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigTraverseEntityResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigTraverseEntityResponse} returns this
+ */
+proto.qdb.WebConfigTraverseEntityResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * repeated DatabaseEntity entities = 2;
+ * @return {!Array<!proto.qdb.DatabaseEntity>}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.prototype.getEntitiesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEntity, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseEntity>} value
+ * @return {!proto.qdb.WebConfigTraverseEntityResponse} returns this
+*/
+proto.qdb.WebConfigTraverseEntityResponse.prototype.setEntitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseEntity}
+ */
+proto.qdb.WebConfigTraverseEntityResponse.prototype.addEntities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseEntity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebConfigTraverseEntityResponse} returns this
+ */
+proto.qdb.WebConfigTraverseEntityResponse.prototype.clearEntitiesList = function() {
+  return this.setEntitiesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetFieldSchemaRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetFieldSchemaRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+field: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetFieldSchemaRequest}
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetFieldSchemaRequest;
+  return proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetFieldSchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetFieldSchemaRequest}
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetFieldSchemaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetFieldSchemaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string field = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetFieldSchemaRequest} returns this
+ */
+proto.qdb.WebConfigGetFieldSchemaRequest.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetFieldSchemaResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetFieldSchemaResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+schema: (f = msg.getSchema()) && proto.qdb.DatabaseFieldSchema.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetFieldSchemaResponse}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetFieldSchemaResponse;
+  return proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetFieldSchemaResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetFieldSchemaResponse}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseFieldSchema;
+      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
+      msg.setSchema(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetFieldSchemaResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetFieldSchemaResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getSchema();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigGetFieldSchemaResponse} returns this
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseFieldSchema schema = 2;
+ * @return {?proto.qdb.DatabaseFieldSchema}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.getSchema = function() {
+  return /** @type{?proto.qdb.DatabaseFieldSchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseFieldSchema, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseFieldSchema|undefined} value
+ * @return {!proto.qdb.WebConfigGetFieldSchemaResponse} returns this
+*/
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.setSchema = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigGetFieldSchemaResponse} returns this
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.clearSchema = function() {
+  return this.setSchema(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigGetFieldSchemaResponse.prototype.hasSchema = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigSetFieldSchemaRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigSetFieldSchemaRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+field: jspb.Message.getFieldWithDefault(msg, 1, ""),
+schema: (f = msg.getSchema()) && proto.qdb.DatabaseFieldSchema.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigSetFieldSchemaRequest}
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigSetFieldSchemaRequest;
+  return proto.qdb.WebConfigSetFieldSchemaRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigSetFieldSchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigSetFieldSchemaRequest}
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseFieldSchema;
+      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
+      msg.setSchema(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigSetFieldSchemaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigSetFieldSchemaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSchema();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string field = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigSetFieldSchemaRequest} returns this
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseFieldSchema schema = 2;
+ * @return {?proto.qdb.DatabaseFieldSchema}
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.getSchema = function() {
+  return /** @type{?proto.qdb.DatabaseFieldSchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseFieldSchema, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseFieldSchema|undefined} value
+ * @return {!proto.qdb.WebConfigSetFieldSchemaRequest} returns this
+*/
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.setSchema = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigSetFieldSchemaRequest} returns this
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.clearSchema = function() {
+  return this.setSchema(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigSetFieldSchemaRequest.prototype.hasSchema = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigSetFieldSchemaResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigSetFieldSchemaResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigSetFieldSchemaResponse}
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigSetFieldSchemaResponse;
+  return proto.qdb.WebConfigSetFieldSchemaResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigSetFieldSchemaResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigSetFieldSchemaResponse}
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigSetFieldSchemaResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigSetFieldSchemaResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum}
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigSetFieldSchemaResponse} returns this
+ */
+proto.qdb.WebConfigSetFieldSchemaResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntitySchemaRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntitySchemaRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+type: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntitySchemaRequest}
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntitySchemaRequest;
+  return proto.qdb.WebConfigGetEntitySchemaRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntitySchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntitySchemaRequest}
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntitySchemaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntitySchemaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string type = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetEntitySchemaRequest} returns this
+ */
+proto.qdb.WebConfigGetEntitySchemaRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetEntitySchemaResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetEntitySchemaResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+schema: (f = msg.getSchema()) && proto.qdb.DatabaseEntitySchema.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetEntitySchemaResponse}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetEntitySchemaResponse;
+  return proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetEntitySchemaResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetEntitySchemaResponse}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseEntitySchema;
+      reader.readMessage(value,proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader);
+      msg.setSchema(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetEntitySchemaResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetEntitySchemaResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getSchema();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigGetEntitySchemaResponse} returns this
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseEntitySchema schema = 2;
+ * @return {?proto.qdb.DatabaseEntitySchema}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.getSchema = function() {
+  return /** @type{?proto.qdb.DatabaseEntitySchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntitySchema, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseEntitySchema|undefined} value
+ * @return {!proto.qdb.WebConfigGetEntitySchemaResponse} returns this
+*/
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.setSchema = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigGetEntitySchemaResponse} returns this
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.clearSchema = function() {
+  return this.setSchema(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigGetEntitySchemaResponse.prototype.hasSchema = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigSetEntitySchemaRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigSetEntitySchemaRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+fieldsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigSetEntitySchemaRequest}
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigSetEntitySchemaRequest;
+  return proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigSetEntitySchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigSetEntitySchemaRequest}
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFields(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigSetEntitySchemaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigSetEntitySchemaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string fields = 2;
+ * @return {!Array<string>}
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.getFieldsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.setFieldsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.addFields = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ */
+proto.qdb.WebConfigSetEntitySchemaRequest.prototype.clearFieldsList = function() {
+  return this.setFieldsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigSetEntitySchemaResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigSetEntitySchemaResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigSetEntitySchemaResponse}
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigSetEntitySchemaResponse;
+  return proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigSetEntitySchemaResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigSetEntitySchemaResponse}
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigSetEntitySchemaResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigSetEntitySchemaResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum}
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigSetEntitySchemaResponse} returns this
+ */
+proto.qdb.WebConfigSetEntitySchemaResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigCreateSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigCreateSnapshotRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigCreateSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateSnapshotRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigCreateSnapshotRequest}
+ */
+proto.qdb.WebConfigCreateSnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigCreateSnapshotRequest;
+  return proto.qdb.WebConfigCreateSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigCreateSnapshotRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigCreateSnapshotRequest}
+ */
+proto.qdb.WebConfigCreateSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigCreateSnapshotRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigCreateSnapshotRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigCreateSnapshotRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigCreateSnapshotResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigCreateSnapshotResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+snapshot: (f = msg.getSnapshot()) && proto.qdb.DatabaseSnapshot.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigCreateSnapshotResponse}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigCreateSnapshotResponse;
+  return proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigCreateSnapshotResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigCreateSnapshotResponse}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseSnapshot;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader);
+      msg.setSnapshot(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigCreateSnapshotResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigCreateSnapshotResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getSnapshot();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseSnapshot.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigCreateSnapshotResponse} returns this
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseSnapshot snapshot = 2;
+ * @return {?proto.qdb.DatabaseSnapshot}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.getSnapshot = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshot} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshot, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseSnapshot|undefined} value
+ * @return {!proto.qdb.WebConfigCreateSnapshotResponse} returns this
+*/
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.setSnapshot = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigCreateSnapshotResponse} returns this
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.clearSnapshot = function() {
+  return this.setSnapshot(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigCreateSnapshotResponse.prototype.hasSnapshot = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigRestoreSnapshotRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigRestoreSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+snapshot: (f = msg.getSnapshot()) && proto.qdb.DatabaseSnapshot.toObject(includeInstance, f),
+merge: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+prune: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+suppressnotifications: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+suppresstransformations: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigRestoreSnapshotRequest;
+  return proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigRestoreSnapshotRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.qdb.DatabaseSnapshot;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader);
+      msg.setSnapshot(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMerge(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPrune(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuppressnotifications(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuppresstransformations(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryrun(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigRestoreSnapshotRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigRestoreSnapshotRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSnapshot();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.qdb.DatabaseSnapshot.serializeBinaryToWriter
+    );
+  }
+  f = message.getMerge();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getPrune();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getSuppressnotifications();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getSuppresstransformations();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getDryrun();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional DatabaseSnapshot snapshot = 1;
+ * @return {?proto.qdb.DatabaseSnapshot}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.getSnapshot = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshot} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshot, 1));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseSnapshot|undefined} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+*/
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.setSnapshot = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.clearSnapshot = function() {
+  return this.setSnapshot(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.hasSnapshot = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bool merge = 2;
+ * @return {boolean}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.getMerge = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.setMerge = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool prune = 3;
+ * @return {boolean}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.getPrune = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.setPrune = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional bool suppressNotifications = 4;
+ * @return {boolean}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.getSuppressnotifications = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.setSuppressnotifications = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bool suppressTransformations = 5;
+ * @return {boolean}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.getSuppresstransformations = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.setSuppresstransformations = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional bool dryRun = 6;
+ * @return {boolean}
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.getDryrun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotRequest.prototype.setDryrun = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigRestoreSnapshotResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigRestoreSnapshotResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+summary: (f = msg.getSummary()) && proto.qdb.DatabaseSnapshotMergeSummary.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigRestoreSnapshotResponse}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigRestoreSnapshotResponse;
+  return proto.qdb.WebConfigRestoreSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigRestoreSnapshotResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigRestoreSnapshotResponse}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseSnapshotMergeSummary;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotMergeSummary.deserializeBinaryFromReader);
+      msg.setSummary(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigRestoreSnapshotResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigRestoreSnapshotResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getSummary();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseSnapshotMergeSummary.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotResponse} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseSnapshotMergeSummary summary = 2;
+ * @return {?proto.qdb.DatabaseSnapshotMergeSummary}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.getSummary = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshotMergeSummary} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshotMergeSummary, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseSnapshotMergeSummary|undefined} value
+ * @return {!proto.qdb.WebConfigRestoreSnapshotResponse} returns this
+*/
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.setSummary = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigRestoreSnapshotResponse} returns this
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.clearSummary = function() {
+  return this.setSummary(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigRestoreSnapshotResponse.prototype.hasSummary = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigDiffSnapshotRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigDiffSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+old: (f = msg.getOld()) && proto.qdb.DatabaseSnapshot.toObject(includeInstance, f),
+pb_new: (f = msg.getNew()) && proto.qdb.DatabaseSnapshot.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigDiffSnapshotRequest}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigDiffSnapshotRequest;
+  return proto.qdb.WebConfigDiffSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigDiffSnapshotRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigDiffSnapshotRequest}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.qdb.DatabaseSnapshot;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader);
+      msg.setOld(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseSnapshot;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader);
+      msg.setNew(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigDiffSnapshotRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigDiffSnapshotRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOld();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.qdb.DatabaseSnapshot.serializeBinaryToWriter
+    );
+  }
+  f = message.getNew();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseSnapshot.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional DatabaseSnapshot old = 1;
+ * @return {?proto.qdb.DatabaseSnapshot}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.getOld = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshot} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshot, 1));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseSnapshot|undefined} value
+ * @return {!proto.qdb.WebConfigDiffSnapshotRequest} returns this
+*/
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.setOld = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigDiffSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.clearOld = function() {
+  return this.setOld(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.hasOld = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional DatabaseSnapshot new = 2;
+ * @return {?proto.qdb.DatabaseSnapshot}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.getNew = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshot} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshot, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseSnapshot|undefined} value
+ * @return {!proto.qdb.WebConfigDiffSnapshotRequest} returns this
+*/
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.setNew = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigDiffSnapshotRequest} returns this
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.clearNew = function() {
+  return this.setNew(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigDiffSnapshotRequest.prototype.hasNew = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigDiffSnapshotResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigDiffSnapshotResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+diff: (f = msg.getDiff()) && proto.qdb.DatabaseSnapshotDiff.toObject(includeInstance, f),
+text: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigDiffSnapshotResponse}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigDiffSnapshotResponse;
+  return proto.qdb.WebConfigDiffSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigDiffSnapshotResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigDiffSnapshotResponse}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebConfigDiffSnapshotResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseSnapshotDiff;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.deserializeBinaryFromReader);
+      msg.setDiff(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setText(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigDiffSnapshotResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigDiffSnapshotResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getDiff();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseSnapshotDiff.serializeBinaryToWriter
+    );
+  }
+  f = message.getText();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebConfigDiffSnapshotResponse.StatusEnum}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebConfigDiffSnapshotResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebConfigDiffSnapshotResponse.StatusEnum} value
+ * @return {!proto.qdb.WebConfigDiffSnapshotResponse} returns this
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseSnapshotDiff diff = 2;
+ * @return {?proto.qdb.DatabaseSnapshotDiff}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.getDiff = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshotDiff} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshotDiff, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseSnapshotDiff|undefined} value
+ * @return {!proto.qdb.WebConfigDiffSnapshotResponse} returns this
+*/
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.setDiff = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebConfigDiffSnapshotResponse} returns this
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.clearDiff = function() {
+  return this.setDiff(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.hasDiff = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string text = 3;
+ * @return {string}
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigDiffSnapshotResponse} returns this
+ */
+proto.qdb.WebConfigDiffSnapshotResponse.prototype.setText = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetRootRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetRootRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetRootRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetRootRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetRootRequest}
+ */
+proto.qdb.WebConfigGetRootRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetRootRequest;
+  return proto.qdb.WebConfigGetRootRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetRootRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetRootRequest}
+ */
+proto.qdb.WebConfigGetRootRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetRootRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetRootRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetRootRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetRootRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetRootResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetRootResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetRootResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetRootResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+rootid: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetRootResponse}
+ */
+proto.qdb.WebConfigGetRootResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetRootResponse;
+  return proto.qdb.WebConfigGetRootResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetRootResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetRootResponse}
+ */
+proto.qdb.WebConfigGetRootResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRootid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetRootResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetRootResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetRootResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetRootResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRootid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string rootId = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetRootResponse.prototype.getRootid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetRootResponse} returns this
+ */
+proto.qdb.WebConfigGetRootResponse.prototype.setRootid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetAllFieldsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetAllFieldsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+cursor: jspb.Message.getFieldWithDefault(msg, 1, ""),
+pagesize: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetAllFieldsRequest}
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetAllFieldsRequest;
+  return proto.qdb.WebConfigGetAllFieldsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetAllFieldsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetAllFieldsRequest}
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPagesize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetAllFieldsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetAllFieldsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPagesize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string cursor = 1;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetAllFieldsRequest} returns this
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 pageSize = 2;
+ * @return {number}
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.prototype.getPagesize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.WebConfigGetAllFieldsRequest} returns this
+ */
+proto.qdb.WebConfigGetAllFieldsRequest.prototype.setPagesize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebConfigGetAllFieldsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebConfigGetAllFieldsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+fieldsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+nextcursor: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebConfigGetAllFieldsResponse}
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebConfigGetAllFieldsResponse;
+  return proto.qdb.WebConfigGetAllFieldsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebConfigGetAllFieldsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebConfigGetAllFieldsResponse}
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFields(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextcursor(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebConfigGetAllFieldsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebConfigGetAllFieldsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getNextcursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string fields = 1;
+ * @return {!Array<string>}
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.getFieldsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.WebConfigGetAllFieldsResponse} returns this
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.setFieldsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.WebConfigGetAllFieldsResponse} returns this
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.addFields = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebConfigGetAllFieldsResponse} returns this
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.clearFieldsList = function() {
+  return this.setFieldsList([]);
+};
+
+
+/**
+ * optional string nextCursor = 2;
+ * @return {string}
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.getNextcursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebConfigGetAllFieldsResponse} returns this
+ */
+proto.qdb.WebConfigGetAllFieldsResponse.prototype.setNextcursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeDatabaseRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeDatabaseRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeDatabaseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeDatabaseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+requesttype: jspb.Message.getFieldWithDefault(msg, 1, 0),
+requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
+    proto.qdb.DatabaseRequest.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebRuntimeDatabaseRequest}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebRuntimeDatabaseRequest;
+  return proto.qdb.WebRuntimeDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebRuntimeDatabaseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebRuntimeDatabaseRequest}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum} */ (reader.readEnum());
+      msg.setRequesttype(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseRequest;
+      reader.readMessage(value,proto.qdb.DatabaseRequest.deserializeBinaryFromReader);
+      msg.addRequests(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebRuntimeDatabaseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebRuntimeDatabaseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequesttype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getRequestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.qdb.DatabaseRequest.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum = {
+  UNSPECIFIED: 0,
+  READ: 1,
+  WRITE: 2
+};
+
+/**
+ * optional RequestTypeEnum requestType = 1;
+ * @return {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.prototype.getRequesttype = function() {
+  return /** @type {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum} value
+ * @return {!proto.qdb.WebRuntimeDatabaseRequest} returns this
+ */
+proto.qdb.WebRuntimeDatabaseRequest.prototype.setRequesttype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * repeated DatabaseRequest requests = 2;
+ * @return {!Array<!proto.qdb.DatabaseRequest>}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.prototype.getRequestsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseRequest>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseRequest, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseRequest>} value
+ * @return {!proto.qdb.WebRuntimeDatabaseRequest} returns this
+*/
+proto.qdb.WebRuntimeDatabaseRequest.prototype.setRequestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseRequest=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseRequest}
+ */
+proto.qdb.WebRuntimeDatabaseRequest.prototype.addRequests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseRequest, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeDatabaseRequest} returns this
+ */
+proto.qdb.WebRuntimeDatabaseRequest.prototype.clearRequestsList = function() {
+  return this.setRequestsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeDatabaseResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeDatabaseResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeDatabaseResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeDatabaseResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+responseList: jspb.Message.toObjectList(msg.getResponseList(),
+    proto.qdb.DatabaseRequest.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebRuntimeDatabaseResponse}
+ */
+proto.qdb.WebRuntimeDatabaseResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebRuntimeDatabaseResponse;
+  return proto.qdb.WebRuntimeDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebRuntimeDatabaseResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebRuntimeDatabaseResponse}
+ */
+proto.qdb.WebRuntimeDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 2:
+      var value = new proto.qdb.DatabaseRequest;
+      reader.readMessage(value,proto.qdb.DatabaseRequest.deserializeBinaryFromReader);
+      msg.addResponse(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebRuntimeDatabaseResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebRuntimeDatabaseResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebRuntimeDatabaseResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResponseList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.qdb.DatabaseRequest.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DatabaseRequest response = 2;
+ * @return {!Array<!proto.qdb.DatabaseRequest>}
+ */
+proto.qdb.WebRuntimeDatabaseResponse.prototype.getResponseList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseRequest>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseRequest, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseRequest>} value
+ * @return {!proto.qdb.WebRuntimeDatabaseResponse} returns this
+*/
+proto.qdb.WebRuntimeDatabaseResponse.prototype.setResponseList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseRequest=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseRequest}
+ */
+proto.qdb.WebRuntimeDatabaseResponse.prototype.addResponse = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseRequest, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeDatabaseResponse} returns this
+ */
+proto.qdb.WebRuntimeDatabaseResponse.prototype.clearResponseList = function() {
+  return this.setResponseList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeRegisterNotificationRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeRegisterNotificationRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
+    proto.qdb.DatabaseNotificationConfig.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest}
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebRuntimeRegisterNotificationRequest;
+  return proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebRuntimeRegisterNotificationRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest}
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.qdb.DatabaseNotificationConfig;
+      reader.readMessage(value,proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader);
+      msg.addRequests(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebRuntimeRegisterNotificationRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebRuntimeRegisterNotificationRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.qdb.DatabaseNotificationConfig.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DatabaseNotificationConfig requests = 1;
+ * @return {!Array<!proto.qdb.DatabaseNotificationConfig>}
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.getRequestsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseNotificationConfig>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseNotificationConfig, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseNotificationConfig>} value
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest} returns this
+*/
+proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.setRequestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseNotificationConfig=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseNotificationConfig}
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.addRequests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseNotificationConfig, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest} returns this
+ */
+proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.clearRequestsList = function() {
+  return this.setRequestsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeRegisterNotificationResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeRegisterNotificationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+tokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse}
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebRuntimeRegisterNotificationResponse;
+  return proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebRuntimeRegisterNotificationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse}
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addTokens(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebRuntimeRegisterNotificationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebRuntimeRegisterNotificationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTokensList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string tokens = 1;
+ * @return {!Array<string>}
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.getTokensList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse} returns this
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.setTokensList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse} returns this
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.addTokens = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse} returns this
+ */
+proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.clearTokensList = function() {
+  return this.setTokensList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeGetNotificationsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetNotificationsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeGetNotificationsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeGetNotificationsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebRuntimeGetNotificationsRequest}
+ */
+proto.qdb.WebRuntimeGetNotificationsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebRuntimeGetNotificationsRequest;
+  return proto.qdb.WebRuntimeGetNotificationsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebRuntimeGetNotificationsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebRuntimeGetNotificationsRequest}
+ */
+proto.qdb.WebRuntimeGetNotificationsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebRuntimeGetNotificationsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebRuntimeGetNotificationsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebRuntimeGetNotificationsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeGetNotificationsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetNotificationsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeGetNotificationsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+notificationsList: jspb.Message.toObjectList(msg.getNotificationsList(),
+    proto.qdb.DatabaseNotification.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebRuntimeGetNotificationsResponse}
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebRuntimeGetNotificationsResponse;
+  return proto.qdb.WebRuntimeGetNotificationsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebRuntimeGetNotificationsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebRuntimeGetNotificationsResponse}
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.qdb.DatabaseNotification;
+      reader.readMessage(value,proto.qdb.DatabaseNotification.deserializeBinaryFromReader);
+      msg.addNotifications(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebRuntimeGetNotificationsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebRuntimeGetNotificationsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNotificationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.qdb.DatabaseNotification.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DatabaseNotification notifications = 1;
+ * @return {!Array<!proto.qdb.DatabaseNotification>}
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.prototype.getNotificationsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseNotification>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseNotification, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseNotification>} value
+ * @return {!proto.qdb.WebRuntimeGetNotificationsResponse} returns this
+*/
+proto.qdb.WebRuntimeGetNotificationsResponse.prototype.setNotificationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseNotification=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseNotification}
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.prototype.addNotifications = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseNotification, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeGetNotificationsResponse} returns this
+ */
+proto.qdb.WebRuntimeGetNotificationsResponse.prototype.clearNotificationsList = function() {
+  return this.setNotificationsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeUnregisterNotificationRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeUnregisterNotificationRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeUnregisterNotificationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebHeader.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeUnregisterNotificationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-authenticationstatus: jspb.Message.getFieldWithDefault(msg, 3, 0)
+tokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2004,23 +9546,23 @@ authenticationstatus: jspb.Message.getFieldWithDefault(msg, 3, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebHeader}
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest}
  */
-proto.qdb.WebHeader.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebHeader;
-  return proto.qdb.WebHeader.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeUnregisterNotificationRequest;
+  return proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebHeader} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeUnregisterNotificationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebHeader}
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest}
  */
-proto.qdb.WebHeader.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2029,16 +9571,7 @@ proto.qdb.WebHeader.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setTimestamp(value);
-      break;
-    case 3:
-      var value = /** @type {!proto.qdb.WebHeader.AuthenticationStatusEnum} */ (reader.readEnum());
-      msg.setAuthenticationstatus(value);
+      msg.addTokens(value);
       break;
     default:
       reader.skipField();
@@ -2053,9 +9586,9 @@ proto.qdb.WebHeader.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebHeader.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebHeader.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeUnregisterNotificationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2063,116 +9596,195 @@ proto.qdb.WebHeader.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebHeader} message
+ * @param {!proto.qdb.WebRuntimeUnregisterNotificationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebHeader.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeUnregisterNotificationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getTokensList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getTimestamp();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getAuthenticationstatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * @enum {number}
+ * repeated string tokens = 1;
+ * @return {!Array<string>}
  */
-proto.qdb.WebHeader.AuthenticationStatusEnum = {
-  UNSPECIFIED: 0,
-  AUTHENTICATED: 1,
-  UNAUTHENTICATED: 2
+proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.getTokensList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
+
 /**
- * optional string id = 1;
- * @return {string}
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest} returns this
  */
-proto.qdb.WebHeader.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.setTokensList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.WebHeader} returns this
+ * @param {number=} opt_index
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest} returns this
  */
-proto.qdb.WebHeader.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.addTokens = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * optional google.protobuf.Timestamp timestamp = 2;
- * @return {?proto.google.protobuf.Timestamp}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest} returns this
  */
-proto.qdb.WebHeader.prototype.getTimestamp = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.clearTokensList = function() {
+  return this.setTokensList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeUnregisterNotificationResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.qdb.WebHeader} returns this
-*/
-proto.qdb.WebHeader.prototype.setTimestamp = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeUnregisterNotificationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebHeader} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse}
  */
-proto.qdb.WebHeader.prototype.clearTimestamp = function() {
-  return this.setTimestamp(undefined);
+proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.WebRuntimeUnregisterNotificationResponse;
+  return proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse}
  */
-proto.qdb.WebHeader.prototype.hasTimestamp = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional AuthenticationStatusEnum authenticationStatus = 3;
- * @return {!proto.qdb.WebHeader.AuthenticationStatusEnum}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.qdb.WebHeader.prototype.getAuthenticationstatus = function() {
-  return /** @type {!proto.qdb.WebHeader.AuthenticationStatusEnum} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.WebRuntimeUnregisterNotificationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.qdb.WebHeader.AuthenticationStatusEnum} value
- * @return {!proto.qdb.WebHeader} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.WebRuntimeUnregisterNotificationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum}
+ */
+proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum} value
+ * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse} returns this
  */
-proto.qdb.WebHeader.prototype.setAuthenticationstatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
@@ -2192,8 +9804,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebMessage.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebMessage.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2202,14 +9814,13 @@ proto.qdb.WebMessage.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebMessage} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebMessage.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-header: (f = msg.getHeader()) && proto.qdb.WebHeader.toObject(includeInstance, f),
-payload: (f = msg.getPayload()) && google_protobuf_any_pb.Any.toObject(includeInstance, f)
+
   };
 
   if (includeInstance) {
@@ -2223,39 +9834,29 @@ payload: (f = msg.getPayload()) && google_protobuf_any_pb.Any.toObject(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebMessage}
+ * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest}
  */
-proto.qdb.WebMessage.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebMessage;
-  return proto.qdb.WebMessage.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest;
+  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebMessage} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebMessage}
+ * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest}
  */
-proto.qdb.WebMessage.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new proto.qdb.WebHeader;
-      reader.readMessage(value,proto.qdb.WebHeader.deserializeBinaryFromReader);
-      msg.setHeader(value);
-      break;
-    case 2:
-      var value = new google_protobuf_any_pb.Any;
-      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
-      msg.setPayload(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -2269,9 +9870,9 @@ proto.qdb.WebMessage.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebMessage.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebMessage.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2279,102 +9880,12 @@ proto.qdb.WebMessage.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebMessage} message
+ * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebMessage.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHeader();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.qdb.WebHeader.serializeBinaryToWriter
-    );
-  }
-  f = message.getPayload();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_any_pb.Any.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional WebHeader header = 1;
- * @return {?proto.qdb.WebHeader}
- */
-proto.qdb.WebMessage.prototype.getHeader = function() {
-  return /** @type{?proto.qdb.WebHeader} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.WebHeader, 1));
-};
-
-
-/**
- * @param {?proto.qdb.WebHeader|undefined} value
- * @return {!proto.qdb.WebMessage} returns this
-*/
-proto.qdb.WebMessage.prototype.setHeader = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebMessage} returns this
- */
-proto.qdb.WebMessage.prototype.clearHeader = function() {
-  return this.setHeader(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.qdb.WebMessage.prototype.hasHeader = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * optional google.protobuf.Any payload = 2;
- * @return {?proto.google.protobuf.Any}
- */
-proto.qdb.WebMessage.prototype.getPayload = function() {
-  return /** @type{?proto.google.protobuf.Any} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Any|undefined} value
- * @return {!proto.qdb.WebMessage} returns this
-*/
-proto.qdb.WebMessage.prototype.setPayload = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebMessage} returns this
- */
-proto.qdb.WebMessage.prototype.clearPayload = function() {
-  return this.setPayload(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.qdb.WebMessage.prototype.hasPayload = function() {
-  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -2394,8 +9905,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigCreateEntityRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigCreateEntityRequest.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2404,15 +9915,13 @@ proto.qdb.WebConfigCreateEntityRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigCreateEntityRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigCreateEntityRequest.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-type: jspb.Message.getFieldWithDefault(msg, 1, ""),
-name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-parentid: jspb.Message.getFieldWithDefault(msg, 3, "")
+status: (f = msg.getStatus()) && proto.qdb.ConnectionState.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2426,23 +9935,23 @@ parentid: jspb.Message.getFieldWithDefault(msg, 3, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigCreateEntityRequest}
+ * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse}
  */
-proto.qdb.WebConfigCreateEntityRequest.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigCreateEntityRequest;
-  return proto.qdb.WebConfigCreateEntityRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse;
+  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigCreateEntityRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigCreateEntityRequest}
+ * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse}
  */
-proto.qdb.WebConfigCreateEntityRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2450,16 +9959,9 @@ proto.qdb.WebConfigCreateEntityRequest.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setParentid(value);
+      var value = new proto.qdb.ConnectionState;
+      reader.readMessage(value,proto.qdb.ConnectionState.deserializeBinaryFromReader);
+      msg.setStatus(value);
       break;
     default:
       reader.skipField();
@@ -2474,9 +9976,9 @@ proto.qdb.WebConfigCreateEntityRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigCreateEntityRequest.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigCreateEntityRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2484,87 +9986,57 @@ proto.qdb.WebConfigCreateEntityRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigCreateEntityRequest} message
+ * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigCreateEntityRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getParentid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
+      f,
+      proto.qdb.ConnectionState.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string type = 1;
- * @return {string}
- */
-proto.qdb.WebConfigCreateEntityRequest.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.WebConfigCreateEntityRequest} returns this
- */
-proto.qdb.WebConfigCreateEntityRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string name = 2;
- * @return {string}
+ * optional ConnectionState status = 1;
+ * @return {?proto.qdb.ConnectionState}
  */
-proto.qdb.WebConfigCreateEntityRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.getStatus = function() {
+  return /** @type{?proto.qdb.ConnectionState} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.ConnectionState, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.WebConfigCreateEntityRequest} returns this
- */
-proto.qdb.WebConfigCreateEntityRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * @param {?proto.qdb.ConnectionState|undefined} value
+ * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} returns this
+*/
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string parentId = 3;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} returns this
  */
-proto.qdb.WebConfigCreateEntityRequest.prototype.getParentid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.clearStatus = function() {
+  return this.setStatus(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.WebConfigCreateEntityRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigCreateEntityRequest.prototype.setParentid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -2584,8 +10056,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigCreateEntityResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigCreateEntityResponse.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetEntitiesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2594,14 +10066,15 @@ proto.qdb.WebConfigCreateEntityResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigCreateEntityResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeGetEntitiesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigCreateEntityResponse.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeGetEntitiesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0),
-id: jspb.Message.getFieldWithDefault(msg, 2, "")
+entitytype: jspb.Message.getFieldWithDefault(msg, 1, ""),
+cursor: jspb.Message.getFieldWithDefault(msg, 2, ""),
+pagesize: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -2615,23 +10088,23 @@ id: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigCreateEntityResponse}
+ * @return {!proto.qdb.WebRuntimeGetEntitiesRequest}
  */
-proto.qdb.WebConfigCreateEntityResponse.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigCreateEntityResponse;
-  return proto.qdb.WebConfigCreateEntityResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeGetEntitiesRequest;
+  return proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigCreateEntityResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeGetEntitiesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigCreateEntityResponse}
+ * @return {!proto.qdb.WebRuntimeGetEntitiesRequest}
  */
-proto.qdb.WebConfigCreateEntityResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2639,12 +10112,16 @@ proto.qdb.WebConfigCreateEntityResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEntitytype(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setCursor(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPagesize(value);
       break;
     default:
       reader.skipField();
@@ -2659,9 +10136,9 @@ proto.qdb.WebConfigCreateEntityResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigCreateEntityResponse.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigCreateEntityResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeGetEntitiesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2669,74 +10146,97 @@ proto.qdb.WebConfigCreateEntityResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigCreateEntityResponse} message
+ * @param {!proto.qdb.WebRuntimeGetEntitiesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigCreateEntityResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeGetEntitiesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getEntitytype();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getId();
+  f = message.getCursor();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getPagesize();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * @enum {number}
- */
-proto.qdb.WebConfigCreateEntityResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
-};
-
-/**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum}
+ * optional string entityType = 1;
+ * @return {string}
  */
-proto.qdb.WebConfigCreateEntityResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.getEntitytype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.qdb.WebConfigCreateEntityResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigCreateEntityResponse} returns this
+ * @param {string} value
+ * @return {!proto.qdb.WebRuntimeGetEntitiesRequest} returns this
  */
-proto.qdb.WebConfigCreateEntityResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.setEntitytype = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string id = 2;
+ * optional string cursor = 2;
  * @return {string}
  */
-proto.qdb.WebConfigCreateEntityResponse.prototype.getId = function() {
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.getCursor = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.WebConfigCreateEntityResponse} returns this
+ * @return {!proto.qdb.WebRuntimeGetEntitiesRequest} returns this
  */
-proto.qdb.WebConfigCreateEntityResponse.prototype.setId = function(value) {
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.setCursor = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional int32 pageSize = 3;
+ * @return {number}
+ */
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.getPagesize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.WebRuntimeGetEntitiesRequest} returns this
+ */
+proto.qdb.WebRuntimeGetEntitiesRequest.prototype.setPagesize = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeGetEntitiesResponse.repeatedFields_ = [1];
 
 
 
@@ -2753,8 +10253,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigDeleteEntityRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigDeleteEntityRequest.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetEntitiesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2763,13 +10263,15 @@ proto.qdb.WebConfigDeleteEntityRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigDeleteEntityRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeGetEntitiesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigDeleteEntityRequest.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeGetEntitiesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, "")
+entitiesList: jspb.Message.toObjectList(msg.getEntitiesList(),
+    proto.qdb.DatabaseEntity.toObject, includeInstance),
+nextcursor: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2783,23 +10285,23 @@ id: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigDeleteEntityRequest}
+ * @return {!proto.qdb.WebRuntimeGetEntitiesResponse}
  */
-proto.qdb.WebConfigDeleteEntityRequest.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeGetEntitiesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigDeleteEntityRequest;
-  return proto.qdb.WebConfigDeleteEntityRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeGetEntitiesResponse;
+  return proto.qdb.WebRuntimeGetEntitiesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigDeleteEntityRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeGetEntitiesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigDeleteEntityRequest}
+ * @return {!proto.qdb.WebRuntimeGetEntitiesResponse}
  */
-proto.qdb.WebConfigDeleteEntityRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeGetEntitiesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2807,8 +10309,13 @@ proto.qdb.WebConfigDeleteEntityRequest.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.addEntities(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setNextcursor(value);
       break;
     default:
       reader.skipField();
@@ -2823,9 +10330,9 @@ proto.qdb.WebConfigDeleteEntityRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigDeleteEntityRequest.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigDeleteEntityRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeGetEntitiesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2833,16 +10340,24 @@ proto.qdb.WebConfigDeleteEntityRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigDeleteEntityRequest} message
+ * @param {!proto.qdb.WebRuntimeGetEntitiesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigDeleteEntityRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeGetEntitiesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getEntitiesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextcursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
       f
     );
   }
@@ -2850,20 +10365,58 @@ proto.qdb.WebConfigDeleteEntityRequest.serializeBinaryToWriter = function(messag
 
 
 /**
- * optional string id = 1;
+ * repeated DatabaseEntity entities = 1;
+ * @return {!Array<!proto.qdb.DatabaseEntity>}
+ */
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.getEntitiesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEntity, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseEntity>} value
+ * @return {!proto.qdb.WebRuntimeGetEntitiesResponse} returns this
+*/
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.setEntitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseEntity}
+ */
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.addEntities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseEntity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeGetEntitiesResponse} returns this
+ */
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.clearEntitiesList = function() {
+  return this.setEntitiesList([]);
+};
+
+
+/**
+ * optional string nextCursor = 2;
  * @return {string}
  */
-proto.qdb.WebConfigDeleteEntityRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.getNextcursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.WebConfigDeleteEntityRequest} returns this
+ * @return {!proto.qdb.WebRuntimeGetEntitiesResponse} returns this
  */
-proto.qdb.WebConfigDeleteEntityRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.WebRuntimeGetEntitiesResponse.prototype.setNextcursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -2883,8 +10436,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigDeleteEntityResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigDeleteEntityResponse.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeQueryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeQueryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2893,13 +10446,13 @@ proto.qdb.WebConfigDeleteEntityResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigDeleteEntityResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeQueryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigDeleteEntityResponse.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeQueryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+query: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2913,23 +10466,23 @@ status: jspb.Message.getFieldWithDefault(msg, 1, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigDeleteEntityResponse}
+ * @return {!proto.qdb.WebRuntimeQueryRequest}
  */
-proto.qdb.WebConfigDeleteEntityResponse.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeQueryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigDeleteEntityResponse;
-  return proto.qdb.WebConfigDeleteEntityResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeQueryRequest;
+  return proto.qdb.WebRuntimeQueryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigDeleteEntityResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeQueryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigDeleteEntityResponse}
+ * @return {!proto.qdb.WebRuntimeQueryRequest}
  */
-proto.qdb.WebConfigDeleteEntityResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2937,8 +10490,8 @@ proto.qdb.WebConfigDeleteEntityResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
       break;
     default:
       reader.skipField();
@@ -2953,9 +10506,9 @@ proto.qdb.WebConfigDeleteEntityResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigDeleteEntityResponse.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeQueryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigDeleteEntityResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeQueryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2963,15 +10516,15 @@ proto.qdb.WebConfigDeleteEntityResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigDeleteEntityResponse} message
+ * @param {!proto.qdb.WebRuntimeQueryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigDeleteEntityResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeQueryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getQuery();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
@@ -2980,33 +10533,31 @@ proto.qdb.WebConfigDeleteEntityResponse.serializeBinaryToWriter = function(messa
 
 
 /**
- * @enum {number}
- */
-proto.qdb.WebConfigDeleteEntityResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
-};
-
-/**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum}
+ * optional string query = 1;
+ * @return {string}
  */
-proto.qdb.WebConfigDeleteEntityResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.WebRuntimeQueryRequest.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.qdb.WebConfigDeleteEntityResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigDeleteEntityResponse} returns this
+ * @param {string} value
+ * @return {!proto.qdb.WebRuntimeQueryRequest} returns this
  */
-proto.qdb.WebConfigDeleteEntityResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.WebRuntimeQueryRequest.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.WebRuntimeQueryResponse.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3022,8 +10573,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetEntityTypesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetEntityTypesRequest.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeQueryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeQueryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3032,13 +10583,16 @@ proto.qdb.WebConfigGetEntityTypesRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetEntityTypesRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeQueryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityTypesRequest.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeQueryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+error: jspb.Message.getFieldWithDefault(msg, 2, ""),
+rowsList: jspb.Message.toObjectList(msg.getRowsList(),
+    proto.qdb.DatabaseQueryRow.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3052,29 +10606,42 @@ proto.qdb.WebConfigGetEntityTypesRequest.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetEntityTypesRequest}
+ * @return {!proto.qdb.WebRuntimeQueryResponse}
  */
-proto.qdb.WebConfigGetEntityTypesRequest.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeQueryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetEntityTypesRequest;
-  return proto.qdb.WebConfigGetEntityTypesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeQueryResponse;
+  return proto.qdb.WebRuntimeQueryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetEntityTypesRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeQueryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetEntityTypesRequest}
+ * @return {!proto.qdb.WebRuntimeQueryResponse}
  */
-proto.qdb.WebConfigGetEntityTypesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeQueryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.WebRuntimeQueryResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    case 3:
+      var value = new proto.qdb.DatabaseQueryRow;
+      reader.readMessage(value,proto.qdb.DatabaseQueryRow.deserializeBinaryFromReader);
+      msg.addRows(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3088,9 +10655,9 @@ proto.qdb.WebConfigGetEntityTypesRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetEntityTypesRequest.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeQueryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetEntityTypesRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeQueryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3098,22 +10665,120 @@ proto.qdb.WebConfigGetEntityTypesRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetEntityTypesRequest} message
+ * @param {!proto.qdb.WebRuntimeQueryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityTypesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeQueryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.qdb.DatabaseQueryRow.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.WebRuntimeQueryResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
 };
 
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebRuntimeQueryResponse.StatusEnum}
+ */
+proto.qdb.WebRuntimeQueryResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebRuntimeQueryResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
 
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!proto.qdb.WebRuntimeQueryResponse.StatusEnum} value
+ * @return {!proto.qdb.WebRuntimeQueryResponse} returns this
  */
-proto.qdb.WebConfigGetEntityTypesResponse.repeatedFields_ = [1];
+proto.qdb.WebRuntimeQueryResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string error = 2;
+ * @return {string}
+ */
+proto.qdb.WebRuntimeQueryResponse.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.WebRuntimeQueryResponse} returns this
+ */
+proto.qdb.WebRuntimeQueryResponse.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated DatabaseQueryRow rows = 3;
+ * @return {!Array<!proto.qdb.DatabaseQueryRow>}
+ */
+proto.qdb.WebRuntimeQueryResponse.prototype.getRowsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseQueryRow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseQueryRow, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseQueryRow>} value
+ * @return {!proto.qdb.WebRuntimeQueryResponse} returns this
+*/
+proto.qdb.WebRuntimeQueryResponse.prototype.setRowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseQueryRow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseQueryRow}
+ */
+proto.qdb.WebRuntimeQueryResponse.prototype.addRows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.qdb.DatabaseQueryRow, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeQueryResponse} returns this
+ */
+proto.qdb.WebRuntimeQueryResponse.prototype.clearRowsList = function() {
+  return this.setRowsList([]);
+};
+
+
 
 
 
@@ -3130,8 +10795,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetEntityTypesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetEntityTypesResponse.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeGetReferrersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetReferrersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3140,13 +10805,13 @@ proto.qdb.WebConfigGetEntityTypesResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetEntityTypesResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeGetReferrersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityTypesResponse.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeGetReferrersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-typesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3160,23 +10825,23 @@ typesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetEntityTypesResponse}
+ * @return {!proto.qdb.WebRuntimeGetReferrersRequest}
  */
-proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeGetReferrersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetEntityTypesResponse;
-  return proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeGetReferrersRequest;
+  return proto.qdb.WebRuntimeGetReferrersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetEntityTypesResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeGetReferrersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetEntityTypesResponse}
+ * @return {!proto.qdb.WebRuntimeGetReferrersRequest}
  */
-proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeGetReferrersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3185,7 +10850,7 @@ proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addTypes(value);
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -3200,9 +10865,9 @@ proto.qdb.WebConfigGetEntityTypesResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetEntityTypesResponse.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeGetReferrersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetEntityTypesResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeGetReferrersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3210,15 +10875,15 @@ proto.qdb.WebConfigGetEntityTypesResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetEntityTypesResponse} message
+ * @param {!proto.qdb.WebRuntimeGetReferrersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityTypesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeGetReferrersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTypesList();
+  f = message.getId();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
@@ -3227,42 +10892,30 @@ proto.qdb.WebConfigGetEntityTypesResponse.serializeBinaryToWriter = function(mes
 
 
 /**
- * repeated string types = 1;
- * @return {!Array<string>}
- */
-proto.qdb.WebConfigGetEntityTypesResponse.prototype.getTypesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.qdb.WebConfigGetEntityTypesResponse} returns this
+ * optional string id = 1;
+ * @return {string}
  */
-proto.qdb.WebConfigGetEntityTypesResponse.prototype.setTypesList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.qdb.WebRuntimeGetReferrersRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.qdb.WebConfigGetEntityTypesResponse} returns this
+ * @return {!proto.qdb.WebRuntimeGetReferrersRequest} returns this
  */
-proto.qdb.WebConfigGetEntityTypesResponse.prototype.addTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.qdb.WebRuntimeGetReferrersRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebConfigGetEntityTypesResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.qdb.WebConfigGetEntityTypesResponse.prototype.clearTypesList = function() {
-  return this.setTypesList([]);
-};
-
-
+proto.qdb.WebRuntimeGetReferrersResponse.repeatedFields_ = [2];
 
 
 
@@ -3279,8 +10932,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetEntityRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetEntityRequest.toObject(opt_includeInstance, this);
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.WebRuntimeGetReferrersResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3289,13 +10942,15 @@ proto.qdb.WebConfigGetEntityRequest.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetEntityRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.WebRuntimeGetReferrersResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityRequest.toObject = function(includeInstance, msg) {
+proto.qdb.WebRuntimeGetReferrersResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, "")
+status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+referrersList: jspb.Message.toObjectList(msg.getReferrersList(),
+    proto.qdb.DatabaseReference.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3309,23 +10964,23 @@ id: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetEntityRequest}
+ * @return {!proto.qdb.WebRuntimeGetReferrersResponse}
  */
-proto.qdb.WebConfigGetEntityRequest.deserializeBinary = function(bytes) {
+proto.qdb.WebRuntimeGetReferrersResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetEntityRequest;
-  return proto.qdb.WebConfigGetEntityRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.WebRuntimeGetReferrersResponse;
+  return proto.qdb.WebRuntimeGetReferrersResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetEntityRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.WebRuntimeGetReferrersResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetEntityRequest}
+ * @return {!proto.qdb.WebRuntimeGetReferrersResponse}
  */
-proto.qdb.WebConfigGetEntityRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.WebRuntimeGetReferrersResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3333,8 +10988,13 @@ proto.qdb.WebConfigGetEntityRequest.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {!proto.qdb.WebRuntimeGetReferrersResponse.StatusEnum} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseReference;
+      reader.readMessage(value,proto.qdb.DatabaseReference.deserializeBinaryFromReader);
+      msg.addReferrers(value);
       break;
     default:
       reader.skipField();
@@ -3349,9 +11009,9 @@ proto.qdb.WebConfigGetEntityRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetEntityRequest.prototype.serializeBinary = function() {
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetEntityRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.WebRuntimeGetReferrersResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3359,37 +11019,92 @@ proto.qdb.WebConfigGetEntityRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetEntityRequest} message
+ * @param {!proto.qdb.WebRuntimeGetReferrersResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.WebRuntimeGetReferrersResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
+  f = message.getReferrersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.qdb.DatabaseReference.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * @enum {number}
  */
-proto.qdb.WebConfigGetEntityRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.WebRuntimeGetReferrersResponse.StatusEnum = {
+  UNSPECIFIED: 0,
+  SUCCESS: 1,
+  FAILURE: 2
+};
+
+/**
+ * optional StatusEnum status = 1;
+ * @return {!proto.qdb.WebRuntimeGetReferrersResponse.StatusEnum}
+ */
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.getStatus = function() {
+  return /** @type {!proto.qdb.WebRuntimeGetReferrersResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.WebConfigGetEntityRequest} returns this
+ * @param {!proto.qdb.WebRuntimeGetReferrersResponse.StatusEnum} value
+ * @return {!proto.qdb.WebRuntimeGetReferrersResponse} returns this
  */
-proto.qdb.WebConfigGetEntityRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * repeated DatabaseReference referrers = 2;
+ * @return {!Array<!proto.qdb.DatabaseReference>}
+ */
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.getReferrersList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseReference>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseReference, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseReference>} value
+ * @return {!proto.qdb.WebRuntimeGetReferrersResponse} returns this
+*/
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.setReferrersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseReference=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseReference}
+ */
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.addReferrers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseReference, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.WebRuntimeGetReferrersResponse} returns this
+ */
+proto.qdb.WebRuntimeGetReferrersResponse.prototype.clearReferrersList = function() {
+  return this.setReferrersList([]);
 };
 
 
@@ -3409,8 +11124,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetEntityResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetEntityResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseReference.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseReference.toObject(opt_includeInstance, this);
 };
 
 
@@ -3419,14 +11134,14 @@ proto.qdb.WebConfigGetEntityResponse.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetEntityResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseReference} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseReference.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0),
-entity: (f = msg.getEntity()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f)
+entityid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+field: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3440,23 +11155,23 @@ entity: (f = msg.getEntity()) && proto.qdb.DatabaseEntity.toObject(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetEntityResponse}
+ * @return {!proto.qdb.DatabaseReference}
  */
-proto.qdb.WebConfigGetEntityResponse.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseReference.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetEntityResponse;
-  return proto.qdb.WebConfigGetEntityResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseReference;
+  return proto.qdb.DatabaseReference.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetEntityResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseReference} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetEntityResponse}
+ * @return {!proto.qdb.DatabaseReference}
  */
-proto.qdb.WebConfigGetEntityResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseReference.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3464,13 +11179,12 @@ proto.qdb.WebConfigGetEntityResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebConfigGetEntityResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEntityid(value);
       break;
     case 2:
-      var value = new proto.qdb.DatabaseEntity;
-      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
-      msg.setEntity(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
       break;
     default:
       reader.skipField();
@@ -3485,9 +11199,9 @@ proto.qdb.WebConfigGetEntityResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetEntityResponse.prototype.serializeBinary = function() {
+proto.qdb.DatabaseReference.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetEntityResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseReference.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3495,94 +11209,72 @@ proto.qdb.WebConfigGetEntityResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetEntityResponse} message
+ * @param {!proto.qdb.DatabaseReference} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntityResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseReference.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getEntityid();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getEntity();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * @enum {number}
- */
-proto.qdb.WebConfigGetEntityResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
-};
-
-/**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigGetEntityResponse.StatusEnum}
+ * optional string entityId = 1;
+ * @return {string}
  */
-proto.qdb.WebConfigGetEntityResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigGetEntityResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.DatabaseReference.prototype.getEntityid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.qdb.WebConfigGetEntityResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigGetEntityResponse} returns this
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseReference} returns this
  */
-proto.qdb.WebConfigGetEntityResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.DatabaseReference.prototype.setEntityid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional DatabaseEntity entity = 2;
- * @return {?proto.qdb.DatabaseEntity}
+ * optional string field = 2;
+ * @return {string}
  */
-proto.qdb.WebConfigGetEntityResponse.prototype.getEntity = function() {
-  return /** @type{?proto.qdb.DatabaseEntity} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 2));
-};
-
-
-/**
- * @param {?proto.qdb.DatabaseEntity|undefined} value
- * @return {!proto.qdb.WebConfigGetEntityResponse} returns this
-*/
-proto.qdb.WebConfigGetEntityResponse.prototype.setEntity = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.qdb.DatabaseReference.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebConfigGetEntityResponse} returns this
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseReference} returns this
  */
-proto.qdb.WebConfigGetEntityResponse.prototype.clearEntity = function() {
-  return this.setEntity(undefined);
+proto.qdb.DatabaseReference.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.qdb.WebConfigGetEntityResponse.prototype.hasEntity = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
+proto.qdb.DatabaseEntity.repeatedFields_ = [5];
 
 
 
@@ -3599,8 +11291,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetFieldSchemaRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseEntity.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseEntity.toObject(opt_includeInstance, this);
 };
 
 
@@ -3609,13 +11301,18 @@ proto.qdb.WebConfigGetFieldSchemaRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetFieldSchemaRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseEntity} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseEntity.toObject = function(includeInstance, msg) {
   var f, obj = {
-field: jspb.Message.getFieldWithDefault(msg, 1, "")
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+type: jspb.Message.getFieldWithDefault(msg, 2, ""),
+name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+parent: (f = msg.getParent()) && proto.qdb.EntityReference.toObject(includeInstance, f),
+childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
+    proto.qdb.EntityReference.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3629,23 +11326,23 @@ field: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetFieldSchemaRequest}
+ * @return {!proto.qdb.DatabaseEntity}
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseEntity.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetFieldSchemaRequest;
-  return proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseEntity;
+  return proto.qdb.DatabaseEntity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetFieldSchemaRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseEntity} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetFieldSchemaRequest}
+ * @return {!proto.qdb.DatabaseEntity}
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseEntity.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3654,7 +11351,25 @@ proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinaryFromReader = function(
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setField(value);
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = new proto.qdb.EntityReference;
+      reader.readMessage(value,proto.qdb.EntityReference.deserializeBinaryFromReader);
+      msg.setParent(value);
+      break;
+    case 5:
+      var value = new proto.qdb.EntityReference;
+      reader.readMessage(value,proto.qdb.EntityReference.deserializeBinaryFromReader);
+      msg.addChildren(value);
       break;
     default:
       reader.skipField();
@@ -3669,9 +11384,9 @@ proto.qdb.WebConfigGetFieldSchemaRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseEntity.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetFieldSchemaRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseEntity.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3679,41 +11394,189 @@ proto.qdb.WebConfigGetFieldSchemaRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetFieldSchemaRequest} message
+ * @param {!proto.qdb.DatabaseEntity} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseEntity.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getField();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getParent();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.qdb.EntityReference.serializeBinaryToWriter
+    );
+  }
+  f = message.getChildrenList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.qdb.EntityReference.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.qdb.DatabaseEntity.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseEntity} returns this
+ */
+proto.qdb.DatabaseEntity.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string type = 2;
+ * @return {string}
+ */
+proto.qdb.DatabaseEntity.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseEntity} returns this
+ */
+proto.qdb.DatabaseEntity.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.qdb.DatabaseEntity.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseEntity} returns this
+ */
+proto.qdb.DatabaseEntity.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional EntityReference parent = 4;
+ * @return {?proto.qdb.EntityReference}
+ */
+proto.qdb.DatabaseEntity.prototype.getParent = function() {
+  return /** @type{?proto.qdb.EntityReference} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.EntityReference, 4));
+};
+
+
+/**
+ * @param {?proto.qdb.EntityReference|undefined} value
+ * @return {!proto.qdb.DatabaseEntity} returns this
+*/
+proto.qdb.DatabaseEntity.prototype.setParent = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseEntity} returns this
+ */
+proto.qdb.DatabaseEntity.prototype.clearParent = function() {
+  return this.setParent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseEntity.prototype.hasParent = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * repeated EntityReference children = 5;
+ * @return {!Array<!proto.qdb.EntityReference>}
+ */
+proto.qdb.DatabaseEntity.prototype.getChildrenList = function() {
+  return /** @type{!Array<!proto.qdb.EntityReference>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.EntityReference, 5));
 };
 
 
 /**
- * optional string field = 1;
- * @return {string}
+ * @param {!Array<!proto.qdb.EntityReference>} value
+ * @return {!proto.qdb.DatabaseEntity} returns this
+*/
+proto.qdb.DatabaseEntity.prototype.setChildrenList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.qdb.EntityReference=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.EntityReference}
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.prototype.getField = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.DatabaseEntity.prototype.addChildren = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.qdb.EntityReference, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.WebConfigGetFieldSchemaRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseEntity} returns this
  */
-proto.qdb.WebConfigGetFieldSchemaRequest.prototype.setField = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.DatabaseEntity.prototype.clearChildrenList = function() {
+  return this.setChildrenList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.DatabaseSnapshotMergeSummary.repeatedFields_ = [1,2,3,4,5,6,7,8];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3729,8 +11592,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetFieldSchemaResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotMergeSummary.toObject(opt_includeInstance, this);
 };
 
 
@@ -3739,14 +11602,21 @@ proto.qdb.WebConfigGetFieldSchemaResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetFieldSchemaResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotMergeSummary} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotMergeSummary.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0),
-schema: (f = msg.getSchema()) && proto.qdb.DatabaseFieldSchema.toObject(includeInstance, f)
+createdentityschemasList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+updatedentityschemasList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+createdfieldschemasList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+updatedfieldschemasList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+createdentitiesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+updatedentitiesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+prunedentitiesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+writtenfieldsList: jspb.Message.toObjectList(msg.getWrittenfieldsList(),
+    proto.qdb.DatabaseField.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3760,23 +11630,23 @@ schema: (f = msg.getSchema()) && proto.qdb.DatabaseFieldSchema.toObject(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetFieldSchemaResponse}
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary}
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshotMergeSummary.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetFieldSchemaResponse;
-  return proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshotMergeSummary;
+  return proto.qdb.DatabaseSnapshotMergeSummary.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetFieldSchemaResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotMergeSummary} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetFieldSchemaResponse}
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary}
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotMergeSummary.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3784,13 +11654,37 @@ proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCreatedentityschemas(value);
       break;
     case 2:
-      var value = new proto.qdb.DatabaseFieldSchema;
-      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
-      msg.setSchema(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUpdatedentityschemas(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCreatedfieldschemas(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUpdatedfieldschemas(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCreatedentities(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUpdatedentities(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPrunedentities(value);
+      break;
+    case 8:
+      var value = new proto.qdb.DatabaseField;
+      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
+      msg.addWrittenfields(value);
       break;
     default:
       reader.skipField();
@@ -3805,9 +11699,9 @@ proto.qdb.WebConfigGetFieldSchemaResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetFieldSchemaResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotMergeSummary.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3815,544 +11709,376 @@ proto.qdb.WebConfigGetFieldSchemaResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetFieldSchemaResponse} message
+ * @param {!proto.qdb.DatabaseSnapshotMergeSummary} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotMergeSummary.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getCreatedentityschemasList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getSchema();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUpdatedentityschemasList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       2,
+      f
+    );
+  }
+  f = message.getCreatedfieldschemasList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getUpdatedfieldschemasList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getCreatedentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getUpdatedentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getPrunedentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      7,
+      f
+    );
+  }
+  f = message.getWrittenfieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
       f,
-      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
+      proto.qdb.DatabaseField.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
- */
-proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
-};
-
-/**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum}
- */
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {!proto.qdb.WebConfigGetFieldSchemaResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigGetFieldSchemaResponse} returns this
- */
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
-};
-
-
-/**
- * optional DatabaseFieldSchema schema = 2;
- * @return {?proto.qdb.DatabaseFieldSchema}
+ * repeated string createdEntitySchemas = 1;
+ * @return {!Array<string>}
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.getSchema = function() {
-  return /** @type{?proto.qdb.DatabaseFieldSchema} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseFieldSchema, 2));
-};
-
-
-/**
- * @param {?proto.qdb.DatabaseFieldSchema|undefined} value
- * @return {!proto.qdb.WebConfigGetFieldSchemaResponse} returns this
-*/
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.setSchema = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getCreatedentityschemasList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebConfigGetFieldSchemaResponse} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.clearSchema = function() {
-  return this.setSchema(undefined);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setCreatedentityschemasList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigGetFieldSchemaResponse.prototype.hasSchema = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addCreatedentityschemas = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigSetFieldSchemaRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearCreatedentityschemasList = function() {
+  return this.setCreatedentityschemasList([]);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigSetFieldSchemaRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated string updatedEntitySchemas = 2;
+ * @return {!Array<string>}
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-field: jspb.Message.getFieldWithDefault(msg, 1, ""),
-schema: (f = msg.getSchema()) && proto.qdb.DatabaseFieldSchema.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getUpdatedentityschemasList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigSetFieldSchemaRequest}
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigSetFieldSchemaRequest;
-  return proto.qdb.WebConfigSetFieldSchemaRequest.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setUpdatedentityschemasList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
-/**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebConfigSetFieldSchemaRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigSetFieldSchemaRequest}
- */
-proto.qdb.WebConfigSetFieldSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setField(value);
-      break;
-    case 2:
-      var value = new proto.qdb.DatabaseFieldSchema;
-      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
-      msg.setSchema(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
+ */
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addUpdatedentityschemas = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigSetFieldSchemaRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearUpdatedentityschemasList = function() {
+  return this.setUpdatedentityschemasList([]);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigSetFieldSchemaRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated string createdFieldSchemas = 3;
+ * @return {!Array<string>}
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getField();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSchema();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
-    );
-  }
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getCreatedfieldschemasList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * optional string field = 1;
- * @return {string}
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.getField = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setCreatedfieldschemasList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.WebConfigSetFieldSchemaRequest} returns this
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.setField = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addCreatedfieldschemas = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * optional DatabaseFieldSchema schema = 2;
- * @return {?proto.qdb.DatabaseFieldSchema}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.getSchema = function() {
-  return /** @type{?proto.qdb.DatabaseFieldSchema} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseFieldSchema, 2));
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearCreatedfieldschemasList = function() {
+  return this.setCreatedfieldschemasList([]);
 };
 
 
 /**
- * @param {?proto.qdb.DatabaseFieldSchema|undefined} value
- * @return {!proto.qdb.WebConfigSetFieldSchemaRequest} returns this
-*/
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.setSchema = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * repeated string updatedFieldSchemas = 4;
+ * @return {!Array<string>}
+ */
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getUpdatedfieldschemasList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebConfigSetFieldSchemaRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.clearSchema = function() {
-  return this.setSchema(undefined);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setUpdatedfieldschemasList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaRequest.prototype.hasSchema = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addUpdatedfieldschemas = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigSetFieldSchemaResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearUpdatedfieldschemasList = function() {
+  return this.setUpdatedfieldschemasList([]);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigSetFieldSchemaResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated string createdEntities = 5;
+ * @return {!Array<string>}
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getCreatedentitiesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigSetFieldSchemaResponse}
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigSetFieldSchemaResponse;
-  return proto.qdb.WebConfigSetFieldSchemaResponse.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setCreatedentitiesList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebConfigSetFieldSchemaResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigSetFieldSchemaResponse}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addCreatedentities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigSetFieldSchemaResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearCreatedentitiesList = function() {
+  return this.setCreatedentitiesList([]);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigSetFieldSchemaResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated string updatedEntities = 6;
+ * @return {!Array<string>}
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      1,
-      f
-    );
-  }
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getUpdatedentitiesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * @enum {number}
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setUpdatedentitiesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
+
 /**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addUpdatedentities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
- * @param {!proto.qdb.WebConfigSetFieldSchemaResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigSetFieldSchemaResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigSetFieldSchemaResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearUpdatedentitiesList = function() {
+  return this.setUpdatedentitiesList([]);
 };
 
 
+/**
+ * repeated string prunedEntities = 7;
+ * @return {!Array<string>}
+ */
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getPrunedentitiesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigGetEntitySchemaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetEntitySchemaRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setPrunedentitiesList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetEntitySchemaRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigGetEntitySchemaRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-type: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addPrunedentities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetEntitySchemaRequest}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigGetEntitySchemaRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetEntitySchemaRequest;
-  return proto.qdb.WebConfigGetEntitySchemaRequest.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearPrunedentitiesList = function() {
+  return this.setPrunedentitiesList([]);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetEntitySchemaRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetEntitySchemaRequest}
+ * repeated DatabaseField writtenFields = 8;
+ * @return {!Array<!proto.qdb.DatabaseField>}
  */
-proto.qdb.WebConfigGetEntitySchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.getWrittenfieldsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseField>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseField, 8));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.qdb.WebConfigGetEntitySchemaRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetEntitySchemaRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+ * @param {!Array<!proto.qdb.DatabaseField>} value
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
+*/
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.setWrittenfieldsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetEntitySchemaRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.qdb.DatabaseField=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseField}
  */
-proto.qdb.WebConfigGetEntitySchemaRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.addWrittenfields = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.qdb.DatabaseField, opt_index);
 };
 
 
 /**
- * optional string type = 1;
- * @return {string}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotMergeSummary} returns this
  */
-proto.qdb.WebConfigGetEntitySchemaRequest.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.DatabaseSnapshotMergeSummary.prototype.clearWrittenfieldsList = function() {
+  return this.setWrittenfieldsList([]);
 };
 
 
+
 /**
- * @param {string} value
- * @return {!proto.qdb.WebConfigGetEntitySchemaRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.qdb.WebConfigGetEntitySchemaRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
+proto.qdb.DatabaseSnapshotDiff.repeatedFields_ = [1,2,3,4,5,6,7];
 
 
 
@@ -4369,8 +12095,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetEntitySchemaResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotDiff.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotDiff.toObject(opt_includeInstance, this);
 };
 
 
@@ -4379,14 +12105,26 @@ proto.qdb.WebConfigGetEntitySchemaResponse.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetEntitySchemaResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotDiff} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntitySchemaResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotDiff.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0),
-schema: (f = msg.getSchema()) && proto.qdb.DatabaseEntitySchema.toObject(includeInstance, f)
+addedentitiesList: jspb.Message.toObjectList(msg.getAddedentitiesList(),
+    proto.qdb.DatabaseSnapshotDiff.EntityChange.toObject, includeInstance),
+removedentitiesList: jspb.Message.toObjectList(msg.getRemovedentitiesList(),
+    proto.qdb.DatabaseSnapshotDiff.EntityChange.toObject, includeInstance),
+movedentitiesList: jspb.Message.toObjectList(msg.getMovedentitiesList(),
+    proto.qdb.DatabaseSnapshotDiff.EntityChange.toObject, includeInstance),
+changedfieldsList: jspb.Message.toObjectList(msg.getChangedfieldsList(),
+    proto.qdb.DatabaseSnapshotDiff.FieldChange.toObject, includeInstance),
+entityschemachangesList: jspb.Message.toObjectList(msg.getEntityschemachangesList(),
+    proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.toObject, includeInstance),
+fieldschemachangesList: jspb.Message.toObjectList(msg.getFieldschemachangesList(),
+    proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.toObject, includeInstance),
+reorderedentitiesList: jspb.Message.toObjectList(msg.getReorderedentitiesList(),
+    proto.qdb.DatabaseSnapshotDiff.EntityChange.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4400,23 +12138,23 @@ schema: (f = msg.getSchema()) && proto.qdb.DatabaseEntitySchema.toObject(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetEntitySchemaResponse}
+ * @return {!proto.qdb.DatabaseSnapshotDiff}
  */
-proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshotDiff.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetEntitySchemaResponse;
-  return proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshotDiff;
+  return proto.qdb.DatabaseSnapshotDiff.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetEntitySchemaResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotDiff} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetEntitySchemaResponse}
+ * @return {!proto.qdb.DatabaseSnapshotDiff}
  */
-proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotDiff.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4424,13 +12162,39 @@ proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = new proto.qdb.DatabaseSnapshotDiff.EntityChange;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.EntityChange.deserializeBinaryFromReader);
+      msg.addAddedentities(value);
       break;
     case 2:
-      var value = new proto.qdb.DatabaseEntitySchema;
-      reader.readMessage(value,proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader);
-      msg.setSchema(value);
+      var value = new proto.qdb.DatabaseSnapshotDiff.EntityChange;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.EntityChange.deserializeBinaryFromReader);
+      msg.addRemovedentities(value);
+      break;
+    case 3:
+      var value = new proto.qdb.DatabaseSnapshotDiff.EntityChange;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.EntityChange.deserializeBinaryFromReader);
+      msg.addMovedentities(value);
+      break;
+    case 4:
+      var value = new proto.qdb.DatabaseSnapshotDiff.FieldChange;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.FieldChange.deserializeBinaryFromReader);
+      msg.addChangedfields(value);
+      break;
+    case 5:
+      var value = new proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.deserializeBinaryFromReader);
+      msg.addEntityschemachanges(value);
+      break;
+    case 6:
+      var value = new proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.deserializeBinaryFromReader);
+      msg.addFieldschemachanges(value);
+      break;
+    case 7:
+      var value = new proto.qdb.DatabaseSnapshotDiff.EntityChange;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotDiff.EntityChange.deserializeBinaryFromReader);
+      msg.addReorderedentities(value);
       break;
     default:
       reader.skipField();
@@ -4445,9 +12209,9 @@ proto.qdb.WebConfigGetEntitySchemaResponse.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotDiff.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetEntitySchemaResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotDiff.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4455,101 +12219,71 @@ proto.qdb.WebConfigGetEntitySchemaResponse.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetEntitySchemaResponse} message
+ * @param {!proto.qdb.DatabaseSnapshotDiff} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetEntitySchemaResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotDiff.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getAddedentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.qdb.DatabaseSnapshotDiff.EntityChange.serializeBinaryToWriter
     );
   }
-  f = message.getSchema();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getRemovedentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
       f,
-      proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter
+      proto.qdb.DatabaseSnapshotDiff.EntityChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getMovedentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.qdb.DatabaseSnapshotDiff.EntityChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getChangedfieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.qdb.DatabaseSnapshotDiff.FieldChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getEntityschemachangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getFieldschemachangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getReorderedentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.qdb.DatabaseSnapshotDiff.EntityChange.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * @enum {number}
- */
-proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
-};
-
-/**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum}
- */
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {!proto.qdb.WebConfigGetEntitySchemaResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigGetEntitySchemaResponse} returns this
- */
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
-};
-
-
-/**
- * optional DatabaseEntitySchema schema = 2;
- * @return {?proto.qdb.DatabaseEntitySchema}
- */
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.getSchema = function() {
-  return /** @type{?proto.qdb.DatabaseEntitySchema} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntitySchema, 2));
-};
-
-
-/**
- * @param {?proto.qdb.DatabaseEntitySchema|undefined} value
- * @return {!proto.qdb.WebConfigGetEntitySchemaResponse} returns this
-*/
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.setSchema = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebConfigGetEntitySchemaResponse} returns this
- */
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.clearSchema = function() {
-  return this.setSchema(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.qdb.WebConfigGetEntitySchemaResponse.prototype.hasSchema = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.qdb.WebConfigSetEntitySchemaRequest.repeatedFields_ = [2];
 
 
 
@@ -4566,8 +12300,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigSetEntitySchemaRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotDiff.EntityChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -4576,14 +12310,16 @@ proto.qdb.WebConfigSetEntitySchemaRequest.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigSetEntitySchemaRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntityChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotDiff.EntityChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-fieldsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+old: (f = msg.getOld()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f),
+pb_new: (f = msg.getNew()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f),
+oldpath: jspb.Message.getFieldWithDefault(msg, 3, ""),
+newpath: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -4597,23 +12333,23 @@ fieldsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigSetEntitySchemaRequest}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshotDiff.EntityChange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigSetEntitySchemaRequest;
-  return proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshotDiff.EntityChange;
+  return proto.qdb.DatabaseSnapshotDiff.EntityChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigSetEntitySchemaRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntityChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigSetEntitySchemaRequest}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotDiff.EntityChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4621,12 +12357,22 @@ proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.setOld(value);
       break;
     case 2:
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.setNew(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.addFields(value);
+      msg.setOldpath(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNewpath(value);
       break;
     default:
       reader.skipField();
@@ -4641,9 +12387,9 @@ proto.qdb.WebConfigSetEntitySchemaRequest.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigSetEntitySchemaRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotDiff.EntityChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4651,23 +12397,39 @@ proto.qdb.WebConfigSetEntitySchemaRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigSetEntitySchemaRequest} message
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntityChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotDiff.EntityChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getOld();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getNew();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getOldpath();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      3,
       f
     );
   }
-  f = message.getFieldsList();
+  f = message.getNewpath();
   if (f.length > 0) {
-    writer.writeRepeatedString(
-      2,
+    writer.writeString(
+      4,
       f
     );
   }
@@ -4675,57 +12437,112 @@ proto.qdb.WebConfigSetEntitySchemaRequest.serializeBinaryToWriter = function(mes
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional DatabaseEntity old = 1;
+ * @return {?proto.qdb.DatabaseEntity}
+ */
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.getOld = function() {
+  return /** @type{?proto.qdb.DatabaseEntity} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 1));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseEntity|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.setOld = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.clearOld = function() {
+  return this.setOld(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.hasOld = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional DatabaseEntity new = 2;
+ * @return {?proto.qdb.DatabaseEntity}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.getNew = function() {
+  return /** @type{?proto.qdb.DatabaseEntity} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ * @param {?proto.qdb.DatabaseEntity|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.setNew = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange} returns this
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.clearNew = function() {
+  return this.setNew(undefined);
 };
 
 
 /**
- * repeated string fields = 2;
- * @return {!Array<string>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.getFieldsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.hasNew = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ * optional string oldPath = 3;
+ * @return {string}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.setFieldsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.getOldpath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange} returns this
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.addFields = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.setOldpath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebConfigSetEntitySchemaRequest} returns this
+ * optional string newPath = 4;
+ * @return {string}
  */
-proto.qdb.WebConfigSetEntitySchemaRequest.prototype.clearFieldsList = function() {
-  return this.setFieldsList([]);
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.getNewpath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.EntityChange.prototype.setNewpath = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -4745,8 +12562,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigSetEntitySchemaResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotDiff.FieldChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -4755,13 +12572,17 @@ proto.qdb.WebConfigSetEntitySchemaResponse.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigSetEntitySchemaResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotDiff.FieldChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+entityid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+path: jspb.Message.getFieldWithDefault(msg, 2, ""),
+field: jspb.Message.getFieldWithDefault(msg, 3, ""),
+oldvalue: (f = msg.getOldvalue()) && google_protobuf_any_pb.Any.toObject(includeInstance, f),
+newvalue: (f = msg.getNewvalue()) && google_protobuf_any_pb.Any.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4775,23 +12596,23 @@ status: jspb.Message.getFieldWithDefault(msg, 1, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigSetEntitySchemaResponse}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange}
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshotDiff.FieldChange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigSetEntitySchemaResponse;
-  return proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshotDiff.FieldChange;
+  return proto.qdb.DatabaseSnapshotDiff.FieldChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigSetEntitySchemaResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigSetEntitySchemaResponse}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange}
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotDiff.FieldChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4799,8 +12620,26 @@ proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEntityid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    case 4:
+      var value = new google_protobuf_any_pb.Any;
+      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
+      msg.setOldvalue(value);
+      break;
+    case 5:
+      var value = new google_protobuf_any_pb.Any;
+      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
+      msg.setNewvalue(value);
       break;
     default:
       reader.skipField();
@@ -4815,9 +12654,9 @@ proto.qdb.WebConfigSetEntitySchemaResponse.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigSetEntitySchemaResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotDiff.FieldChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4825,147 +12664,177 @@ proto.qdb.WebConfigSetEntitySchemaResponse.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigSetEntitySchemaResponse} message
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotDiff.FieldChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getEntityid();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getOldvalue();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_any_pb.Any.serializeBinaryToWriter
+    );
+  }
+  f = message.getNewvalue();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_any_pb.Any.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @enum {number}
+ * optional string entityId = 1;
+ * @return {string}
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.getEntityid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
+
 /**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum}
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange} returns this
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.setEntityid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.qdb.WebConfigSetEntitySchemaResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigSetEntitySchemaResponse} returns this
+ * optional string path = 2;
+ * @return {string}
  */
-proto.qdb.WebConfigSetEntitySchemaResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
 
+/**
+ * optional string field = 3;
+ * @return {string}
+ */
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange} returns this
  */
-proto.qdb.WebConfigCreateSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigCreateSnapshotRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigCreateSnapshotRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional google.protobuf.Any oldValue = 4;
+ * @return {?proto.google.protobuf.Any}
  */
-proto.qdb.WebConfigCreateSnapshotRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.getOldvalue = function() {
+  return /** @type{?proto.google.protobuf.Any} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 4));
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * @param {?proto.google.protobuf.Any|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.setOldvalue = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigCreateSnapshotRequest}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange} returns this
  */
-proto.qdb.WebConfigCreateSnapshotRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigCreateSnapshotRequest;
-  return proto.qdb.WebConfigCreateSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.clearOldvalue = function() {
+  return this.setOldvalue(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebConfigCreateSnapshotRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigCreateSnapshotRequest}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigCreateSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.hasOldvalue = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional google.protobuf.Any newValue = 5;
+ * @return {?proto.google.protobuf.Any}
  */
-proto.qdb.WebConfigCreateSnapshotRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigCreateSnapshotRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.getNewvalue = function() {
+  return /** @type{?proto.google.protobuf.Any} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 5));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigCreateSnapshotRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.google.protobuf.Any|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.setNewvalue = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange} returns this
  */
-proto.qdb.WebConfigCreateSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.clearNewvalue = function() {
+  return this.setNewvalue(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseSnapshotDiff.FieldChange.prototype.hasNewvalue = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
@@ -4985,8 +12854,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigCreateSnapshotResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -4995,14 +12864,14 @@ proto.qdb.WebConfigCreateSnapshotResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigCreateSnapshotResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigCreateSnapshotResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0),
-snapshot: (f = msg.getSnapshot()) && proto.qdb.DatabaseSnapshot.toObject(includeInstance, f)
+old: (f = msg.getOld()) && proto.qdb.DatabaseEntitySchema.toObject(includeInstance, f),
+pb_new: (f = msg.getNew()) && proto.qdb.DatabaseEntitySchema.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5016,23 +12885,23 @@ snapshot: (f = msg.getSnapshot()) && proto.qdb.DatabaseSnapshot.toObject(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigCreateSnapshotResponse}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigCreateSnapshotResponse;
-  return proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange;
+  return proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigCreateSnapshotResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigCreateSnapshotResponse}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5040,13 +12909,14 @@ proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = new proto.qdb.DatabaseEntitySchema;
+      reader.readMessage(value,proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader);
+      msg.setOld(value);
       break;
     case 2:
-      var value = new proto.qdb.DatabaseSnapshot;
-      reader.readMessage(value,proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader);
-      msg.setSnapshot(value);
+      var value = new proto.qdb.DatabaseEntitySchema;
+      reader.readMessage(value,proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader);
+      msg.setNew(value);
       break;
     default:
       reader.skipField();
@@ -5061,9 +12931,9 @@ proto.qdb.WebConfigCreateSnapshotResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigCreateSnapshotResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5071,82 +12941,93 @@ proto.qdb.WebConfigCreateSnapshotResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigCreateSnapshotResponse} message
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigCreateSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getOld();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter
     );
   }
-  f = message.getSnapshot();
+  f = message.getNew();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.qdb.DatabaseSnapshot.serializeBinaryToWriter
+      proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
+ * optional DatabaseEntitySchema old = 1;
+ * @return {?proto.qdb.DatabaseEntitySchema}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.getOld = function() {
+  return /** @type{?proto.qdb.DatabaseEntitySchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntitySchema, 1));
 };
 
+
 /**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum}
+ * @param {?proto.qdb.DatabaseEntitySchema|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.setOld = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange} returns this
  */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.clearOld = function() {
+  return this.setOld(undefined);
 };
 
 
 /**
- * @param {!proto.qdb.WebConfigCreateSnapshotResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigCreateSnapshotResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.hasOld = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional DatabaseSnapshot snapshot = 2;
- * @return {?proto.qdb.DatabaseSnapshot}
+ * optional DatabaseEntitySchema new = 2;
+ * @return {?proto.qdb.DatabaseEntitySchema}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.getSnapshot = function() {
-  return /** @type{?proto.qdb.DatabaseSnapshot} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshot, 2));
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.getNew = function() {
+  return /** @type{?proto.qdb.DatabaseEntitySchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntitySchema, 2));
 };
 
 
 /**
- * @param {?proto.qdb.DatabaseSnapshot|undefined} value
- * @return {!proto.qdb.WebConfigCreateSnapshotResponse} returns this
+ * @param {?proto.qdb.DatabaseEntitySchema|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange} returns this
 */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.setSnapshot = function(value) {
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.setNew = function(value) {
   return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.qdb.WebConfigCreateSnapshotResponse} returns this
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange} returns this
  */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.clearSnapshot = function() {
-  return this.setSnapshot(undefined);
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.clearNew = function() {
+  return this.setNew(undefined);
 };
 
 
@@ -5154,7 +13035,7 @@ proto.qdb.WebConfigCreateSnapshotResponse.prototype.clearSnapshot = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.qdb.WebConfigCreateSnapshotResponse.prototype.hasSnapshot = function() {
+proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange.prototype.hasNew = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
@@ -5175,8 +13056,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigRestoreSnapshotRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -5185,13 +13066,14 @@ proto.qdb.WebConfigRestoreSnapshotRequest.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigRestoreSnapshotRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-snapshot: (f = msg.getSnapshot()) && proto.qdb.DatabaseSnapshot.toObject(includeInstance, f)
+old: (f = msg.getOld()) && proto.qdb.DatabaseFieldSchema.toObject(includeInstance, f),
+pb_new: (f = msg.getNew()) && proto.qdb.DatabaseFieldSchema.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5205,23 +13087,23 @@ snapshot: (f = msg.getSnapshot()) && proto.qdb.DatabaseSnapshot.toObject(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigRestoreSnapshotRequest}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange}
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigRestoreSnapshotRequest;
-  return proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange;
+  return proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigRestoreSnapshotRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigRestoreSnapshotRequest}
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange}
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5229,9 +13111,14 @@ proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.qdb.DatabaseSnapshot;
-      reader.readMessage(value,proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader);
-      msg.setSnapshot(value);
+      var value = new proto.qdb.DatabaseFieldSchema;
+      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
+      msg.setOld(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseFieldSchema;
+      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
+      msg.setNew(value);
       break;
     default:
       reader.skipField();
@@ -5246,9 +13133,9 @@ proto.qdb.WebConfigRestoreSnapshotRequest.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigRestoreSnapshotRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5256,48 +13143,56 @@ proto.qdb.WebConfigRestoreSnapshotRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigRestoreSnapshotRequest} message
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSnapshot();
+  f = message.getOld();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.qdb.DatabaseSnapshot.serializeBinaryToWriter
+      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
+    );
+  }
+  f = message.getNew();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional DatabaseSnapshot snapshot = 1;
- * @return {?proto.qdb.DatabaseSnapshot}
+ * optional DatabaseFieldSchema old = 1;
+ * @return {?proto.qdb.DatabaseFieldSchema}
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.prototype.getSnapshot = function() {
-  return /** @type{?proto.qdb.DatabaseSnapshot} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshot, 1));
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.getOld = function() {
+  return /** @type{?proto.qdb.DatabaseFieldSchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseFieldSchema, 1));
 };
 
 
 /**
- * @param {?proto.qdb.DatabaseSnapshot|undefined} value
- * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ * @param {?proto.qdb.DatabaseFieldSchema|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange} returns this
 */
-proto.qdb.WebConfigRestoreSnapshotRequest.prototype.setSnapshot = function(value) {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.setOld = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.qdb.WebConfigRestoreSnapshotRequest} returns this
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange} returns this
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.prototype.clearSnapshot = function() {
-  return this.setSnapshot(undefined);
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.clearOld = function() {
+  return this.setOld(undefined);
 };
 
 
@@ -5305,147 +13200,311 @@ proto.qdb.WebConfigRestoreSnapshotRequest.prototype.clearSnapshot = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.qdb.WebConfigRestoreSnapshotRequest.prototype.hasSnapshot = function() {
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.hasOld = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
+/**
+ * optional DatabaseFieldSchema new = 2;
+ * @return {?proto.qdb.DatabaseFieldSchema}
+ */
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.getNew = function() {
+  return /** @type{?proto.qdb.DatabaseFieldSchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseFieldSchema, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseFieldSchema|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.setNew = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.clearNew = function() {
+  return this.setNew(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange.prototype.hasNew = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated EntityChange addedEntities = 1;
+ * @return {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.getAddedentitiesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseSnapshotDiff.EntityChange, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.prototype.setAddedentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntityChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.addAddedentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseSnapshotDiff.EntityChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.clearAddedentitiesList = function() {
+  return this.setAddedentitiesList([]);
+};
+
+
+/**
+ * repeated EntityChange removedEntities = 2;
+ * @return {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.getRemovedentitiesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseSnapshotDiff.EntityChange, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.prototype.setRemovedentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntityChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.addRemovedentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseSnapshotDiff.EntityChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.clearRemovedentitiesList = function() {
+  return this.setRemovedentitiesList([]);
+};
+
+
+/**
+ * repeated EntityChange movedEntities = 3;
+ * @return {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.getMovedentitiesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseSnapshotDiff.EntityChange, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.prototype.setMovedentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntityChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.addMovedentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.qdb.DatabaseSnapshotDiff.EntityChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.clearMovedentitiesList = function() {
+  return this.setMovedentitiesList([]);
+};
+
+
+/**
+ * repeated FieldChange changedFields = 4;
+ * @return {!Array<!proto.qdb.DatabaseSnapshotDiff.FieldChange>}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.getChangedfieldsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseSnapshotDiff.FieldChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseSnapshotDiff.FieldChange, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseSnapshotDiff.FieldChange>} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.prototype.setChangedfieldsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldChange}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.addChangedfields = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.qdb.DatabaseSnapshotDiff.FieldChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.clearChangedfieldsList = function() {
+  return this.setChangedfieldsList([]);
+};
 
 
+/**
+ * repeated EntitySchemaChange entitySchemaChanges = 5;
+ * @return {!Array<!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange>}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.getEntityschemachangesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange>} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.prototype.setEntityschemachangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange}
+ */
+proto.qdb.DatabaseSnapshotDiff.prototype.addEntityschemachanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.qdb.DatabaseSnapshotDiff.EntitySchemaChange, opt_index);
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
  */
-proto.qdb.WebConfigRestoreSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigRestoreSnapshotResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotDiff.prototype.clearEntityschemachangesList = function() {
+  return this.setEntityschemachangesList([]);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigRestoreSnapshotResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated FieldSchemaChange fieldSchemaChanges = 6;
+ * @return {!Array<!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange>}
  */
-proto.qdb.WebConfigRestoreSnapshotResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.qdb.DatabaseSnapshotDiff.prototype.getFieldschemachangesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange, 6));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigRestoreSnapshotResponse}
- */
-proto.qdb.WebConfigRestoreSnapshotResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigRestoreSnapshotResponse;
-  return proto.qdb.WebConfigRestoreSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+ * @param {!Array<!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange>} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.prototype.setFieldschemachangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebConfigRestoreSnapshotResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigRestoreSnapshotResponse}
+ * @param {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange}
  */
-proto.qdb.WebConfigRestoreSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseSnapshotDiff.prototype.addFieldschemachanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.qdb.DatabaseSnapshotDiff.FieldSchemaChange, opt_index);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
  */
-proto.qdb.WebConfigRestoreSnapshotResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigRestoreSnapshotResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseSnapshotDiff.prototype.clearFieldschemachangesList = function() {
+  return this.setFieldschemachangesList([]);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigRestoreSnapshotResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated EntityChange reorderedEntities = 7;
+ * @return {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>}
  */
-proto.qdb.WebConfigRestoreSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      1,
-      f
-    );
-  }
+proto.qdb.DatabaseSnapshotDiff.prototype.getReorderedentitiesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseSnapshotDiff.EntityChange, 7));
 };
 
 
 /**
- * @enum {number}
- */
-proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum = {
-  UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
+ * @param {!Array<!proto.qdb.DatabaseSnapshotDiff.EntityChange>} value
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
+*/
+proto.qdb.DatabaseSnapshotDiff.prototype.setReorderedentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
 };
 
+
 /**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum}
+ * @param {!proto.qdb.DatabaseSnapshotDiff.EntityChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseSnapshotDiff.EntityChange}
  */
-proto.qdb.WebConfigRestoreSnapshotResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.DatabaseSnapshotDiff.prototype.addReorderedentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.qdb.DatabaseSnapshotDiff.EntityChange, opt_index);
 };
 
 
 /**
- * @param {!proto.qdb.WebConfigRestoreSnapshotResponse.StatusEnum} value
- * @return {!proto.qdb.WebConfigRestoreSnapshotResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshotDiff} returns this
  */
-proto.qdb.WebConfigRestoreSnapshotResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.DatabaseSnapshotDiff.prototype.clearReorderedentitiesList = function() {
+  return this.setReorderedentitiesList([]);
 };
 
 
@@ -5465,8 +13524,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetRootRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetRootRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseEntityEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseEntityEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -5475,13 +13534,15 @@ proto.qdb.WebConfigGetRootRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetRootRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseEntityEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetRootRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseEntityEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+current: (f = msg.getCurrent()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f),
+previous: (f = msg.getPrevious()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5495,29 +13556,43 @@ proto.qdb.WebConfigGetRootRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetRootRequest}
+ * @return {!proto.qdb.DatabaseEntityEvent}
  */
-proto.qdb.WebConfigGetRootRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseEntityEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetRootRequest;
-  return proto.qdb.WebConfigGetRootRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseEntityEvent;
+  return proto.qdb.DatabaseEntityEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetRootRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseEntityEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetRootRequest}
+ * @return {!proto.qdb.DatabaseEntityEvent}
  */
-proto.qdb.WebConfigGetRootRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseEntityEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {!proto.qdb.DatabaseEntityEvent.TypeEnum} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.setCurrent(value);
+      break;
+    case 3:
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.setPrevious(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5531,9 +13606,9 @@ proto.qdb.WebConfigGetRootRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetRootRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseEntityEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetRootRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseEntityEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5541,142 +13616,138 @@ proto.qdb.WebConfigGetRootRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetRootRequest} message
+ * @param {!proto.qdb.DatabaseEntityEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetRootRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseEntityEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getCurrent();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
+  f = message.getPrevious();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
+    );
+  }
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.qdb.DatabaseEntityEvent.TypeEnum = {
+  UNSPECIFIED: 0,
+  CREATED: 1,
+  DELETED: 2,
+  MOVED: 3,
+  RENAMED: 4
+};
 
+/**
+ * optional TypeEnum type = 1;
+ * @return {!proto.qdb.DatabaseEntityEvent.TypeEnum}
+ */
+proto.qdb.DatabaseEntityEvent.prototype.getType = function() {
+  return /** @type {!proto.qdb.DatabaseEntityEvent.TypeEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!proto.qdb.DatabaseEntityEvent.TypeEnum} value
+ * @return {!proto.qdb.DatabaseEntityEvent} returns this
  */
-proto.qdb.WebConfigGetRootResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetRootResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseEntityEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetRootResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional DatabaseEntity current = 2;
+ * @return {?proto.qdb.DatabaseEntity}
  */
-proto.qdb.WebConfigGetRootResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-rootid: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.qdb.DatabaseEntityEvent.prototype.getCurrent = function() {
+  return /** @type{?proto.qdb.DatabaseEntity} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 2));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetRootResponse}
- */
-proto.qdb.WebConfigGetRootResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetRootResponse;
-  return proto.qdb.WebConfigGetRootResponse.deserializeBinaryFromReader(msg, reader);
+ * @param {?proto.qdb.DatabaseEntity|undefined} value
+ * @return {!proto.qdb.DatabaseEntityEvent} returns this
+*/
+proto.qdb.DatabaseEntityEvent.prototype.setCurrent = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetRootResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetRootResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseEntityEvent} returns this
  */
-proto.qdb.WebConfigGetRootResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRootid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseEntityEvent.prototype.clearCurrent = function() {
+  return this.setCurrent(undefined);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigGetRootResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetRootResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseEntityEvent.prototype.hasCurrent = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetRootResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.qdb.WebConfigGetRootResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getRootid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+ * optional DatabaseEntity previous = 3;
+ * @return {?proto.qdb.DatabaseEntity}
+ */
+proto.qdb.DatabaseEntityEvent.prototype.getPrevious = function() {
+  return /** @type{?proto.qdb.DatabaseEntity} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 3));
 };
 
 
 /**
- * optional string rootId = 1;
- * @return {string}
+ * @param {?proto.qdb.DatabaseEntity|undefined} value
+ * @return {!proto.qdb.DatabaseEntityEvent} returns this
+*/
+proto.qdb.DatabaseEntityEvent.prototype.setPrevious = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseEntityEvent} returns this
  */
-proto.qdb.WebConfigGetRootResponse.prototype.getRootid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.DatabaseEntityEvent.prototype.clearPrevious = function() {
+  return this.setPrevious(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.WebConfigGetRootResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigGetRootResponse.prototype.setRootid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.DatabaseEntityEvent.prototype.hasPrevious = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -5696,8 +13767,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebConfigGetAllFieldsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetAllFieldsRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseField.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseField.toObject(opt_includeInstance, this);
 };
 
 
@@ -5706,13 +13777,18 @@ proto.qdb.WebConfigGetAllFieldsRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetAllFieldsRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseField} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetAllFieldsRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseField.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+value: (f = msg.getValue()) && google_protobuf_any_pb.Any.toObject(includeInstance, f),
+writetime: (f = msg.getWritetime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+writerid: jspb.Message.getFieldWithDefault(msg, 5, ""),
+computed: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -5726,29 +13802,55 @@ proto.qdb.WebConfigGetAllFieldsRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetAllFieldsRequest}
+ * @return {!proto.qdb.DatabaseField}
  */
-proto.qdb.WebConfigGetAllFieldsRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseField.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetAllFieldsRequest;
-  return proto.qdb.WebConfigGetAllFieldsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseField;
+  return proto.qdb.DatabaseField.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetAllFieldsRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseField} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetAllFieldsRequest}
+ * @return {!proto.qdb.DatabaseField}
  */
-proto.qdb.WebConfigGetAllFieldsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseField.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = new google_protobuf_any_pb.Any;
+      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
+      msg.setValue(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setWritetime(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWriterid(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setComputed(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5762,9 +13864,9 @@ proto.qdb.WebConfigGetAllFieldsRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebConfigGetAllFieldsRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseField.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetAllFieldsRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseField.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5772,168 +13874,202 @@ proto.qdb.WebConfigGetAllFieldsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetAllFieldsRequest} message
+ * @param {!proto.qdb.DatabaseField} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebConfigGetAllFieldsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseField.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_any_pb.Any.serializeBinaryToWriter
+    );
+  }
+  f = message.getWritetime();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getWriterid();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getComputed();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
 };
 
 
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.qdb.DatabaseField.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseField} returns this
  */
-proto.qdb.WebConfigGetAllFieldsResponse.repeatedFields_ = [1];
+proto.qdb.DatabaseField.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.qdb.DatabaseField.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseField} returns this
  */
-proto.qdb.WebConfigGetAllFieldsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebConfigGetAllFieldsResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseField.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebConfigGetAllFieldsResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional google.protobuf.Any value = 3;
+ * @return {?proto.google.protobuf.Any}
  */
-proto.qdb.WebConfigGetAllFieldsResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-fieldsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
-  };
+proto.qdb.DatabaseField.prototype.getValue = function() {
+  return /** @type{?proto.google.protobuf.Any} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 3));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {?proto.google.protobuf.Any|undefined} value
+ * @return {!proto.qdb.DatabaseField} returns this
+*/
+proto.qdb.DatabaseField.prototype.setValue = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebConfigGetAllFieldsResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseField} returns this
  */
-proto.qdb.WebConfigGetAllFieldsResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebConfigGetAllFieldsResponse;
-  return proto.qdb.WebConfigGetAllFieldsResponse.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseField.prototype.clearValue = function() {
+  return this.setValue(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebConfigGetAllFieldsResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebConfigGetAllFieldsResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigGetAllFieldsResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addFields(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseField.prototype.hasValue = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional google.protobuf.Timestamp writeTime = 4;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.qdb.WebConfigGetAllFieldsResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebConfigGetAllFieldsResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseField.prototype.getWritetime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.qdb.DatabaseField} returns this
+*/
+proto.qdb.DatabaseField.prototype.setWritetime = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseField} returns this
+ */
+proto.qdb.DatabaseField.prototype.clearWritetime = function() {
+  return this.setWritetime(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebConfigGetAllFieldsResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebConfigGetAllFieldsResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getFieldsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
+proto.qdb.DatabaseField.prototype.hasWritetime = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * repeated string fields = 1;
- * @return {!Array<string>}
+ * optional string writerId = 5;
+ * @return {string}
  */
-proto.qdb.WebConfigGetAllFieldsResponse.prototype.getFieldsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.qdb.DatabaseField.prototype.getWriterid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.qdb.WebConfigGetAllFieldsResponse} returns this
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseField} returns this
  */
-proto.qdb.WebConfigGetAllFieldsResponse.prototype.setFieldsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.qdb.DatabaseField.prototype.setWriterid = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.qdb.WebConfigGetAllFieldsResponse} returns this
+ * optional bool computed = 6;
+ * @return {boolean}
  */
-proto.qdb.WebConfigGetAllFieldsResponse.prototype.addFields = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.qdb.DatabaseField.prototype.getComputed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebConfigGetAllFieldsResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.qdb.DatabaseField} returns this
  */
-proto.qdb.WebConfigGetAllFieldsResponse.prototype.clearFieldsList = function() {
-  return this.setFieldsList([]);
+proto.qdb.DatabaseField.prototype.setComputed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
@@ -5943,7 +14079,7 @@ proto.qdb.WebConfigGetAllFieldsResponse.prototype.clearFieldsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.qdb.WebRuntimeDatabaseRequest.repeatedFields_ = [2];
+proto.qdb.DatabaseNotificationConfig.repeatedFields_ = [4];
 
 
 
@@ -5960,8 +14096,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeDatabaseRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseNotificationConfig.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseNotificationConfig.toObject(opt_includeInstance, this);
 };
 
 
@@ -5970,15 +14106,18 @@ proto.qdb.WebRuntimeDatabaseRequest.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeDatabaseRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseNotificationConfig} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeDatabaseRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseNotificationConfig.toObject = function(includeInstance, msg) {
   var f, obj = {
-requesttype: jspb.Message.getFieldWithDefault(msg, 1, 0),
-requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
-    proto.qdb.DatabaseRequest.toObject, includeInstance)
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+type: jspb.Message.getFieldWithDefault(msg, 2, ""),
+field: jspb.Message.getFieldWithDefault(msg, 3, ""),
+contextfieldsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+notifyonchange: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+serviceid: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -5992,23 +14131,23 @@ requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeDatabaseRequest}
+ * @return {!proto.qdb.DatabaseNotificationConfig}
  */
-proto.qdb.WebRuntimeDatabaseRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseNotificationConfig.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeDatabaseRequest;
-  return proto.qdb.WebRuntimeDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseNotificationConfig;
+  return proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeDatabaseRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseNotificationConfig} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeDatabaseRequest}
+ * @return {!proto.qdb.DatabaseNotificationConfig}
  */
-proto.qdb.WebRuntimeDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6016,13 +14155,28 @@ proto.qdb.WebRuntimeDatabaseRequest.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum} */ (reader.readEnum());
-      msg.setRequesttype(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var value = new proto.qdb.DatabaseRequest;
-      reader.readMessage(value,proto.qdb.DatabaseRequest.deserializeBinaryFromReader);
-      msg.addRequests(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addContextfields(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNotifyonchange(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceid(value);
       break;
     default:
       reader.skipField();
@@ -6037,9 +14191,9 @@ proto.qdb.WebRuntimeDatabaseRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebRuntimeDatabaseRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseNotificationConfig.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeDatabaseRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseNotificationConfig.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6047,92 +14201,181 @@ proto.qdb.WebRuntimeDatabaseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeDatabaseRequest} message
+ * @param {!proto.qdb.DatabaseNotificationConfig} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseNotificationConfig.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRequesttype();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getRequestsList();
+  f = message.getType();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       2,
-      f,
-      proto.qdb.DatabaseRequest.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getContextfieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getNotifyonchange();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getServiceid();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
     );
   }
 };
 
 
 /**
- * @enum {number}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum = {
-  UNSPECIFIED: 0,
-  READ: 1,
-  WRITE: 2
+proto.qdb.DatabaseNotificationConfig.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
+
 /**
- * optional RequestTypeEnum requestType = 1;
- * @return {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum}
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
  */
-proto.qdb.WebRuntimeDatabaseRequest.prototype.getRequesttype = function() {
-  return /** @type {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.DatabaseNotificationConfig.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.qdb.WebRuntimeDatabaseRequest.RequestTypeEnum} value
- * @return {!proto.qdb.WebRuntimeDatabaseRequest} returns this
+ * optional string type = 2;
+ * @return {string}
  */
-proto.qdb.WebRuntimeDatabaseRequest.prototype.setRequesttype = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.qdb.DatabaseNotificationConfig.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * repeated DatabaseRequest requests = 2;
- * @return {!Array<!proto.qdb.DatabaseRequest>}
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
  */
-proto.qdb.WebRuntimeDatabaseRequest.prototype.getRequestsList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseRequest>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseRequest, 2));
+proto.qdb.DatabaseNotificationConfig.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string field = 3;
+ * @return {string}
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated string contextFields = 4;
+ * @return {!Array<string>}
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.getContextfieldsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.setContextfieldsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.addContextfields = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.clearContextfieldsList = function() {
+  return this.setContextfieldsList([]);
+};
+
+
+/**
+ * optional bool notifyOnChange = 5;
+ * @return {boolean}
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.getNotifyonchange = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {!Array<!proto.qdb.DatabaseRequest>} value
- * @return {!proto.qdb.WebRuntimeDatabaseRequest} returns this
-*/
-proto.qdb.WebRuntimeDatabaseRequest.prototype.setRequestsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+ * @param {boolean} value
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ */
+proto.qdb.DatabaseNotificationConfig.prototype.setNotifyonchange = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseRequest=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseRequest}
+ * optional string serviceId = 6;
+ * @return {string}
  */
-proto.qdb.WebRuntimeDatabaseRequest.prototype.addRequests = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseRequest, opt_index);
+proto.qdb.DatabaseNotificationConfig.prototype.getServiceid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebRuntimeDatabaseRequest} returns this
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseNotificationConfig} returns this
  */
-proto.qdb.WebRuntimeDatabaseRequest.prototype.clearRequestsList = function() {
-  return this.setRequestsList([]);
+proto.qdb.DatabaseNotificationConfig.prototype.setServiceid = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -6142,7 +14385,7 @@ proto.qdb.WebRuntimeDatabaseRequest.prototype.clearRequestsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.qdb.WebRuntimeDatabaseResponse.repeatedFields_ = [2];
+proto.qdb.DatabaseNotification.repeatedFields_ = [4];
 
 
 
@@ -6159,8 +14402,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeDatabaseResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseNotification.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseNotification.toObject(opt_includeInstance, this);
 };
 
 
@@ -6169,14 +14412,17 @@ proto.qdb.WebRuntimeDatabaseResponse.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeDatabaseResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseNotification} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeDatabaseResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseNotification.toObject = function(includeInstance, msg) {
   var f, obj = {
-responseList: jspb.Message.toObjectList(msg.getResponseList(),
-    proto.qdb.DatabaseRequest.toObject, includeInstance)
+token: jspb.Message.getFieldWithDefault(msg, 1, ""),
+current: (f = msg.getCurrent()) && proto.qdb.DatabaseField.toObject(includeInstance, f),
+previous: (f = msg.getPrevious()) && proto.qdb.DatabaseField.toObject(includeInstance, f),
+contextList: jspb.Message.toObjectList(msg.getContextList(),
+    proto.qdb.DatabaseField.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6190,33 +14436,47 @@ responseList: jspb.Message.toObjectList(msg.getResponseList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeDatabaseResponse}
+ * @return {!proto.qdb.DatabaseNotification}
  */
-proto.qdb.WebRuntimeDatabaseResponse.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseNotification.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeDatabaseResponse;
-  return proto.qdb.WebRuntimeDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseNotification;
+  return proto.qdb.DatabaseNotification.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeDatabaseResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseNotification} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeDatabaseResponse}
+ * @return {!proto.qdb.DatabaseNotification}
  */
-proto.qdb.WebRuntimeDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseNotification.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
+      break;
     case 2:
-      var value = new proto.qdb.DatabaseRequest;
-      reader.readMessage(value,proto.qdb.DatabaseRequest.deserializeBinaryFromReader);
-      msg.addResponse(value);
+      var value = new proto.qdb.DatabaseField;
+      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
+      msg.setCurrent(value);
+      break;
+    case 3:
+      var value = new proto.qdb.DatabaseField;
+      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
+      msg.setPrevious(value);
+      break;
+    case 4:
+      var value = new proto.qdb.DatabaseField;
+      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
+      msg.addContext(value);
       break;
     default:
       reader.skipField();
@@ -6231,9 +14491,9 @@ proto.qdb.WebRuntimeDatabaseResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebRuntimeDatabaseResponse.prototype.serializeBinary = function() {
+proto.qdb.DatabaseNotification.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeDatabaseResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseNotification.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6241,58 +14501,173 @@ proto.qdb.WebRuntimeDatabaseResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeDatabaseResponse} message
+ * @param {!proto.qdb.DatabaseNotification} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseNotification.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getResponseList();
+  f = message.getToken();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCurrent();
+  if (f != null) {
+    writer.writeMessage(
       2,
       f,
-      proto.qdb.DatabaseRequest.serializeBinaryToWriter
+      proto.qdb.DatabaseField.serializeBinaryToWriter
+    );
+  }
+  f = message.getPrevious();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.qdb.DatabaseField.serializeBinaryToWriter
+    );
+  }
+  f = message.getContextList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.qdb.DatabaseField.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated DatabaseRequest response = 2;
- * @return {!Array<!proto.qdb.DatabaseRequest>}
+ * optional string token = 1;
+ * @return {string}
  */
-proto.qdb.WebRuntimeDatabaseResponse.prototype.getResponseList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseRequest>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseRequest, 2));
+proto.qdb.DatabaseNotification.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.qdb.DatabaseRequest>} value
- * @return {!proto.qdb.WebRuntimeDatabaseResponse} returns this
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseNotification} returns this
+ */
+proto.qdb.DatabaseNotification.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional DatabaseField current = 2;
+ * @return {?proto.qdb.DatabaseField}
+ */
+proto.qdb.DatabaseNotification.prototype.getCurrent = function() {
+  return /** @type{?proto.qdb.DatabaseField} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseField, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseField|undefined} value
+ * @return {!proto.qdb.DatabaseNotification} returns this
 */
-proto.qdb.WebRuntimeDatabaseResponse.prototype.setResponseList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.qdb.DatabaseNotification.prototype.setCurrent = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseRequest=} opt_value
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseNotification} returns this
+ */
+proto.qdb.DatabaseNotification.prototype.clearCurrent = function() {
+  return this.setCurrent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseNotification.prototype.hasCurrent = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional DatabaseField previous = 3;
+ * @return {?proto.qdb.DatabaseField}
+ */
+proto.qdb.DatabaseNotification.prototype.getPrevious = function() {
+  return /** @type{?proto.qdb.DatabaseField} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseField, 3));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseField|undefined} value
+ * @return {!proto.qdb.DatabaseNotification} returns this
+*/
+proto.qdb.DatabaseNotification.prototype.setPrevious = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseNotification} returns this
+ */
+proto.qdb.DatabaseNotification.prototype.clearPrevious = function() {
+  return this.setPrevious(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseNotification.prototype.hasPrevious = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated DatabaseField context = 4;
+ * @return {!Array<!proto.qdb.DatabaseField>}
+ */
+proto.qdb.DatabaseNotification.prototype.getContextList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseField>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseField, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.qdb.DatabaseField>} value
+ * @return {!proto.qdb.DatabaseNotification} returns this
+*/
+proto.qdb.DatabaseNotification.prototype.setContextList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.qdb.DatabaseField=} opt_value
  * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseRequest}
+ * @return {!proto.qdb.DatabaseField}
  */
-proto.qdb.WebRuntimeDatabaseResponse.prototype.addResponse = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseRequest, opt_index);
+proto.qdb.DatabaseNotification.prototype.addContext = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.qdb.DatabaseField, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebRuntimeDatabaseResponse} returns this
+ * @return {!proto.qdb.DatabaseNotification} returns this
  */
-proto.qdb.WebRuntimeDatabaseResponse.prototype.clearResponseList = function() {
-  return this.setResponseList([]);
+proto.qdb.DatabaseNotification.prototype.clearContextList = function() {
+  return this.setContextList([]);
 };
 
 
@@ -6302,7 +14677,7 @@ proto.qdb.WebRuntimeDatabaseResponse.prototype.clearResponseList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.repeatedFields_ = [1];
+proto.qdb.DatabaseEntitySchema.repeatedFields_ = [2];
 
 
 
@@ -6319,8 +14694,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeRegisterNotificationRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseEntitySchema.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseEntitySchema.toObject(opt_includeInstance, this);
 };
 
 
@@ -6329,14 +14704,14 @@ proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeRegisterNotificationRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseEntitySchema} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseEntitySchema.toObject = function(includeInstance, msg) {
   var f, obj = {
-requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
-    proto.qdb.DatabaseNotificationConfig.toObject, includeInstance)
+name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+fieldsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6350,23 +14725,23 @@ requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest}
+ * @return {!proto.qdb.DatabaseEntitySchema}
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseEntitySchema.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeRegisterNotificationRequest;
-  return proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseEntitySchema;
+  return proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeRegisterNotificationRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseEntitySchema} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest}
+ * @return {!proto.qdb.DatabaseEntitySchema}
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6374,9 +14749,12 @@ proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.qdb.DatabaseNotificationConfig;
-      reader.readMessage(value,proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader);
-      msg.addRequests(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFields(value);
       break;
     default:
       reader.skipField();
@@ -6391,9 +14769,9 @@ proto.qdb.WebRuntimeRegisterNotificationRequest.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseEntitySchema.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeRegisterNotificationRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6401,58 +14779,81 @@ proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeRegisterNotificationRequest} message
+ * @param {!proto.qdb.DatabaseEntitySchema} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRequestsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.qdb.DatabaseNotificationConfig.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getFieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated DatabaseNotificationConfig requests = 1;
- * @return {!Array<!proto.qdb.DatabaseNotificationConfig>}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.getRequestsList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseNotificationConfig>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseNotificationConfig, 1));
+proto.qdb.DatabaseEntitySchema.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.qdb.DatabaseNotificationConfig>} value
- * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest} returns this
-*/
-proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.setRequestsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseEntitySchema} returns this
+ */
+proto.qdb.DatabaseEntitySchema.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseNotificationConfig=} opt_value
+ * repeated string fields = 2;
+ * @return {!Array<string>}
+ */
+proto.qdb.DatabaseEntitySchema.prototype.getFieldsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseEntitySchema} returns this
+ */
+proto.qdb.DatabaseEntitySchema.prototype.setFieldsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseNotificationConfig}
+ * @return {!proto.qdb.DatabaseEntitySchema} returns this
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.addRequests = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseNotificationConfig, opt_index);
+proto.qdb.DatabaseEntitySchema.prototype.addFields = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebRuntimeRegisterNotificationRequest} returns this
+ * @return {!proto.qdb.DatabaseEntitySchema} returns this
  */
-proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.clearRequestsList = function() {
-  return this.setRequestsList([]);
+proto.qdb.DatabaseEntitySchema.prototype.clearFieldsList = function() {
+  return this.setFieldsList([]);
 };
 
 
@@ -6462,7 +14863,7 @@ proto.qdb.WebRuntimeRegisterNotificationRequest.prototype.clearRequestsList = fu
  * @private {!Array<number>}
  * @const
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.repeatedFields_ = [1];
+proto.qdb.DatabaseFieldSchema.repeatedFields_ = [3,9];
 
 
 
@@ -6479,8 +14880,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeRegisterNotificationResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseFieldSchema.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseFieldSchema.toObject(opt_includeInstance, this);
 };
 
 
@@ -6489,13 +14890,25 @@ proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeRegisterNotificationResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseFieldSchema} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseFieldSchema.toObject = function(includeInstance, msg) {
   var f, obj = {
-tokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+type: jspb.Message.getFieldWithDefault(msg, 2, ""),
+enumvaluesList: jspb.Message.toObjectList(msg.getEnumvaluesList(),
+    proto.qdb.DatabaseEnumValue.toObject, includeInstance),
+computed: (f = msg.getComputed()) && proto.qdb.DatabaseComputedField.toObject(includeInstance, f),
+unit: jspb.Message.getFieldWithDefault(msg, 5, ""),
+displayprecision: jspb.Message.getFieldWithDefault(msg, 6, 0),
+scale: jspb.Message.getFloatingPointFieldWithDefault(msg, 7, 0.0),
+offset: jspb.Message.getFloatingPointFieldWithDefault(msg, 8, 0.0),
+alarmlimitsList: jspb.Message.toObjectList(msg.getAlarmlimitsList(),
+    proto.qdb.DatabaseAlarmLimit.toObject, includeInstance),
+index: jspb.Message.getFieldWithDefault(msg, 10, 0),
+ondelete: jspb.Message.getFieldWithDefault(msg, 11, 0)
   };
 
   if (includeInstance) {
@@ -6509,23 +14922,23 @@ tokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse}
+ * @return {!proto.qdb.DatabaseFieldSchema}
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseFieldSchema.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeRegisterNotificationResponse;
-  return proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseFieldSchema;
+  return proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeRegisterNotificationResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseFieldSchema} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse}
+ * @return {!proto.qdb.DatabaseFieldSchema}
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6534,7 +14947,50 @@ proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinaryFromReader = f
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addTokens(value);
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = new proto.qdb.DatabaseEnumValue;
+      reader.readMessage(value,proto.qdb.DatabaseEnumValue.deserializeBinaryFromReader);
+      msg.addEnumvalues(value);
+      break;
+    case 4:
+      var value = new proto.qdb.DatabaseComputedField;
+      reader.readMessage(value,proto.qdb.DatabaseComputedField.deserializeBinaryFromReader);
+      msg.setComputed(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnit(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setDisplayprecision(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScale(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setOffset(value);
+      break;
+    case 9:
+      var value = new proto.qdb.DatabaseAlarmLimit;
+      reader.readMessage(value,proto.qdb.DatabaseAlarmLimit.deserializeBinaryFromReader);
+      msg.addAlarmlimits(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.qdb.DatabaseFieldSchema.IndexEnum} */ (reader.readEnum());
+      msg.setIndex(value);
+      break;
+    case 11:
+      var value = /** @type {!proto.qdb.DatabaseFieldSchema.ReferencePolicyEnum} */ (reader.readEnum());
+      msg.setOndelete(value);
       break;
     default:
       reader.skipField();
@@ -6546,341 +15002,384 @@ proto.qdb.WebRuntimeRegisterNotificationResponse.deserializeBinaryFromReader = f
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.qdb.DatabaseFieldSchema.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.DatabaseFieldSchema} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEnumvaluesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.qdb.DatabaseEnumValue.serializeBinaryToWriter
+    );
+  }
+  f = message.getComputed();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.qdb.DatabaseComputedField.serializeBinaryToWriter
+    );
+  }
+  f = message.getUnit();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getDisplayprecision();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getScale();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      7,
+      f
+    );
+  }
+  f = message.getOffset();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      8,
+      f
+    );
+  }
+  f = message.getAlarmlimitsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.qdb.DatabaseAlarmLimit.serializeBinaryToWriter
+    );
+  }
+  f = message.getIndex();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getOndelete();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      11,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.qdb.DatabaseFieldSchema.IndexEnum = {
+  UNSPECIFIED: 0,
+  HASH: 1,
+  SORTED: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.qdb.DatabaseFieldSchema.ReferencePolicyEnum = {
+  SET_NULL: 0,
+  RESTRICT: 1,
+  CASCADE: 2
+};
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.qdb.DatabaseFieldSchema.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
+ */
+proto.qdb.DatabaseFieldSchema.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string type = 2;
+ * @return {string}
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeRegisterNotificationResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseFieldSchema.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeRegisterNotificationResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getTokensList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
+proto.qdb.DatabaseFieldSchema.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated string tokens = 1;
- * @return {!Array<string>}
+ * repeated DatabaseEnumValue enumValues = 3;
+ * @return {!Array<!proto.qdb.DatabaseEnumValue>}
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.getTokensList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.qdb.DatabaseFieldSchema.prototype.getEnumvaluesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseEnumValue>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEnumValue, 3));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse} returns this
- */
-proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.setTokensList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+ * @param {!Array<!proto.qdb.DatabaseEnumValue>} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
+*/
+proto.qdb.DatabaseFieldSchema.prototype.setEnumvaluesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
+ * @param {!proto.qdb.DatabaseEnumValue=} opt_value
  * @param {number=} opt_index
- * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse} returns this
+ * @return {!proto.qdb.DatabaseEnumValue}
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.addTokens = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.qdb.DatabaseFieldSchema.prototype.addEnumvalues = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.qdb.DatabaseEnumValue, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebRuntimeRegisterNotificationResponse} returns this
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeRegisterNotificationResponse.prototype.clearTokensList = function() {
-  return this.setTokensList([]);
+proto.qdb.DatabaseFieldSchema.prototype.clearEnumvaluesList = function() {
+  return this.setEnumvaluesList([]);
 };
 
 
+/**
+ * optional DatabaseComputedField computed = 4;
+ * @return {?proto.qdb.DatabaseComputedField}
+ */
+proto.qdb.DatabaseFieldSchema.prototype.getComputed = function() {
+  return /** @type{?proto.qdb.DatabaseComputedField} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseComputedField, 4));
+};
+
 
+/**
+ * @param {?proto.qdb.DatabaseComputedField|undefined} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
+*/
+proto.qdb.DatabaseFieldSchema.prototype.setComputed = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeGetNotificationsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeGetNotificationsRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseFieldSchema.prototype.clearComputed = function() {
+  return this.setComputed(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeGetNotificationsRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebRuntimeGetNotificationsRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.qdb.DatabaseFieldSchema.prototype.hasComputed = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * optional string unit = 5;
+ * @return {string}
+ */
+proto.qdb.DatabaseFieldSchema.prototype.getUnit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeGetNotificationsRequest}
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeGetNotificationsRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeGetNotificationsRequest;
-  return proto.qdb.WebRuntimeGetNotificationsRequest.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseFieldSchema.prototype.setUnit = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeGetNotificationsRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeGetNotificationsRequest}
+ * optional int32 displayPrecision = 6;
+ * @return {number}
  */
-proto.qdb.WebRuntimeGetNotificationsRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseFieldSchema.prototype.getDisplayprecision = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeGetNotificationsRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeGetNotificationsRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseFieldSchema.prototype.setDisplayprecision = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeGetNotificationsRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional double scale = 7;
+ * @return {number}
  */
-proto.qdb.WebRuntimeGetNotificationsRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.qdb.DatabaseFieldSchema.prototype.getScale = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 7, 0.0));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.repeatedFields_ = [1];
-
+proto.qdb.DatabaseFieldSchema.prototype.setScale = function(value) {
+  return jspb.Message.setProto3FloatField(this, 7, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional double offset = 8;
+ * @return {number}
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeGetNotificationsResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseFieldSchema.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 8, 0.0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeGetNotificationsResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-notificationsList: jspb.Message.toObjectList(msg.getNotificationsList(),
-    proto.qdb.DatabaseNotification.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.qdb.DatabaseFieldSchema.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3FloatField(this, 8, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeGetNotificationsResponse}
+ * repeated DatabaseAlarmLimit alarmLimits = 9;
+ * @return {!Array<!proto.qdb.DatabaseAlarmLimit>}
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeGetNotificationsResponse;
-  return proto.qdb.WebRuntimeGetNotificationsResponse.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseFieldSchema.prototype.getAlarmlimitsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseAlarmLimit>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseAlarmLimit, 9));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeGetNotificationsResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeGetNotificationsResponse}
- */
-proto.qdb.WebRuntimeGetNotificationsResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.qdb.DatabaseNotification;
-      reader.readMessage(value,proto.qdb.DatabaseNotification.deserializeBinaryFromReader);
-      msg.addNotifications(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {!Array<!proto.qdb.DatabaseAlarmLimit>} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
+*/
+proto.qdb.DatabaseFieldSchema.prototype.setAlarmlimitsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.qdb.DatabaseAlarmLimit=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseAlarmLimit}
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeGetNotificationsResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseFieldSchema.prototype.addAlarmlimits = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.qdb.DatabaseAlarmLimit, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeGetNotificationsResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getNotificationsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.qdb.DatabaseNotification.serializeBinaryToWriter
-    );
-  }
+proto.qdb.DatabaseFieldSchema.prototype.clearAlarmlimitsList = function() {
+  return this.setAlarmlimitsList([]);
 };
 
 
 /**
- * repeated DatabaseNotification notifications = 1;
- * @return {!Array<!proto.qdb.DatabaseNotification>}
+ * optional IndexEnum index = 10;
+ * @return {!proto.qdb.DatabaseFieldSchema.IndexEnum}
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.prototype.getNotificationsList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseNotification>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseNotification, 1));
+proto.qdb.DatabaseFieldSchema.prototype.getIndex = function() {
+  return /** @type {!proto.qdb.DatabaseFieldSchema.IndexEnum} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
 };
 
 
 /**
- * @param {!Array<!proto.qdb.DatabaseNotification>} value
- * @return {!proto.qdb.WebRuntimeGetNotificationsResponse} returns this
-*/
-proto.qdb.WebRuntimeGetNotificationsResponse.prototype.setNotificationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!proto.qdb.DatabaseFieldSchema.IndexEnum} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
+ */
+proto.qdb.DatabaseFieldSchema.prototype.setIndex = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseNotification=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseNotification}
+ * optional ReferencePolicyEnum onDelete = 11;
+ * @return {!proto.qdb.DatabaseFieldSchema.ReferencePolicyEnum}
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.prototype.addNotifications = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseNotification, opt_index);
+proto.qdb.DatabaseFieldSchema.prototype.getOndelete = function() {
+  return /** @type {!proto.qdb.DatabaseFieldSchema.ReferencePolicyEnum} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebRuntimeGetNotificationsResponse} returns this
+ * @param {!proto.qdb.DatabaseFieldSchema.ReferencePolicyEnum} value
+ * @return {!proto.qdb.DatabaseFieldSchema} returns this
  */
-proto.qdb.WebRuntimeGetNotificationsResponse.prototype.clearNotificationsList = function() {
-  return this.setNotificationsList([]);
+proto.qdb.DatabaseFieldSchema.prototype.setOndelete = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6896,8 +15395,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeUnregisterNotificationRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseEnumValue.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseEnumValue.toObject(opt_includeInstance, this);
 };
 
 
@@ -6906,13 +15405,14 @@ proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeUnregisterNotificationRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseEnumValue} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseEnumValue.toObject = function(includeInstance, msg) {
   var f, obj = {
-tokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+value: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -6926,23 +15426,23 @@ tokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest}
+ * @return {!proto.qdb.DatabaseEnumValue}
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseEnumValue.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeUnregisterNotificationRequest;
-  return proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseEnumValue;
+  return proto.qdb.DatabaseEnumValue.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeUnregisterNotificationRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseEnumValue} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest}
+ * @return {!proto.qdb.DatabaseEnumValue}
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseEnumValue.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6951,7 +15451,11 @@ proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addTokens(value);
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -6966,9 +15470,9 @@ proto.qdb.WebRuntimeUnregisterNotificationRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseEnumValue.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeUnregisterNotificationRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseEnumValue.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6976,56 +15480,62 @@ proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeUnregisterNotificationRequest} message
+ * @param {!proto.qdb.DatabaseEnumValue} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseEnumValue.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTokensList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getValue();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated string tokens = 1;
- * @return {!Array<string>}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.getTokensList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.qdb.DatabaseEnumValue.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest} returns this
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseEnumValue} returns this
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.setTokensList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.qdb.DatabaseEnumValue.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest} returns this
+ * optional int64 value = 2;
+ * @return {number}
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.addTokens = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.qdb.DatabaseEnumValue.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationRequest} returns this
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseEnumValue} returns this
  */
-proto.qdb.WebRuntimeUnregisterNotificationRequest.prototype.clearTokensList = function() {
-  return this.setTokensList([]);
+proto.qdb.DatabaseEnumValue.prototype.setValue = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -7045,8 +15555,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeUnregisterNotificationResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseAlarmLimit.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseAlarmLimit.toObject(opt_includeInstance, this);
 };
 
 
@@ -7055,13 +15565,14 @@ proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseAlarmLimit} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseAlarmLimit.toObject = function(includeInstance, msg) {
   var f, obj = {
-status: jspb.Message.getFieldWithDefault(msg, 1, 0)
+level: jspb.Message.getFieldWithDefault(msg, 1, 0),
+value: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
   };
 
   if (includeInstance) {
@@ -7075,23 +15586,23 @@ status: jspb.Message.getFieldWithDefault(msg, 1, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse}
+ * @return {!proto.qdb.DatabaseAlarmLimit}
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseAlarmLimit.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeUnregisterNotificationResponse;
-  return proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseAlarmLimit;
+  return proto.qdb.DatabaseAlarmLimit.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseAlarmLimit} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse}
+ * @return {!proto.qdb.DatabaseAlarmLimit}
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseAlarmLimit.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7099,8 +15610,12 @@ proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {!proto.qdb.DatabaseAlarmLimit.LevelEnum} */ (reader.readEnum());
+      msg.setLevel(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -7115,9 +15630,9 @@ proto.qdb.WebRuntimeUnregisterNotificationResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.serializeBinary = function() {
+proto.qdb.DatabaseAlarmLimit.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeUnregisterNotificationResponse.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseAlarmLimit.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7125,49 +15640,83 @@ proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse} message
+ * @param {!proto.qdb.DatabaseAlarmLimit} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseAlarmLimit.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
+  f = message.getLevel();
   if (f !== 0.0) {
     writer.writeEnum(
       1,
       f
     );
   }
+  f = message.getValue();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
  * @enum {number}
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum = {
+proto.qdb.DatabaseAlarmLimit.LevelEnum = {
   UNSPECIFIED: 0,
-  SUCCESS: 1,
-  FAILURE: 2
+  LOW_LOW: 1,
+  LOW: 2,
+  HIGH: 3,
+  HIGH_HIGH: 4
 };
 
 /**
- * optional StatusEnum status = 1;
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum}
+ * optional LevelEnum level = 1;
+ * @return {!proto.qdb.DatabaseAlarmLimit.LevelEnum}
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.getStatus = function() {
-  return /** @type {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.DatabaseAlarmLimit.prototype.getLevel = function() {
+  return /** @type {!proto.qdb.DatabaseAlarmLimit.LevelEnum} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!proto.qdb.WebRuntimeUnregisterNotificationResponse.StatusEnum} value
- * @return {!proto.qdb.WebRuntimeUnregisterNotificationResponse} returns this
+ * @param {!proto.qdb.DatabaseAlarmLimit.LevelEnum} value
+ * @return {!proto.qdb.DatabaseAlarmLimit} returns this
  */
-proto.qdb.WebRuntimeUnregisterNotificationResponse.prototype.setStatus = function(value) {
+proto.qdb.DatabaseAlarmLimit.prototype.setLevel = function(value) {
   return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
+/**
+ * optional double value = 2;
+ * @return {number}
+ */
+proto.qdb.DatabaseAlarmLimit.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseAlarmLimit} returns this
+ */
+proto.qdb.DatabaseAlarmLimit.prototype.setValue = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.DatabaseComputedField.repeatedFields_ = [2];
 
 
 
@@ -7184,8 +15733,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseComputedField.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseComputedField.toObject(opt_includeInstance, this);
 };
 
 
@@ -7194,13 +15743,15 @@ proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseComputedField} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseComputedField.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+script: jspb.Message.getFieldWithDefault(msg, 1, ""),
+inputsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+evaluation: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -7214,29 +15765,41 @@ proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest}
+ * @return {!proto.qdb.DatabaseComputedField}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseComputedField.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest;
-  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseComputedField;
+  return proto.qdb.DatabaseComputedField.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseComputedField} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest}
+ * @return {!proto.qdb.DatabaseComputedField}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseComputedField.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScript(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addInputs(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.qdb.DatabaseComputedField.EvaluationEnum} */ (reader.readEnum());
+      msg.setEvaluation(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7250,9 +15813,9 @@ proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseComputedField.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseComputedField.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7260,163 +15823,115 @@ proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest} message
+ * @param {!proto.qdb.DatabaseComputedField} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseComputedField.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-status: (f = msg.getStatus()) && proto.qdb.ConnectionState.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
+  f = message.getScript();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getInputsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getEvaluation();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
   }
-  return obj;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse}
+ * @enum {number}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse;
-  return proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseComputedField.EvaluationEnum = {
+  UNSPECIFIED: 0,
+  ON_READ: 1,
+  ON_CHANGE: 2
 };
 
-
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse}
+ * optional string script = 1;
+ * @return {string}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.qdb.ConnectionState;
-      reader.readMessage(value,proto.qdb.ConnectionState.deserializeBinaryFromReader);
-      msg.setStatus(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseComputedField.prototype.getScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseComputedField} returns this
+ */
+proto.qdb.DatabaseComputedField.prototype.setScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * repeated string inputs = 2;
+ * @return {!Array<string>}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseComputedField.prototype.getInputsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.DatabaseComputedField} returns this
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getStatus();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.qdb.ConnectionState.serializeBinaryToWriter
-    );
-  }
+proto.qdb.DatabaseComputedField.prototype.setInputsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional ConnectionState status = 1;
- * @return {?proto.qdb.ConnectionState}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseComputedField} returns this
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.getStatus = function() {
-  return /** @type{?proto.qdb.ConnectionState} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.ConnectionState, 1));
+proto.qdb.DatabaseComputedField.prototype.addInputs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {?proto.qdb.ConnectionState|undefined} value
- * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} returns this
-*/
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseComputedField} returns this
+ */
+proto.qdb.DatabaseComputedField.prototype.clearInputsList = function() {
+  return this.setInputsList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse} returns this
+ * optional EvaluationEnum evaluation = 3;
+ * @return {!proto.qdb.DatabaseComputedField.EvaluationEnum}
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.clearStatus = function() {
-  return this.setStatus(undefined);
+proto.qdb.DatabaseComputedField.prototype.getEvaluation = function() {
+  return /** @type {!proto.qdb.DatabaseComputedField.EvaluationEnum} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.qdb.DatabaseComputedField.EvaluationEnum} value
+ * @return {!proto.qdb.DatabaseComputedField} returns this
  */
-proto.qdb.WebRuntimeGetDatabaseConnectionStatusResponse.prototype.hasStatus = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.qdb.DatabaseComputedField.prototype.setEvaluation = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
@@ -7436,8 +15951,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeGetEntitiesRequest.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7446,13 +15961,18 @@ proto.qdb.WebRuntimeGetEntitiesRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeGetEntitiesRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-entitytype: jspb.Message.getFieldWithDefault(msg, 1, "")
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+field: jspb.Message.getFieldWithDefault(msg, 2, ""),
+value: (f = msg.getValue()) && google_protobuf_any_pb.Any.toObject(includeInstance, f),
+writetime: (f = msg.getWritetime()) && proto.qdb.Timestamp.toObject(includeInstance, f),
+writerid: (f = msg.getWriterid()) && proto.qdb.String.toObject(includeInstance, f),
+success: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -7466,23 +15986,23 @@ entitytype: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeGetEntitiesRequest}
+ * @return {!proto.qdb.DatabaseRequest}
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeGetEntitiesRequest;
-  return proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseRequest;
+  return proto.qdb.DatabaseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeGetEntitiesRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeGetEntitiesRequest}
+ * @return {!proto.qdb.DatabaseRequest}
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7491,7 +16011,30 @@ proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinaryFromReader = function(ms
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEntitytype(value);
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    case 3:
+      var value = new google_protobuf_any_pb.Any;
+      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
+      msg.setValue(value);
+      break;
+    case 4:
+      var value = new proto.qdb.Timestamp;
+      reader.readMessage(value,proto.qdb.Timestamp.deserializeBinaryFromReader);
+      msg.setWritetime(value);
+      break;
+    case 5:
+      var value = new proto.qdb.String;
+      reader.readMessage(value,proto.qdb.String.deserializeBinaryFromReader);
+      msg.setWriterid(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
       break;
     default:
       reader.skipField();
@@ -7506,9 +16049,9 @@ proto.qdb.WebRuntimeGetEntitiesRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.prototype.serializeBinary = function() {
+proto.qdb.DatabaseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeGetEntitiesRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7516,197 +16059,222 @@ proto.qdb.WebRuntimeGetEntitiesRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeGetEntitiesRequest} message
+ * @param {!proto.qdb.DatabaseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEntitytype();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_any_pb.Any.serializeBinaryToWriter
+    );
+  }
+  f = message.getWritetime();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.qdb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getWriterid();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.qdb.String.serializeBinaryToWriter
+    );
+  }
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string entityType = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.prototype.getEntitytype = function() {
+proto.qdb.DatabaseRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.WebRuntimeGetEntitiesRequest} returns this
+ * @return {!proto.qdb.DatabaseRequest} returns this
  */
-proto.qdb.WebRuntimeGetEntitiesRequest.prototype.setEntitytype = function(value) {
+proto.qdb.DatabaseRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional string field = 2;
+ * @return {string}
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.repeatedFields_ = [1];
+proto.qdb.DatabaseRequest.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
 
 
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseRequest} returns this
+ */
+proto.qdb.DatabaseRequest.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional google.protobuf.Any value = 3;
+ * @return {?proto.google.protobuf.Any}
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.WebRuntimeGetEntitiesResponse.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseRequest.prototype.getValue = function() {
+  return /** @type{?proto.google.protobuf.Any} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 3));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.qdb.WebRuntimeGetEntitiesResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.google.protobuf.Any|undefined} value
+ * @return {!proto.qdb.DatabaseRequest} returns this
+*/
+proto.qdb.DatabaseRequest.prototype.setValue = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseRequest} returns this
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-entitiesList: jspb.Message.toObjectList(msg.getEntitiesList(),
-    proto.qdb.DatabaseEntity.toObject, includeInstance)
-  };
+proto.qdb.DatabaseRequest.prototype.clearValue = function() {
+  return this.setValue(undefined);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseRequest.prototype.hasValue = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.WebRuntimeGetEntitiesResponse}
+ * optional Timestamp writeTime = 4;
+ * @return {?proto.qdb.Timestamp}
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.WebRuntimeGetEntitiesResponse;
-  return proto.qdb.WebRuntimeGetEntitiesResponse.deserializeBinaryFromReader(msg, reader);
+proto.qdb.DatabaseRequest.prototype.getWritetime = function() {
+  return /** @type{?proto.qdb.Timestamp} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.qdb.Timestamp|undefined} value
+ * @return {!proto.qdb.DatabaseRequest} returns this
+*/
+proto.qdb.DatabaseRequest.prototype.setWritetime = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.qdb.WebRuntimeGetEntitiesResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.WebRuntimeGetEntitiesResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseRequest} returns this
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.qdb.DatabaseEntity;
-      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
-      msg.addEntities(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.qdb.DatabaseRequest.prototype.clearWritetime = function() {
+  return this.setWritetime(undefined);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.WebRuntimeGetEntitiesResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.qdb.DatabaseRequest.prototype.hasWritetime = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.WebRuntimeGetEntitiesResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional String writerId = 5;
+ * @return {?proto.qdb.String}
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getEntitiesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.qdb.DatabaseEntity.serializeBinaryToWriter
-    );
-  }
+proto.qdb.DatabaseRequest.prototype.getWriterid = function() {
+  return /** @type{?proto.qdb.String} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.String, 5));
 };
 
 
 /**
- * repeated DatabaseEntity entities = 1;
- * @return {!Array<!proto.qdb.DatabaseEntity>}
+ * @param {?proto.qdb.String|undefined} value
+ * @return {!proto.qdb.DatabaseRequest} returns this
+*/
+proto.qdb.DatabaseRequest.prototype.setWriterid = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseRequest} returns this
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.prototype.getEntitiesList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEntity, 1));
+proto.qdb.DatabaseRequest.prototype.clearWriterid = function() {
+  return this.setWriterid(undefined);
 };
 
 
 /**
- * @param {!Array<!proto.qdb.DatabaseEntity>} value
- * @return {!proto.qdb.WebRuntimeGetEntitiesResponse} returns this
-*/
-proto.qdb.WebRuntimeGetEntitiesResponse.prototype.setEntitiesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseRequest.prototype.hasWriterid = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseEntity}
+ * optional bool success = 6;
+ * @return {boolean}
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.prototype.addEntities = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseEntity, opt_index);
+proto.qdb.DatabaseRequest.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.WebRuntimeGetEntitiesResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.qdb.DatabaseRequest} returns this
  */
-proto.qdb.WebRuntimeGetEntitiesResponse.prototype.clearEntitiesList = function() {
-  return this.setEntitiesList([]);
+proto.qdb.DatabaseRequest.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
@@ -7716,7 +16284,7 @@ proto.qdb.WebRuntimeGetEntitiesResponse.prototype.clearEntitiesList = function()
  * @private {!Array<number>}
  * @const
  */
-proto.qdb.DatabaseEntity.repeatedFields_ = [5];
+proto.qdb.DatabaseQueryRow.repeatedFields_ = [2];
 
 
 
@@ -7733,8 +16301,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseEntity.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseEntity.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseQueryRow.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseQueryRow.toObject(opt_includeInstance, this);
 };
 
 
@@ -7743,18 +16311,15 @@ proto.qdb.DatabaseEntity.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseEntity} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseQueryRow} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseEntity.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseQueryRow.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-type: jspb.Message.getFieldWithDefault(msg, 2, ""),
-name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-parent: (f = msg.getParent()) && proto.qdb.EntityReference.toObject(includeInstance, f),
-childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
-    proto.qdb.EntityReference.toObject, includeInstance)
+entityid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+fieldsList: jspb.Message.toObjectList(msg.getFieldsList(),
+    proto.qdb.DatabaseField.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -7768,23 +16333,23 @@ childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseEntity}
+ * @return {!proto.qdb.DatabaseQueryRow}
  */
-proto.qdb.DatabaseEntity.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseQueryRow.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseEntity;
-  return proto.qdb.DatabaseEntity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseQueryRow;
+  return proto.qdb.DatabaseQueryRow.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseEntity} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseQueryRow} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseEntity}
+ * @return {!proto.qdb.DatabaseQueryRow}
  */
-proto.qdb.DatabaseEntity.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseQueryRow.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7793,225 +16358,124 @@ proto.qdb.DatabaseEntity.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 4:
-      var value = new proto.qdb.EntityReference;
-      reader.readMessage(value,proto.qdb.EntityReference.deserializeBinaryFromReader);
-      msg.setParent(value);
-      break;
-    case 5:
-      var value = new proto.qdb.EntityReference;
-      reader.readMessage(value,proto.qdb.EntityReference.deserializeBinaryFromReader);
-      msg.addChildren(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.qdb.DatabaseEntity.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseEntity.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseEntity} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.qdb.DatabaseEntity.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getParent();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.qdb.EntityReference.serializeBinaryToWriter
-    );
-  }
-  f = message.getChildrenList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      proto.qdb.EntityReference.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.qdb.DatabaseEntity.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseEntity} returns this
- */
-proto.qdb.DatabaseEntity.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string type = 2;
- * @return {string}
- */
-proto.qdb.DatabaseEntity.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseEntity} returns this
- */
-proto.qdb.DatabaseEntity.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional string name = 3;
- * @return {string}
- */
-proto.qdb.DatabaseEntity.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+      msg.setEntityid(value);
+      break;
+    case 2:
+      var value = new proto.qdb.DatabaseField;
+      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
+      msg.addFields(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.DatabaseEntity} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.qdb.DatabaseEntity.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.qdb.DatabaseQueryRow.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.DatabaseQueryRow.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional EntityReference parent = 4;
- * @return {?proto.qdb.EntityReference}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.DatabaseQueryRow} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseEntity.prototype.getParent = function() {
-  return /** @type{?proto.qdb.EntityReference} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.EntityReference, 4));
-};
-
-
-/**
- * @param {?proto.qdb.EntityReference|undefined} value
- * @return {!proto.qdb.DatabaseEntity} returns this
-*/
-proto.qdb.DatabaseEntity.prototype.setParent = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.qdb.DatabaseQueryRow.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntityid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFieldsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.qdb.DatabaseField.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseEntity} returns this
+ * optional string entityId = 1;
+ * @return {string}
  */
-proto.qdb.DatabaseEntity.prototype.clearParent = function() {
-  return this.setParent(undefined);
+proto.qdb.DatabaseQueryRow.prototype.getEntityid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseQueryRow} returns this
  */
-proto.qdb.DatabaseEntity.prototype.hasParent = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.qdb.DatabaseQueryRow.prototype.setEntityid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated EntityReference children = 5;
- * @return {!Array<!proto.qdb.EntityReference>}
+ * repeated DatabaseField fields = 2;
+ * @return {!Array<!proto.qdb.DatabaseField>}
  */
-proto.qdb.DatabaseEntity.prototype.getChildrenList = function() {
-  return /** @type{!Array<!proto.qdb.EntityReference>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.EntityReference, 5));
+proto.qdb.DatabaseQueryRow.prototype.getFieldsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseField>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseField, 2));
 };
 
 
 /**
- * @param {!Array<!proto.qdb.EntityReference>} value
- * @return {!proto.qdb.DatabaseEntity} returns this
+ * @param {!Array<!proto.qdb.DatabaseField>} value
+ * @return {!proto.qdb.DatabaseQueryRow} returns this
 */
-proto.qdb.DatabaseEntity.prototype.setChildrenList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+proto.qdb.DatabaseQueryRow.prototype.setFieldsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.qdb.EntityReference=} opt_value
+ * @param {!proto.qdb.DatabaseField=} opt_value
  * @param {number=} opt_index
- * @return {!proto.qdb.EntityReference}
+ * @return {!proto.qdb.DatabaseField}
  */
-proto.qdb.DatabaseEntity.prototype.addChildren = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.qdb.EntityReference, opt_index);
+proto.qdb.DatabaseQueryRow.prototype.addFields = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseField, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseEntity} returns this
+ * @return {!proto.qdb.DatabaseQueryRow} returns this
  */
-proto.qdb.DatabaseEntity.prototype.clearChildrenList = function() {
-  return this.setChildrenList([]);
+proto.qdb.DatabaseQueryRow.prototype.clearFieldsList = function() {
+  return this.setFieldsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.DatabaseSnapshot.repeatedFields_ = [1,2,3,4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8027,8 +16491,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseField.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseField.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshot.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshot.toObject(opt_includeInstance, this);
 };
 
 
@@ -8037,17 +16501,20 @@ proto.qdb.DatabaseField.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseField} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshot} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseField.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshot.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-value: (f = msg.getValue()) && google_protobuf_any_pb.Any.toObject(includeInstance, f),
-writetime: (f = msg.getWritetime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-writerid: jspb.Message.getFieldWithDefault(msg, 5, "")
+entitiesList: jspb.Message.toObjectList(msg.getEntitiesList(),
+    proto.qdb.DatabaseEntity.toObject, includeInstance),
+fieldsList: jspb.Message.toObjectList(msg.getFieldsList(),
+    proto.qdb.DatabaseField.toObject, includeInstance),
+entityschemasList: jspb.Message.toObjectList(msg.getEntityschemasList(),
+    proto.qdb.DatabaseEntitySchema.toObject, includeInstance),
+fieldschemasList: jspb.Message.toObjectList(msg.getFieldschemasList(),
+    proto.qdb.DatabaseFieldSchema.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -8061,23 +16528,23 @@ writerid: jspb.Message.getFieldWithDefault(msg, 5, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseField}
+ * @return {!proto.qdb.DatabaseSnapshot}
  */
-proto.qdb.DatabaseField.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshot.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseField;
-  return proto.qdb.DatabaseField.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshot;
+  return proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseField} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshot} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseField}
+ * @return {!proto.qdb.DatabaseSnapshot}
  */
-proto.qdb.DatabaseField.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8085,26 +16552,24 @@ proto.qdb.DatabaseField.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.addEntities(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = new proto.qdb.DatabaseField;
+      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
+      msg.addFields(value);
       break;
     case 3:
-      var value = new google_protobuf_any_pb.Any;
-      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
-      msg.setValue(value);
+      var value = new proto.qdb.DatabaseEntitySchema;
+      reader.readMessage(value,proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader);
+      msg.addEntityschemas(value);
       break;
     case 4:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setWritetime(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setWriterid(value);
+      var value = new proto.qdb.DatabaseFieldSchema;
+      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
+      msg.addFieldschemas(value);
       break;
     default:
       reader.skipField();
@@ -8119,9 +16584,9 @@ proto.qdb.DatabaseField.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.DatabaseField.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshot.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseField.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshot.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8129,187 +16594,199 @@ proto.qdb.DatabaseField.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseField} message
+ * @param {!proto.qdb.DatabaseSnapshot} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseField.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshot.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getEntitiesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
     );
   }
-  f = message.getName();
+  f = message.getFieldsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.qdb.DatabaseField.serializeBinaryToWriter
     );
   }
-  f = message.getValue();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getEntityschemasList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
       f,
-      google_protobuf_any_pb.Any.serializeBinaryToWriter
+      proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter
     );
   }
-  f = message.getWritetime();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getFieldschemasList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       4,
       f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getWriterid();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
+      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * repeated DatabaseEntity entities = 1;
+ * @return {!Array<!proto.qdb.DatabaseEntity>}
  */
-proto.qdb.DatabaseField.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.DatabaseSnapshot.prototype.getEntitiesList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseEntity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEntity, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.DatabaseField} returns this
- */
-proto.qdb.DatabaseField.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.qdb.DatabaseEntity>} value
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
+*/
+proto.qdb.DatabaseSnapshot.prototype.setEntitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * @param {!proto.qdb.DatabaseEntity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseEntity}
  */
-proto.qdb.DatabaseField.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.qdb.DatabaseSnapshot.prototype.addEntities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseEntity, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.DatabaseField} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
  */
-proto.qdb.DatabaseField.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.qdb.DatabaseSnapshot.prototype.clearEntitiesList = function() {
+  return this.setEntitiesList([]);
 };
 
 
 /**
- * optional google.protobuf.Any value = 3;
- * @return {?proto.google.protobuf.Any}
+ * repeated DatabaseField fields = 2;
+ * @return {!Array<!proto.qdb.DatabaseField>}
  */
-proto.qdb.DatabaseField.prototype.getValue = function() {
-  return /** @type{?proto.google.protobuf.Any} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 3));
+proto.qdb.DatabaseSnapshot.prototype.getFieldsList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseField>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseField, 2));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Any|undefined} value
- * @return {!proto.qdb.DatabaseField} returns this
+ * @param {!Array<!proto.qdb.DatabaseField>} value
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
 */
-proto.qdb.DatabaseField.prototype.setValue = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.qdb.DatabaseSnapshot.prototype.setFieldsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseField} returns this
+ * @param {!proto.qdb.DatabaseField=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseField}
  */
-proto.qdb.DatabaseField.prototype.clearValue = function() {
-  return this.setValue(undefined);
+proto.qdb.DatabaseSnapshot.prototype.addFields = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseField, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
  */
-proto.qdb.DatabaseField.prototype.hasValue = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.qdb.DatabaseSnapshot.prototype.clearFieldsList = function() {
+  return this.setFieldsList([]);
 };
 
 
 /**
- * optional google.protobuf.Timestamp writeTime = 4;
- * @return {?proto.google.protobuf.Timestamp}
+ * repeated DatabaseEntitySchema entitySchemas = 3;
+ * @return {!Array<!proto.qdb.DatabaseEntitySchema>}
  */
-proto.qdb.DatabaseField.prototype.getWritetime = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+proto.qdb.DatabaseSnapshot.prototype.getEntityschemasList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseEntitySchema>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEntitySchema, 3));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.qdb.DatabaseField} returns this
+ * @param {!Array<!proto.qdb.DatabaseEntitySchema>} value
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
 */
-proto.qdb.DatabaseField.prototype.setWritetime = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.qdb.DatabaseSnapshot.prototype.setEntityschemasList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseField} returns this
+ * @param {!proto.qdb.DatabaseEntitySchema=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseEntitySchema}
  */
-proto.qdb.DatabaseField.prototype.clearWritetime = function() {
-  return this.setWritetime(undefined);
+proto.qdb.DatabaseSnapshot.prototype.addEntityschemas = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.qdb.DatabaseEntitySchema, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
  */
-proto.qdb.DatabaseField.prototype.hasWritetime = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.qdb.DatabaseSnapshot.prototype.clearEntityschemasList = function() {
+  return this.setEntityschemasList([]);
 };
 
 
 /**
- * optional string writerId = 5;
- * @return {string}
+ * repeated DatabaseFieldSchema fieldSchemas = 4;
+ * @return {!Array<!proto.qdb.DatabaseFieldSchema>}
  */
-proto.qdb.DatabaseField.prototype.getWriterid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.qdb.DatabaseSnapshot.prototype.getFieldschemasList = function() {
+  return /** @type{!Array<!proto.qdb.DatabaseFieldSchema>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseFieldSchema, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.DatabaseField} returns this
- */
-proto.qdb.DatabaseField.prototype.setWriterid = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+ * @param {!Array<!proto.qdb.DatabaseFieldSchema>} value
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
+*/
+proto.qdb.DatabaseSnapshot.prototype.setFieldschemasList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
+/**
+ * @param {!proto.qdb.DatabaseFieldSchema=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.DatabaseFieldSchema}
+ */
+proto.qdb.DatabaseSnapshot.prototype.addFieldschemas = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.qdb.DatabaseFieldSchema, opt_index);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.DatabaseSnapshot} returns this
  */
-proto.qdb.DatabaseNotificationConfig.repeatedFields_ = [4];
+proto.qdb.DatabaseSnapshot.prototype.clearFieldschemasList = function() {
+  return this.setFieldschemasList([]);
+};
+
+
 
 
 
@@ -8326,8 +16803,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseNotificationConfig.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseNotificationConfig.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotRecord.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotRecord.toObject(opt_includeInstance, this);
 };
 
 
@@ -8336,18 +16813,18 @@ proto.qdb.DatabaseNotificationConfig.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseNotificationConfig} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotRecord} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseNotificationConfig.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotRecord.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-type: jspb.Message.getFieldWithDefault(msg, 2, ""),
-field: jspb.Message.getFieldWithDefault(msg, 3, ""),
-contextfieldsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-notifyonchange: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-serviceid: jspb.Message.getFieldWithDefault(msg, 6, "")
+header: (f = msg.getHeader()) && proto.qdb.DatabaseSnapshotRecord.Header.toObject(includeInstance, f),
+entityschema: (f = msg.getEntityschema()) && proto.qdb.DatabaseEntitySchema.toObject(includeInstance, f),
+fieldschema: (f = msg.getFieldschema()) && proto.qdb.DatabaseFieldSchema.toObject(includeInstance, f),
+entity: (f = msg.getEntity()) && proto.qdb.DatabaseEntity.toObject(includeInstance, f),
+field: (f = msg.getField()) && proto.qdb.DatabaseField.toObject(includeInstance, f),
+trailer: (f = msg.getTrailer()) && proto.qdb.DatabaseSnapshotRecord.Trailer.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -8361,23 +16838,23 @@ serviceid: jspb.Message.getFieldWithDefault(msg, 6, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseNotificationConfig}
+ * @return {!proto.qdb.DatabaseSnapshotRecord}
  */
-proto.qdb.DatabaseNotificationConfig.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshotRecord.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseNotificationConfig;
-  return proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshotRecord;
+  return proto.qdb.DatabaseSnapshotRecord.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseNotificationConfig} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotRecord} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseNotificationConfig}
+ * @return {!proto.qdb.DatabaseSnapshotRecord}
  */
-proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotRecord.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8385,28 +16862,34 @@ proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.qdb.DatabaseSnapshotRecord.Header;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotRecord.Header.deserializeBinaryFromReader);
+      msg.setHeader(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
+      var value = new proto.qdb.DatabaseEntitySchema;
+      reader.readMessage(value,proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader);
+      msg.setEntityschema(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setField(value);
+      var value = new proto.qdb.DatabaseFieldSchema;
+      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
+      msg.setFieldschema(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addContextfields(value);
+      var value = new proto.qdb.DatabaseEntity;
+      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
+      msg.setEntity(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setNotifyonchange(value);
+      var value = new proto.qdb.DatabaseField;
+      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
+      msg.setField(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceid(value);
+      var value = new proto.qdb.DatabaseSnapshotRecord.Trailer;
+      reader.readMessage(value,proto.qdb.DatabaseSnapshotRecord.Trailer.deserializeBinaryFromReader);
+      msg.setTrailer(value);
       break;
     default:
       reader.skipField();
@@ -8421,9 +16904,9 @@ proto.qdb.DatabaseNotificationConfig.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.DatabaseNotificationConfig.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotRecord.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseNotificationConfig.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotRecord.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8431,192 +16914,194 @@ proto.qdb.DatabaseNotificationConfig.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseNotificationConfig} message
+ * @param {!proto.qdb.DatabaseSnapshotRecord} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseNotificationConfig.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotRecord.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getHeader();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.qdb.DatabaseSnapshotRecord.Header.serializeBinaryToWriter
     );
   }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getEntityschema();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter
     );
   }
-  f = message.getField();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFieldschema();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
     );
   }
-  f = message.getContextfieldsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getEntity();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
+      f,
+      proto.qdb.DatabaseEntity.serializeBinaryToWriter
     );
   }
-  f = message.getNotifyonchange();
-  if (f) {
-    writer.writeBool(
+  f = message.getField();
+  if (f != null) {
+    writer.writeMessage(
       5,
-      f
+      f,
+      proto.qdb.DatabaseField.serializeBinaryToWriter
     );
   }
-  f = message.getServiceid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTrailer();
+  if (f != null) {
+    writer.writeMessage(
       6,
-      f
+      f,
+      proto.qdb.DatabaseSnapshotRecord.Trailer.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.qdb.DatabaseNotificationConfig.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
- */
-proto.qdb.DatabaseNotificationConfig.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string type = 2;
- * @return {string}
- */
-proto.qdb.DatabaseNotificationConfig.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
- */
-proto.qdb.DatabaseNotificationConfig.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional string field = 3;
- * @return {string}
- */
-proto.qdb.DatabaseNotificationConfig.prototype.getField = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
- */
-proto.qdb.DatabaseNotificationConfig.prototype.setField = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * repeated string contextFields = 4;
- * @return {!Array<string>}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.qdb.DatabaseNotificationConfig.prototype.getContextfieldsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.qdb.DatabaseSnapshotRecord.Header.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotRecord.Header.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.DatabaseSnapshotRecord.Header} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseNotificationConfig.prototype.setContextfieldsList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.qdb.DatabaseSnapshotRecord.Header.toObject = function(includeInstance, msg) {
+  var f, obj = {
+entitycount: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Header}
  */
-proto.qdb.DatabaseNotificationConfig.prototype.addContextfields = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.qdb.DatabaseSnapshotRecord.Header.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.DatabaseSnapshotRecord.Header;
+  return proto.qdb.DatabaseSnapshotRecord.Header.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.DatabaseSnapshotRecord.Header} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Header}
  */
-proto.qdb.DatabaseNotificationConfig.prototype.clearContextfieldsList = function() {
-  return this.setContextfieldsList([]);
+proto.qdb.DatabaseSnapshotRecord.Header.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEntitycount(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool notifyOnChange = 5;
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.qdb.DatabaseNotificationConfig.prototype.getNotifyonchange = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.qdb.DatabaseSnapshotRecord.Header.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.DatabaseSnapshotRecord.Header.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.DatabaseSnapshotRecord.Header} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseNotificationConfig.prototype.setNotifyonchange = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.qdb.DatabaseSnapshotRecord.Header.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntitycount();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string serviceId = 6;
- * @return {string}
+ * optional int64 entityCount = 1;
+ * @return {number}
  */
-proto.qdb.DatabaseNotificationConfig.prototype.getServiceid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.qdb.DatabaseSnapshotRecord.Header.prototype.getEntitycount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.DatabaseNotificationConfig} returns this
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Header} returns this
  */
-proto.qdb.DatabaseNotificationConfig.prototype.setServiceid = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.qdb.DatabaseSnapshotRecord.Header.prototype.setEntitycount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.qdb.DatabaseNotification.repeatedFields_ = [4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8632,8 +17117,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseNotification.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseNotification.toObject(opt_includeInstance, this);
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.DatabaseSnapshotRecord.Trailer.toObject(opt_includeInstance, this);
 };
 
 
@@ -8642,17 +17127,15 @@ proto.qdb.DatabaseNotification.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseNotification} msg The msg instance to transform.
+ * @param {!proto.qdb.DatabaseSnapshotRecord.Trailer} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseNotification.toObject = function(includeInstance, msg) {
+proto.qdb.DatabaseSnapshotRecord.Trailer.toObject = function(includeInstance, msg) {
   var f, obj = {
-token: jspb.Message.getFieldWithDefault(msg, 1, ""),
-current: (f = msg.getCurrent()) && proto.qdb.DatabaseField.toObject(includeInstance, f),
-previous: (f = msg.getPrevious()) && proto.qdb.DatabaseField.toObject(includeInstance, f),
-contextList: jspb.Message.toObjectList(msg.getContextList(),
-    proto.qdb.DatabaseField.toObject, includeInstance)
+entitycount: jspb.Message.getFieldWithDefault(msg, 1, 0),
+fieldcount: jspb.Message.getFieldWithDefault(msg, 2, 0),
+checksum: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -8666,23 +17149,23 @@ contextList: jspb.Message.toObjectList(msg.getContextList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseNotification}
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Trailer}
  */
-proto.qdb.DatabaseNotification.deserializeBinary = function(bytes) {
+proto.qdb.DatabaseSnapshotRecord.Trailer.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseNotification;
-  return proto.qdb.DatabaseNotification.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.DatabaseSnapshotRecord.Trailer;
+  return proto.qdb.DatabaseSnapshotRecord.Trailer.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseNotification} msg The message object to deserialize into.
+ * @param {!proto.qdb.DatabaseSnapshotRecord.Trailer} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseNotification}
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Trailer}
  */
-proto.qdb.DatabaseNotification.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.DatabaseSnapshotRecord.Trailer.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8690,23 +17173,16 @@ proto.qdb.DatabaseNotification.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setToken(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEntitycount(value);
       break;
     case 2:
-      var value = new proto.qdb.DatabaseField;
-      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
-      msg.setCurrent(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFieldcount(value);
       break;
     case 3:
-      var value = new proto.qdb.DatabaseField;
-      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
-      msg.setPrevious(value);
-      break;
-    case 4:
-      var value = new proto.qdb.DatabaseField;
-      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
-      msg.addContext(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChecksum(value);
       break;
     default:
       reader.skipField();
@@ -8721,9 +17197,9 @@ proto.qdb.DatabaseNotification.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.DatabaseNotification.prototype.serializeBinary = function() {
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseNotification.serializeBinaryToWriter(this, writer);
+  proto.qdb.DatabaseSnapshotRecord.Trailer.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8731,89 +17207,226 @@ proto.qdb.DatabaseNotification.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseNotification} message
+ * @param {!proto.qdb.DatabaseSnapshotRecord.Trailer} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseNotification.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.DatabaseSnapshotRecord.Trailer.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getToken();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getEntitycount();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
   }
-  f = message.getCurrent();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getFieldcount();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
-      f,
-      proto.qdb.DatabaseField.serializeBinaryToWriter
-    );
-  }
-  f = message.getPrevious();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.qdb.DatabaseField.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getContextList();
+  f = message.getChecksum();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.qdb.DatabaseField.serializeBinaryToWriter
+    writer.writeString(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * optional string token = 1;
- * @return {string}
+ * optional int64 entityCount = 1;
+ * @return {number}
+ */
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.getEntitycount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Trailer} returns this
+ */
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.setEntitycount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 fieldCount = 2;
+ * @return {number}
+ */
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.getFieldcount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Trailer} returns this
+ */
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.setFieldcount = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string checksum = 3;
+ * @return {string}
+ */
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.getChecksum = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord.Trailer} returns this
+ */
+proto.qdb.DatabaseSnapshotRecord.Trailer.prototype.setChecksum = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional Header header = 1;
+ * @return {?proto.qdb.DatabaseSnapshotRecord.Header}
+ */
+proto.qdb.DatabaseSnapshotRecord.prototype.getHeader = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshotRecord.Header} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshotRecord.Header, 1));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseSnapshotRecord.Header|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
+*/
+proto.qdb.DatabaseSnapshotRecord.prototype.setHeader = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
+ */
+proto.qdb.DatabaseSnapshotRecord.prototype.clearHeader = function() {
+  return this.setHeader(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseSnapshotRecord.prototype.hasHeader = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional DatabaseEntitySchema entitySchema = 2;
+ * @return {?proto.qdb.DatabaseEntitySchema}
+ */
+proto.qdb.DatabaseSnapshotRecord.prototype.getEntityschema = function() {
+  return /** @type{?proto.qdb.DatabaseEntitySchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntitySchema, 2));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseEntitySchema|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
+*/
+proto.qdb.DatabaseSnapshotRecord.prototype.setEntityschema = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
+ */
+proto.qdb.DatabaseSnapshotRecord.prototype.clearEntityschema = function() {
+  return this.setEntityschema(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.qdb.DatabaseSnapshotRecord.prototype.hasEntityschema = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional DatabaseFieldSchema fieldSchema = 3;
+ * @return {?proto.qdb.DatabaseFieldSchema}
+ */
+proto.qdb.DatabaseSnapshotRecord.prototype.getFieldschema = function() {
+  return /** @type{?proto.qdb.DatabaseFieldSchema} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseFieldSchema, 3));
+};
+
+
+/**
+ * @param {?proto.qdb.DatabaseFieldSchema|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
+*/
+proto.qdb.DatabaseSnapshotRecord.prototype.setFieldschema = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
  */
-proto.qdb.DatabaseNotification.prototype.getToken = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.DatabaseSnapshotRecord.prototype.clearFieldschema = function() {
+  return this.setFieldschema(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.DatabaseNotification} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.DatabaseNotification.prototype.setToken = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.DatabaseSnapshotRecord.prototype.hasFieldschema = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional DatabaseField current = 2;
- * @return {?proto.qdb.DatabaseField}
+ * optional DatabaseEntity entity = 4;
+ * @return {?proto.qdb.DatabaseEntity}
  */
-proto.qdb.DatabaseNotification.prototype.getCurrent = function() {
-  return /** @type{?proto.qdb.DatabaseField} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseField, 2));
+proto.qdb.DatabaseSnapshotRecord.prototype.getEntity = function() {
+  return /** @type{?proto.qdb.DatabaseEntity} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseEntity, 4));
 };
 
 
 /**
- * @param {?proto.qdb.DatabaseField|undefined} value
- * @return {!proto.qdb.DatabaseNotification} returns this
+ * @param {?proto.qdb.DatabaseEntity|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
 */
-proto.qdb.DatabaseNotification.prototype.setCurrent = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.qdb.DatabaseSnapshotRecord.prototype.setEntity = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseNotification} returns this
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
  */
-proto.qdb.DatabaseNotification.prototype.clearCurrent = function() {
-  return this.setCurrent(undefined);
+proto.qdb.DatabaseSnapshotRecord.prototype.clearEntity = function() {
+  return this.setEntity(undefined);
 };
 
 
@@ -8821,36 +17434,36 @@ proto.qdb.DatabaseNotification.prototype.clearCurrent = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.qdb.DatabaseNotification.prototype.hasCurrent = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.qdb.DatabaseSnapshotRecord.prototype.hasEntity = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional DatabaseField previous = 3;
+ * optional DatabaseField field = 5;
  * @return {?proto.qdb.DatabaseField}
  */
-proto.qdb.DatabaseNotification.prototype.getPrevious = function() {
+proto.qdb.DatabaseSnapshotRecord.prototype.getField = function() {
   return /** @type{?proto.qdb.DatabaseField} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.DatabaseField, 3));
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseField, 5));
 };
 
 
 /**
  * @param {?proto.qdb.DatabaseField|undefined} value
- * @return {!proto.qdb.DatabaseNotification} returns this
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
 */
-proto.qdb.DatabaseNotification.prototype.setPrevious = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.qdb.DatabaseSnapshotRecord.prototype.setField = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseNotification} returns this
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
  */
-proto.qdb.DatabaseNotification.prototype.clearPrevious = function() {
-  return this.setPrevious(undefined);
+proto.qdb.DatabaseSnapshotRecord.prototype.clearField = function() {
+  return this.setField(undefined);
 };
 
 
@@ -8858,57 +17471,49 @@ proto.qdb.DatabaseNotification.prototype.clearPrevious = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.qdb.DatabaseNotification.prototype.hasPrevious = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.qdb.DatabaseSnapshotRecord.prototype.hasField = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * repeated DatabaseField context = 4;
- * @return {!Array<!proto.qdb.DatabaseField>}
+ * optional Trailer trailer = 6;
+ * @return {?proto.qdb.DatabaseSnapshotRecord.Trailer}
  */
-proto.qdb.DatabaseNotification.prototype.getContextList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseField>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseField, 4));
+proto.qdb.DatabaseSnapshotRecord.prototype.getTrailer = function() {
+  return /** @type{?proto.qdb.DatabaseSnapshotRecord.Trailer} */ (
+    jspb.Message.getWrapperField(this, proto.qdb.DatabaseSnapshotRecord.Trailer, 6));
 };
 
 
 /**
- * @param {!Array<!proto.qdb.DatabaseField>} value
- * @return {!proto.qdb.DatabaseNotification} returns this
+ * @param {?proto.qdb.DatabaseSnapshotRecord.Trailer|undefined} value
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
 */
-proto.qdb.DatabaseNotification.prototype.setContextList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+proto.qdb.DatabaseSnapshotRecord.prototype.setTrailer = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseField=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseField}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.DatabaseSnapshotRecord} returns this
  */
-proto.qdb.DatabaseNotification.prototype.addContext = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.qdb.DatabaseField, opt_index);
+proto.qdb.DatabaseSnapshotRecord.prototype.clearTrailer = function() {
+  return this.setTrailer(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseNotification} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.DatabaseNotification.prototype.clearContextList = function() {
-  return this.setContextList([]);
+proto.qdb.DatabaseSnapshotRecord.prototype.hasTrailer = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.qdb.DatabaseEntitySchema.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8924,8 +17529,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseEntitySchema.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseEntitySchema.toObject(opt_includeInstance, this);
+proto.qdb.Int.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.Int.toObject(opt_includeInstance, this);
 };
 
 
@@ -8934,14 +17539,13 @@ proto.qdb.DatabaseEntitySchema.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseEntitySchema} msg The msg instance to transform.
+ * @param {!proto.qdb.Int} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseEntitySchema.toObject = function(includeInstance, msg) {
+proto.qdb.Int.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-fieldsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+raw: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -8955,23 +17559,23 @@ fieldsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseEntitySchema}
+ * @return {!proto.qdb.Int}
  */
-proto.qdb.DatabaseEntitySchema.deserializeBinary = function(bytes) {
+proto.qdb.Int.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseEntitySchema;
-  return proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.Int;
+  return proto.qdb.Int.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseEntitySchema} msg The message object to deserialize into.
+ * @param {!proto.qdb.Int} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseEntitySchema}
+ * @return {!proto.qdb.Int}
  */
-proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.Int.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8979,12 +17583,8 @@ proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addFields(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRaw(value);
       break;
     default:
       reader.skipField();
@@ -8999,9 +17599,9 @@ proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.DatabaseEntitySchema.prototype.serializeBinary = function() {
+proto.qdb.Int.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter(this, writer);
+  proto.qdb.Int.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9009,81 +17609,37 @@ proto.qdb.DatabaseEntitySchema.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseEntitySchema} message
+ * @param {!proto.qdb.Int} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.Int.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRaw();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
   }
-  f = message.getFieldsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string name = 1;
- * @return {string}
- */
-proto.qdb.DatabaseEntitySchema.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseEntitySchema} returns this
- */
-proto.qdb.DatabaseEntitySchema.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * repeated string fields = 2;
- * @return {!Array<string>}
- */
-proto.qdb.DatabaseEntitySchema.prototype.getFieldsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.qdb.DatabaseEntitySchema} returns this
- */
-proto.qdb.DatabaseEntitySchema.prototype.setFieldsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseEntitySchema} returns this
+ * optional int64 raw = 1;
+ * @return {number}
  */
-proto.qdb.DatabaseEntitySchema.prototype.addFields = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.qdb.Int.prototype.getRaw = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseEntitySchema} returns this
+ * @param {number} value
+ * @return {!proto.qdb.Int} returns this
  */
-proto.qdb.DatabaseEntitySchema.prototype.clearFieldsList = function() {
-  return this.setFieldsList([]);
+proto.qdb.Int.prototype.setRaw = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -9103,8 +17659,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseFieldSchema.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseFieldSchema.toObject(opt_includeInstance, this);
+proto.qdb.String.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.String.toObject(opt_includeInstance, this);
 };
 
 
@@ -9113,14 +17669,13 @@ proto.qdb.DatabaseFieldSchema.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseFieldSchema} msg The msg instance to transform.
+ * @param {!proto.qdb.String} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseFieldSchema.toObject = function(includeInstance, msg) {
+proto.qdb.String.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-type: jspb.Message.getFieldWithDefault(msg, 2, "")
+raw: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9134,23 +17689,23 @@ type: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseFieldSchema}
+ * @return {!proto.qdb.String}
  */
-proto.qdb.DatabaseFieldSchema.deserializeBinary = function(bytes) {
+proto.qdb.String.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseFieldSchema;
-  return proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.String;
+  return proto.qdb.String.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseFieldSchema} msg The message object to deserialize into.
+ * @param {!proto.qdb.String} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseFieldSchema}
+ * @return {!proto.qdb.String}
  */
-proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.String.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9159,11 +17714,7 @@ proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
+      msg.setRaw(value);
       break;
     default:
       reader.skipField();
@@ -9178,9 +17729,9 @@ proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.DatabaseFieldSchema.prototype.serializeBinary = function() {
+proto.qdb.String.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter(this, writer);
+  proto.qdb.String.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9188,62 +17739,37 @@ proto.qdb.DatabaseFieldSchema.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseFieldSchema} message
+ * @param {!proto.qdb.String} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.String.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getRaw();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string raw = 1;
  * @return {string}
  */
-proto.qdb.DatabaseFieldSchema.prototype.getName = function() {
+proto.qdb.String.prototype.getRaw = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.DatabaseFieldSchema} returns this
- */
-proto.qdb.DatabaseFieldSchema.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string type = 2;
- * @return {string}
- */
-proto.qdb.DatabaseFieldSchema.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseFieldSchema} returns this
+ * @return {!proto.qdb.String} returns this
  */
-proto.qdb.DatabaseFieldSchema.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.qdb.String.prototype.setRaw = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -9263,8 +17789,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseRequest.toObject(opt_includeInstance, this);
+proto.qdb.Timestamp.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.Timestamp.toObject(opt_includeInstance, this);
 };
 
 
@@ -9273,18 +17799,13 @@ proto.qdb.DatabaseRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseRequest} msg The msg instance to transform.
+ * @param {!proto.qdb.Timestamp} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseRequest.toObject = function(includeInstance, msg) {
+proto.qdb.Timestamp.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-field: jspb.Message.getFieldWithDefault(msg, 2, ""),
-value: (f = msg.getValue()) && google_protobuf_any_pb.Any.toObject(includeInstance, f),
-writetime: (f = msg.getWritetime()) && proto.qdb.Timestamp.toObject(includeInstance, f),
-writerid: (f = msg.getWriterid()) && proto.qdb.String.toObject(includeInstance, f),
-success: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
+raw: (f = msg.getRaw()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9298,23 +17819,23 @@ success: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseRequest}
+ * @return {!proto.qdb.Timestamp}
  */
-proto.qdb.DatabaseRequest.deserializeBinary = function(bytes) {
+proto.qdb.Timestamp.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseRequest;
-  return proto.qdb.DatabaseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.Timestamp;
+  return proto.qdb.Timestamp.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseRequest} msg The message object to deserialize into.
+ * @param {!proto.qdb.Timestamp} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseRequest}
+ * @return {!proto.qdb.Timestamp}
  */
-proto.qdb.DatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.Timestamp.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9322,31 +17843,9 @@ proto.qdb.DatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setField(value);
-      break;
-    case 3:
-      var value = new google_protobuf_any_pb.Any;
-      reader.readMessage(value,google_protobuf_any_pb.Any.deserializeBinaryFromReader);
-      msg.setValue(value);
-      break;
-    case 4:
-      var value = new proto.qdb.Timestamp;
-      reader.readMessage(value,proto.qdb.Timestamp.deserializeBinaryFromReader);
-      msg.setWritetime(value);
-      break;
-    case 5:
-      var value = new proto.qdb.String;
-      reader.readMessage(value,proto.qdb.String.deserializeBinaryFromReader);
-      msg.setWriterid(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setRaw(value);
       break;
     default:
       reader.skipField();
@@ -9361,9 +17860,9 @@ proto.qdb.DatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.DatabaseRequest.prototype.serializeBinary = function() {
+proto.qdb.Timestamp.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseRequest.serializeBinaryToWriter(this, writer);
+  proto.qdb.Timestamp.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9371,233 +17870,61 @@ proto.qdb.DatabaseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseRequest} message
+ * @param {!proto.qdb.Timestamp} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.Timestamp.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getField();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getValue();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_any_pb.Any.serializeBinaryToWriter
-    );
-  }
-  f = message.getWritetime();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.qdb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getWriterid();
+  f = message.getRaw();
   if (f != null) {
     writer.writeMessage(
-      5,
+      1,
       f,
-      proto.qdb.String.serializeBinaryToWriter
-    );
-  }
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
- */
-proto.qdb.DatabaseRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseRequest} returns this
- */
-proto.qdb.DatabaseRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string field = 2;
- * @return {string}
- */
-proto.qdb.DatabaseRequest.prototype.getField = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.qdb.DatabaseRequest} returns this
- */
-proto.qdb.DatabaseRequest.prototype.setField = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional google.protobuf.Any value = 3;
- * @return {?proto.google.protobuf.Any}
- */
-proto.qdb.DatabaseRequest.prototype.getValue = function() {
-  return /** @type{?proto.google.protobuf.Any} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_any_pb.Any, 3));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Any|undefined} value
- * @return {!proto.qdb.DatabaseRequest} returns this
-*/
-proto.qdb.DatabaseRequest.prototype.setValue = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseRequest} returns this
- */
-proto.qdb.DatabaseRequest.prototype.clearValue = function() {
-  return this.setValue(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.qdb.DatabaseRequest.prototype.hasValue = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
-/**
- * optional Timestamp writeTime = 4;
- * @return {?proto.qdb.Timestamp}
- */
-proto.qdb.DatabaseRequest.prototype.getWritetime = function() {
-  return /** @type{?proto.qdb.Timestamp} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.Timestamp, 4));
-};
-
-
-/**
- * @param {?proto.qdb.Timestamp|undefined} value
- * @return {!proto.qdb.DatabaseRequest} returns this
-*/
-proto.qdb.DatabaseRequest.prototype.setWritetime = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseRequest} returns this
- */
-proto.qdb.DatabaseRequest.prototype.clearWritetime = function() {
-  return this.setWritetime(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.qdb.DatabaseRequest.prototype.hasWritetime = function() {
-  return jspb.Message.getField(this, 4) != null;
-};
-
-
-/**
- * optional String writerId = 5;
- * @return {?proto.qdb.String}
+ * optional google.protobuf.Timestamp raw = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.qdb.DatabaseRequest.prototype.getWriterid = function() {
-  return /** @type{?proto.qdb.String} */ (
-    jspb.Message.getWrapperField(this, proto.qdb.String, 5));
+proto.qdb.Timestamp.prototype.getRaw = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {?proto.qdb.String|undefined} value
- * @return {!proto.qdb.DatabaseRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.qdb.Timestamp} returns this
 */
-proto.qdb.DatabaseRequest.prototype.setWriterid = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.DatabaseRequest} returns this
- */
-proto.qdb.DatabaseRequest.prototype.clearWriterid = function() {
-  return this.setWriterid(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.qdb.DatabaseRequest.prototype.hasWriterid = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.qdb.Timestamp.prototype.setRaw = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional bool success = 6;
- * @return {boolean}
+ * Clears the message field making it undefined.
+ * @return {!proto.qdb.Timestamp} returns this
  */
-proto.qdb.DatabaseRequest.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.qdb.Timestamp.prototype.clearRaw = function() {
+  return this.setRaw(undefined);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.qdb.DatabaseRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.qdb.DatabaseRequest.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
+proto.qdb.Timestamp.prototype.hasRaw = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.qdb.DatabaseSnapshot.repeatedFields_ = [1,2,3,4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9613,8 +17940,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.DatabaseSnapshot.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.DatabaseSnapshot.toObject(opt_includeInstance, this);
+proto.qdb.Float.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.Float.toObject(opt_includeInstance, this);
 };
 
 
@@ -9623,20 +17950,13 @@ proto.qdb.DatabaseSnapshot.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.DatabaseSnapshot} msg The msg instance to transform.
+ * @param {!proto.qdb.Float} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseSnapshot.toObject = function(includeInstance, msg) {
+proto.qdb.Float.toObject = function(includeInstance, msg) {
   var f, obj = {
-entitiesList: jspb.Message.toObjectList(msg.getEntitiesList(),
-    proto.qdb.DatabaseEntity.toObject, includeInstance),
-fieldsList: jspb.Message.toObjectList(msg.getFieldsList(),
-    proto.qdb.DatabaseField.toObject, includeInstance),
-entityschemasList: jspb.Message.toObjectList(msg.getEntityschemasList(),
-    proto.qdb.DatabaseEntitySchema.toObject, includeInstance),
-fieldschemasList: jspb.Message.toObjectList(msg.getFieldschemasList(),
-    proto.qdb.DatabaseFieldSchema.toObject, includeInstance)
+raw: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
   };
 
   if (includeInstance) {
@@ -9650,23 +17970,23 @@ fieldschemasList: jspb.Message.toObjectList(msg.getFieldschemasList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.DatabaseSnapshot}
+ * @return {!proto.qdb.Float}
  */
-proto.qdb.DatabaseSnapshot.deserializeBinary = function(bytes) {
+proto.qdb.Float.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.DatabaseSnapshot;
-  return proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.Float;
+  return proto.qdb.Float.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.DatabaseSnapshot} msg The message object to deserialize into.
+ * @param {!proto.qdb.Float} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.DatabaseSnapshot}
+ * @return {!proto.qdb.Float}
  */
-proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.Float.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9674,24 +17994,8 @@ proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.qdb.DatabaseEntity;
-      reader.readMessage(value,proto.qdb.DatabaseEntity.deserializeBinaryFromReader);
-      msg.addEntities(value);
-      break;
-    case 2:
-      var value = new proto.qdb.DatabaseField;
-      reader.readMessage(value,proto.qdb.DatabaseField.deserializeBinaryFromReader);
-      msg.addFields(value);
-      break;
-    case 3:
-      var value = new proto.qdb.DatabaseEntitySchema;
-      reader.readMessage(value,proto.qdb.DatabaseEntitySchema.deserializeBinaryFromReader);
-      msg.addEntityschemas(value);
-      break;
-    case 4:
-      var value = new proto.qdb.DatabaseFieldSchema;
-      reader.readMessage(value,proto.qdb.DatabaseFieldSchema.deserializeBinaryFromReader);
-      msg.addFieldschemas(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRaw(value);
       break;
     default:
       reader.skipField();
@@ -9706,9 +18010,9 @@ proto.qdb.DatabaseSnapshot.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.DatabaseSnapshot.prototype.serializeBinary = function() {
+proto.qdb.Float.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.DatabaseSnapshot.serializeBinaryToWriter(this, writer);
+  proto.qdb.Float.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9716,196 +18020,167 @@ proto.qdb.DatabaseSnapshot.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.DatabaseSnapshot} message
+ * @param {!proto.qdb.Float} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseSnapshot.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.Float.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEntitiesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getRaw();
+  if (f !== 0.0) {
+    writer.writeDouble(
       1,
-      f,
-      proto.qdb.DatabaseEntity.serializeBinaryToWriter
-    );
-  }
-  f = message.getFieldsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.qdb.DatabaseField.serializeBinaryToWriter
-    );
-  }
-  f = message.getEntityschemasList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.qdb.DatabaseEntitySchema.serializeBinaryToWriter
-    );
-  }
-  f = message.getFieldschemasList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.qdb.DatabaseFieldSchema.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated DatabaseEntity entities = 1;
- * @return {!Array<!proto.qdb.DatabaseEntity>}
- */
-proto.qdb.DatabaseSnapshot.prototype.getEntitiesList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseEntity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEntity, 1));
-};
-
-
-/**
- * @param {!Array<!proto.qdb.DatabaseEntity>} value
- * @return {!proto.qdb.DatabaseSnapshot} returns this
-*/
-proto.qdb.DatabaseSnapshot.prototype.setEntitiesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.qdb.DatabaseEntity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseEntity}
+ * optional double raw = 1;
+ * @return {number}
  */
-proto.qdb.DatabaseSnapshot.prototype.addEntities = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.qdb.DatabaseEntity, opt_index);
+proto.qdb.Float.prototype.getRaw = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseSnapshot} returns this
+ * @param {number} value
+ * @return {!proto.qdb.Float} returns this
  */
-proto.qdb.DatabaseSnapshot.prototype.clearEntitiesList = function() {
-  return this.setEntitiesList([]);
+proto.qdb.Float.prototype.setRaw = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
 };
 
 
-/**
- * repeated DatabaseField fields = 2;
- * @return {!Array<!proto.qdb.DatabaseField>}
- */
-proto.qdb.DatabaseSnapshot.prototype.getFieldsList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseField>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseField, 2));
-};
-
 
-/**
- * @param {!Array<!proto.qdb.DatabaseField>} value
- * @return {!proto.qdb.DatabaseSnapshot} returns this
-*/
-proto.qdb.DatabaseSnapshot.prototype.setFieldsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.qdb.DatabaseField=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseField}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.qdb.DatabaseSnapshot.prototype.addFields = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.qdb.DatabaseField, opt_index);
+proto.qdb.Bool.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.Bool.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseSnapshot} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.qdb.Bool} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseSnapshot.prototype.clearFieldsList = function() {
-  return this.setFieldsList([]);
-};
-
+proto.qdb.Bool.toObject = function(includeInstance, msg) {
+  var f, obj = {
+raw: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
 
-/**
- * repeated DatabaseEntitySchema entitySchemas = 3;
- * @return {!Array<!proto.qdb.DatabaseEntitySchema>}
- */
-proto.qdb.DatabaseSnapshot.prototype.getEntityschemasList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseEntitySchema>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseEntitySchema, 3));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!Array<!proto.qdb.DatabaseEntitySchema>} value
- * @return {!proto.qdb.DatabaseSnapshot} returns this
-*/
-proto.qdb.DatabaseSnapshot.prototype.setEntityschemasList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.qdb.Bool}
+ */
+proto.qdb.Bool.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.qdb.Bool;
+  return proto.qdb.Bool.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseEntitySchema=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseEntitySchema}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.qdb.Bool} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.qdb.Bool}
  */
-proto.qdb.DatabaseSnapshot.prototype.addEntityschemas = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.qdb.DatabaseEntitySchema, opt_index);
+proto.qdb.Bool.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRaw(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseSnapshot} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.qdb.DatabaseSnapshot.prototype.clearEntityschemasList = function() {
-  return this.setEntityschemasList([]);
+proto.qdb.Bool.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.qdb.Bool.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * repeated DatabaseFieldSchema fieldSchemas = 4;
- * @return {!Array<!proto.qdb.DatabaseFieldSchema>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.qdb.Bool} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.DatabaseSnapshot.prototype.getFieldschemasList = function() {
-  return /** @type{!Array<!proto.qdb.DatabaseFieldSchema>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.qdb.DatabaseFieldSchema, 4));
-};
-
-
-/**
- * @param {!Array<!proto.qdb.DatabaseFieldSchema>} value
- * @return {!proto.qdb.DatabaseSnapshot} returns this
-*/
-proto.qdb.DatabaseSnapshot.prototype.setFieldschemasList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+proto.qdb.Bool.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRaw();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!proto.qdb.DatabaseFieldSchema=} opt_value
- * @param {number=} opt_index
- * @return {!proto.qdb.DatabaseFieldSchema}
+ * optional bool raw = 1;
+ * @return {boolean}
  */
-proto.qdb.DatabaseSnapshot.prototype.addFieldschemas = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.qdb.DatabaseFieldSchema, opt_index);
+proto.qdb.Bool.prototype.getRaw = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.qdb.DatabaseSnapshot} returns this
+ * @param {boolean} value
+ * @return {!proto.qdb.Bool} returns this
  */
-proto.qdb.DatabaseSnapshot.prototype.clearFieldschemasList = function() {
-  return this.setFieldschemasList([]);
+proto.qdb.Bool.prototype.setRaw = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
@@ -9925,8 +18200,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.Int.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.Int.toObject(opt_includeInstance, this);
+proto.qdb.EntityReference.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.EntityReference.toObject(opt_includeInstance, this);
 };
 
 
@@ -9935,13 +18210,13 @@ proto.qdb.Int.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.Int} msg The msg instance to transform.
+ * @param {!proto.qdb.EntityReference} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Int.toObject = function(includeInstance, msg) {
+proto.qdb.EntityReference.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: jspb.Message.getFieldWithDefault(msg, 1, 0)
+raw: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9955,23 +18230,23 @@ raw: jspb.Message.getFieldWithDefault(msg, 1, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.Int}
+ * @return {!proto.qdb.EntityReference}
  */
-proto.qdb.Int.deserializeBinary = function(bytes) {
+proto.qdb.EntityReference.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.Int;
-  return proto.qdb.Int.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.EntityReference;
+  return proto.qdb.EntityReference.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.Int} msg The message object to deserialize into.
+ * @param {!proto.qdb.EntityReference} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.Int}
+ * @return {!proto.qdb.EntityReference}
  */
-proto.qdb.Int.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.EntityReference.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9979,7 +18254,7 @@ proto.qdb.Int.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
+      var value = /** @type {string} */ (reader.readString());
       msg.setRaw(value);
       break;
     default:
@@ -9995,9 +18270,9 @@ proto.qdb.Int.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.Int.prototype.serializeBinary = function() {
+proto.qdb.EntityReference.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.Int.serializeBinaryToWriter(this, writer);
+  proto.qdb.EntityReference.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10005,15 +18280,15 @@ proto.qdb.Int.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.Int} message
+ * @param {!proto.qdb.EntityReference} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Int.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.EntityReference.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getRaw();
-  if (f !== 0) {
-    writer.writeInt64(
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
@@ -10022,20 +18297,20 @@ proto.qdb.Int.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional int64 raw = 1;
- * @return {number}
+ * optional string raw = 1;
+ * @return {string}
  */
-proto.qdb.Int.prototype.getRaw = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.qdb.EntityReference.prototype.getRaw = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.qdb.Int} returns this
+ * @param {string} value
+ * @return {!proto.qdb.EntityReference} returns this
  */
-proto.qdb.Int.prototype.setRaw = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.qdb.EntityReference.prototype.setRaw = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -10055,8 +18330,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.String.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.String.toObject(opt_includeInstance, this);
+proto.qdb.BinaryFile.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.BinaryFile.toObject(opt_includeInstance, this);
 };
 
 
@@ -10065,13 +18340,16 @@ proto.qdb.String.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.String} msg The msg instance to transform.
+ * @param {!proto.qdb.BinaryFile} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.String.toObject = function(includeInstance, msg) {
+proto.qdb.BinaryFile.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: jspb.Message.getFieldWithDefault(msg, 1, "")
+raw: jspb.Message.getFieldWithDefault(msg, 1, ""),
+hash: jspb.Message.getFieldWithDefault(msg, 2, ""),
+size: jspb.Message.getFieldWithDefault(msg, 3, 0),
+mimetype: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -10085,23 +18363,23 @@ raw: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.String}
+ * @return {!proto.qdb.BinaryFile}
  */
-proto.qdb.String.deserializeBinary = function(bytes) {
+proto.qdb.BinaryFile.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.String;
-  return proto.qdb.String.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.BinaryFile;
+  return proto.qdb.BinaryFile.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.String} msg The message object to deserialize into.
+ * @param {!proto.qdb.BinaryFile} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.String}
+ * @return {!proto.qdb.BinaryFile}
  */
-proto.qdb.String.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.BinaryFile.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10112,6 +18390,18 @@ proto.qdb.String.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setRaw(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHash(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSize(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimetype(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -10125,9 +18415,9 @@ proto.qdb.String.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.String.prototype.serializeBinary = function() {
+proto.qdb.BinaryFile.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.String.serializeBinaryToWriter(this, writer);
+  proto.qdb.BinaryFile.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10135,11 +18425,11 @@ proto.qdb.String.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.String} message
+ * @param {!proto.qdb.BinaryFile} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.String.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.BinaryFile.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getRaw();
   if (f.length > 0) {
@@ -10148,6 +18438,27 @@ proto.qdb.String.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getHash();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getMimetype();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -10155,20 +18466,74 @@ proto.qdb.String.serializeBinaryToWriter = function(message, writer) {
  * optional string raw = 1;
  * @return {string}
  */
-proto.qdb.String.prototype.getRaw = function() {
+proto.qdb.BinaryFile.prototype.getRaw = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.String} returns this
+ * @return {!proto.qdb.BinaryFile} returns this
  */
-proto.qdb.String.prototype.setRaw = function(value) {
+proto.qdb.BinaryFile.prototype.setRaw = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string hash = 2;
+ * @return {string}
+ */
+proto.qdb.BinaryFile.prototype.getHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.BinaryFile} returns this
+ */
+proto.qdb.BinaryFile.prototype.setHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int64 size = 3;
+ * @return {number}
+ */
+proto.qdb.BinaryFile.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.qdb.BinaryFile} returns this
+ */
+proto.qdb.BinaryFile.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string mimeType = 4;
+ * @return {string}
+ */
+proto.qdb.BinaryFile.prototype.getMimetype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.qdb.BinaryFile} returns this
+ */
+proto.qdb.BinaryFile.prototype.setMimetype = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
 
 
 
@@ -10185,8 +18550,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.Timestamp.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.Timestamp.toObject(opt_includeInstance, this);
+proto.qdb.Transformation.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.Transformation.toObject(opt_includeInstance, this);
 };
 
 
@@ -10195,13 +18560,13 @@ proto.qdb.Timestamp.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.Timestamp} msg The msg instance to transform.
+ * @param {!proto.qdb.Transformation} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Timestamp.toObject = function(includeInstance, msg) {
+proto.qdb.Transformation.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: (f = msg.getRaw()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+raw: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -10215,23 +18580,23 @@ raw: (f = msg.getRaw()) && google_protobuf_timestamp_pb.Timestamp.toObject(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.Timestamp}
+ * @return {!proto.qdb.Transformation}
  */
-proto.qdb.Timestamp.deserializeBinary = function(bytes) {
+proto.qdb.Transformation.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.Timestamp;
-  return proto.qdb.Timestamp.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.Transformation;
+  return proto.qdb.Transformation.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.Timestamp} msg The message object to deserialize into.
+ * @param {!proto.qdb.Transformation} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.Timestamp}
+ * @return {!proto.qdb.Transformation}
  */
-proto.qdb.Timestamp.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.Transformation.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10239,8 +18604,7 @@ proto.qdb.Timestamp.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      var value = /** @type {string} */ (reader.readString());
       msg.setRaw(value);
       break;
     default:
@@ -10256,9 +18620,9 @@ proto.qdb.Timestamp.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.Timestamp.prototype.serializeBinary = function() {
+proto.qdb.Transformation.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.Timestamp.serializeBinaryToWriter(this, writer);
+  proto.qdb.Transformation.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10266,60 +18630,47 @@ proto.qdb.Timestamp.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.Timestamp} message
+ * @param {!proto.qdb.Transformation} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Timestamp.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.Transformation.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getRaw();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
     );
   }
 };
 
 
 /**
- * optional google.protobuf.Timestamp raw = 1;
- * @return {?proto.google.protobuf.Timestamp}
+ * optional string raw = 1;
+ * @return {string}
  */
-proto.qdb.Timestamp.prototype.getRaw = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.qdb.Timestamp} returns this
-*/
-proto.qdb.Timestamp.prototype.setRaw = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.qdb.Transformation.prototype.getRaw = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.qdb.Timestamp} returns this
+ * @param {string} value
+ * @return {!proto.qdb.Transformation} returns this
  */
-proto.qdb.Timestamp.prototype.clearRaw = function() {
-  return this.setRaw(undefined);
+proto.qdb.Transformation.prototype.setRaw = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.qdb.Timestamp.prototype.hasRaw = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
+proto.qdb.IntList.repeatedFields_ = [1];
 
 
 
@@ -10336,8 +18687,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.Float.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.Float.toObject(opt_includeInstance, this);
+proto.qdb.IntList.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.IntList.toObject(opt_includeInstance, this);
 };
 
 
@@ -10346,13 +18697,13 @@ proto.qdb.Float.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.Float} msg The msg instance to transform.
+ * @param {!proto.qdb.IntList} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Float.toObject = function(includeInstance, msg) {
+proto.qdb.IntList.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+rawList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -10366,23 +18717,23 @@ raw: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.Float}
+ * @return {!proto.qdb.IntList}
  */
-proto.qdb.Float.deserializeBinary = function(bytes) {
+proto.qdb.IntList.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.Float;
-  return proto.qdb.Float.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.IntList;
+  return proto.qdb.IntList.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.Float} msg The message object to deserialize into.
+ * @param {!proto.qdb.IntList} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.Float}
+ * @return {!proto.qdb.IntList}
  */
-proto.qdb.Float.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.IntList.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10390,8 +18741,10 @@ proto.qdb.Float.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setRaw(value);
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt64() : [reader.readInt64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addRaw(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -10406,9 +18759,9 @@ proto.qdb.Float.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.Float.prototype.serializeBinary = function() {
+proto.qdb.IntList.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.Float.serializeBinaryToWriter(this, writer);
+  proto.qdb.IntList.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10416,15 +18769,15 @@ proto.qdb.Float.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.Float} message
+ * @param {!proto.qdb.IntList} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Float.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.IntList.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRaw();
-  if (f !== 0.0) {
-    writer.writeDouble(
+  f = message.getRawList();
+  if (f.length > 0) {
+    writer.writePackedInt64(
       1,
       f
     );
@@ -10433,24 +18786,50 @@ proto.qdb.Float.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional double raw = 1;
- * @return {number}
+ * repeated int64 raw = 1;
+ * @return {!Array<number>}
  */
-proto.qdb.Float.prototype.getRaw = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+proto.qdb.IntList.prototype.getRawList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.qdb.IntList} returns this
+ */
+proto.qdb.IntList.prototype.setRawList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.qdb.Float} returns this
+ * @param {number=} opt_index
+ * @return {!proto.qdb.IntList} returns this
  */
-proto.qdb.Float.prototype.setRaw = function(value) {
-  return jspb.Message.setProto3FloatField(this, 1, value);
+proto.qdb.IntList.prototype.addRaw = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.IntList} returns this
+ */
+proto.qdb.IntList.prototype.clearRawList = function() {
+  return this.setRawList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.StringList.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10466,8 +18845,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.Bool.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.Bool.toObject(opt_includeInstance, this);
+proto.qdb.StringList.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.StringList.toObject(opt_includeInstance, this);
 };
 
 
@@ -10476,13 +18855,13 @@ proto.qdb.Bool.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.Bool} msg The msg instance to transform.
+ * @param {!proto.qdb.StringList} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Bool.toObject = function(includeInstance, msg) {
+proto.qdb.StringList.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+rawList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -10496,23 +18875,23 @@ raw: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.Bool}
+ * @return {!proto.qdb.StringList}
  */
-proto.qdb.Bool.deserializeBinary = function(bytes) {
+proto.qdb.StringList.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.Bool;
-  return proto.qdb.Bool.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.StringList;
+  return proto.qdb.StringList.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.Bool} msg The message object to deserialize into.
+ * @param {!proto.qdb.StringList} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.Bool}
+ * @return {!proto.qdb.StringList}
  */
-proto.qdb.Bool.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.StringList.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10520,8 +18899,8 @@ proto.qdb.Bool.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRaw(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addRaw(value);
       break;
     default:
       reader.skipField();
@@ -10536,9 +18915,9 @@ proto.qdb.Bool.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.Bool.prototype.serializeBinary = function() {
+proto.qdb.StringList.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.Bool.serializeBinaryToWriter(this, writer);
+  proto.qdb.StringList.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10546,15 +18925,15 @@ proto.qdb.Bool.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.Bool} message
+ * @param {!proto.qdb.StringList} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Bool.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.StringList.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRaw();
-  if (f) {
-    writer.writeBool(
+  f = message.getRawList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
       f
     );
@@ -10563,24 +18942,50 @@ proto.qdb.Bool.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional bool raw = 1;
- * @return {boolean}
+ * repeated string raw = 1;
+ * @return {!Array<string>}
  */
-proto.qdb.Bool.prototype.getRaw = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.qdb.StringList.prototype.getRawList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.qdb.Bool} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.StringList} returns this
  */
-proto.qdb.Bool.prototype.setRaw = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.qdb.StringList.prototype.setRawList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.qdb.StringList} returns this
+ */
+proto.qdb.StringList.prototype.addRaw = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.StringList} returns this
+ */
+proto.qdb.StringList.prototype.clearRawList = function() {
+  return this.setRawList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.qdb.EntityReferenceList.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10596,8 +19001,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.EntityReference.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.EntityReference.toObject(opt_includeInstance, this);
+proto.qdb.EntityReferenceList.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.EntityReferenceList.toObject(opt_includeInstance, this);
 };
 
 
@@ -10606,13 +19011,13 @@ proto.qdb.EntityReference.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.EntityReference} msg The msg instance to transform.
+ * @param {!proto.qdb.EntityReferenceList} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.EntityReference.toObject = function(includeInstance, msg) {
+proto.qdb.EntityReferenceList.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: jspb.Message.getFieldWithDefault(msg, 1, "")
+rawList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -10626,23 +19031,23 @@ raw: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.EntityReference}
+ * @return {!proto.qdb.EntityReferenceList}
  */
-proto.qdb.EntityReference.deserializeBinary = function(bytes) {
+proto.qdb.EntityReferenceList.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.EntityReference;
-  return proto.qdb.EntityReference.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.EntityReferenceList;
+  return proto.qdb.EntityReferenceList.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.EntityReference} msg The message object to deserialize into.
+ * @param {!proto.qdb.EntityReferenceList} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.EntityReference}
+ * @return {!proto.qdb.EntityReferenceList}
  */
-proto.qdb.EntityReference.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.EntityReferenceList.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10651,7 +19056,7 @@ proto.qdb.EntityReference.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRaw(value);
+      msg.addRaw(value);
       break;
     default:
       reader.skipField();
@@ -10666,9 +19071,9 @@ proto.qdb.EntityReference.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.EntityReference.prototype.serializeBinary = function() {
+proto.qdb.EntityReferenceList.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.EntityReference.serializeBinaryToWriter(this, writer);
+  proto.qdb.EntityReferenceList.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10676,15 +19081,15 @@ proto.qdb.EntityReference.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.EntityReference} message
+ * @param {!proto.qdb.EntityReferenceList} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.EntityReference.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.EntityReferenceList.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRaw();
+  f = message.getRawList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
@@ -10693,20 +19098,39 @@ proto.qdb.EntityReference.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string raw = 1;
- * @return {string}
+ * repeated string raw = 1;
+ * @return {!Array<string>}
  */
-proto.qdb.EntityReference.prototype.getRaw = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.EntityReferenceList.prototype.getRawList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.qdb.EntityReferenceList} returns this
+ */
+proto.qdb.EntityReferenceList.prototype.setRawList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.qdb.EntityReference} returns this
+ * @param {number=} opt_index
+ * @return {!proto.qdb.EntityReferenceList} returns this
  */
-proto.qdb.EntityReference.prototype.setRaw = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.EntityReferenceList.prototype.addRaw = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.qdb.EntityReferenceList} returns this
+ */
+proto.qdb.EntityReferenceList.prototype.clearRawList = function() {
+  return this.setRawList([]);
 };
 
 
@@ -10726,8 +19150,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.BinaryFile.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.BinaryFile.toObject(opt_includeInstance, this);
+proto.qdb.StringMap.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.StringMap.toObject(opt_includeInstance, this);
 };
 
 
@@ -10736,13 +19160,13 @@ proto.qdb.BinaryFile.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.BinaryFile} msg The msg instance to transform.
+ * @param {!proto.qdb.StringMap} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.BinaryFile.toObject = function(includeInstance, msg) {
+proto.qdb.StringMap.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: jspb.Message.getFieldWithDefault(msg, 1, "")
+rawMap: (f = msg.getRawMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -10756,23 +19180,23 @@ raw: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.BinaryFile}
+ * @return {!proto.qdb.StringMap}
  */
-proto.qdb.BinaryFile.deserializeBinary = function(bytes) {
+proto.qdb.StringMap.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.BinaryFile;
-  return proto.qdb.BinaryFile.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.StringMap;
+  return proto.qdb.StringMap.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.BinaryFile} msg The message object to deserialize into.
+ * @param {!proto.qdb.StringMap} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.BinaryFile}
+ * @return {!proto.qdb.StringMap}
  */
-proto.qdb.BinaryFile.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.StringMap.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10780,8 +19204,10 @@ proto.qdb.BinaryFile.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRaw(value);
+      var value = msg.getRawMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -10796,9 +19222,9 @@ proto.qdb.BinaryFile.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.BinaryFile.prototype.serializeBinary = function() {
+proto.qdb.StringMap.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.BinaryFile.serializeBinaryToWriter(this, writer);
+  proto.qdb.StringMap.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10806,37 +19232,39 @@ proto.qdb.BinaryFile.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.BinaryFile} message
+ * @param {!proto.qdb.StringMap} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.BinaryFile.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.StringMap.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRaw();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
+  f = message.getRawMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
 
 /**
- * optional string raw = 1;
- * @return {string}
+ * map<string, string> raw = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.qdb.BinaryFile.prototype.getRaw = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.StringMap.prototype.getRawMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.BinaryFile} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.qdb.StringMap} returns this
  */
-proto.qdb.BinaryFile.prototype.setRaw = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.StringMap.prototype.clearRawMap = function() {
+  this.getRawMap().clear();
+  return this;
 };
 
 
@@ -10856,8 +19284,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.qdb.Transformation.prototype.toObject = function(opt_includeInstance) {
-  return proto.qdb.Transformation.toObject(opt_includeInstance, this);
+proto.qdb.Enum.prototype.toObject = function(opt_includeInstance) {
+  return proto.qdb.Enum.toObject(opt_includeInstance, this);
 };
 
 
@@ -10866,13 +19294,13 @@ proto.qdb.Transformation.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.qdb.Transformation} msg The msg instance to transform.
+ * @param {!proto.qdb.Enum} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Transformation.toObject = function(includeInstance, msg) {
+proto.qdb.Enum.toObject = function(includeInstance, msg) {
   var f, obj = {
-raw: jspb.Message.getFieldWithDefault(msg, 1, "")
+raw: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -10886,23 +19314,23 @@ raw: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.qdb.Transformation}
+ * @return {!proto.qdb.Enum}
  */
-proto.qdb.Transformation.deserializeBinary = function(bytes) {
+proto.qdb.Enum.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.qdb.Transformation;
-  return proto.qdb.Transformation.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.qdb.Enum;
+  return proto.qdb.Enum.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.qdb.Transformation} msg The message object to deserialize into.
+ * @param {!proto.qdb.Enum} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.qdb.Transformation}
+ * @return {!proto.qdb.Enum}
  */
-proto.qdb.Transformation.deserializeBinaryFromReader = function(msg, reader) {
+proto.qdb.Enum.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10910,7 +19338,7 @@ proto.qdb.Transformation.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
+      var value = /** @type {number} */ (reader.readInt64());
       msg.setRaw(value);
       break;
     default:
@@ -10926,9 +19354,9 @@ proto.qdb.Transformation.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.qdb.Transformation.prototype.serializeBinary = function() {
+proto.qdb.Enum.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.qdb.Transformation.serializeBinaryToWriter(this, writer);
+  proto.qdb.Enum.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10936,15 +19364,15 @@ proto.qdb.Transformation.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.qdb.Transformation} message
+ * @param {!proto.qdb.Enum} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.qdb.Transformation.serializeBinaryToWriter = function(message, writer) {
+proto.qdb.Enum.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getRaw();
-  if (f.length > 0) {
-    writer.writeString(
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
@@ -10953,20 +19381,20 @@ proto.qdb.Transformation.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string raw = 1;
- * @return {string}
+ * optional int64 raw = 1;
+ * @return {number}
  */
-proto.qdb.Transformation.prototype.getRaw = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.qdb.Enum.prototype.getRaw = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.qdb.Transformation} returns this
+ * @param {number} value
+ * @return {!proto.qdb.Enum} returns this
  */
-proto.qdb.Transformation.prototype.setRaw = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.qdb.Enum.prototype.setRaw = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -56,3 +56,81 @@ function qMessageType(message) {
 
     return null
 }
+
+function qUnpackValue(value) {
+    const typeName = value.getTypeName();
+    if (!typeName.startsWith("qdb.") || !proto.qdb[typeName.substring(4)]) {
+        qWarn(`[qUnpackValue] Unsupported value type '${typeName}'`);
+        return null;
+    }
+
+    const messageType = proto.qdb[typeName.substring(4)];
+    const message = value.unpack(messageType.deserializeBinary, typeName);
+
+    if (message.getRawList) {
+        return message.getRawList();
+    }
+
+    if (message.getRawMap) {
+        return Object.fromEntries(message.getRawMap().toArray());
+    }
+
+    return message.getRaw();
+}
+
+function qPackValue(typeName, raw) {
+    const messageType = proto.qdb[typeName.replace(/^qdb\./, "")];
+    if (!messageType) {
+        qWarn(`[qPackValue] Unsupported value type '${typeName}'`);
+        return null;
+    }
+
+    const message = new messageType();
+    if (message.setRawList) {
+        message.setRawList(raw);
+    } else if (message.getRawMap) {
+        Object.entries(raw).forEach(([key, value]) => message.getRawMap().set(key, value));
+    } else {
+        message.setRaw(raw);
+    }
+
+    const value = new proto.google.protobuf.Any();
+    value.pack(message.serializeBinary(), "qdb." + typeName.replace(/^qdb\./, ""));
+    return value;
+}
+
+function qEnumName(fieldSchema, value) {
+    const enumValue = fieldSchema.getEnumvaluesList().find(e => e.getValue() === value);
+    return enumValue ? enumValue.getName() : String(value);
+}
+
+function qEnumValue(fieldSchema, name) {
+    const enumValue = fieldSchema.getEnumvaluesList().find(e => e.getName() === name);
+    return enumValue ? enumValue.getValue() : null;
+}
+
+function qToEngineering(fieldSchema, raw) {
+    const scale = fieldSchema.getScale() || 1;
+    return raw * scale + fieldSchema.getOffset();
+}
+
+function qFromEngineering(fieldSchema, value) {
+    const scale = fieldSchema.getScale() || 1;
+    return (value - fieldSchema.getOffset()) / scale;
+}
+
+function qFormatEngineering(fieldSchema, raw) {
+    const value = qToEngineering(fieldSchema, raw);
+    const text = fieldSchema.getDisplayprecision() > 0 ? value.toFixed(fieldSchema.getDisplayprecision()) : String(value);
+    return fieldSchema.getUnit() ? `${text} ${fieldSchema.getUnit()}` : text;
+}
+
+function qAlarmLevel(fieldSchema, value) {
+    const LevelEnum = proto.qdb.DatabaseAlarmLimit.LevelEnum;
+    const severity = level => (level === LevelEnum.LOW_LOW || level === LevelEnum.HIGH_HIGH) ? 2 : (level === LevelEnum.UNSPECIFIED ? 0 : 1);
+
+    return fieldSchema.getAlarmlimitsList().reduce((level, limit) => {
+        const exceeded = (limit.getLevel() === LevelEnum.LOW_LOW || limit.getLevel() === LevelEnum.LOW) ? value <= limit.getValue() : value >= limit.getValue();
+        return exceeded && severity(limit.getLevel()) > severity(level) ? limit.getLevel() : level;
+    }, LevelEnum.UNSPECIFIED);
+}